@@ -100,6 +100,104 @@ CREATE TRIGGER trigger_user_tenant_mapping_updated_at
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+-- ============================================================================
+-- Feature Flag Registry
+-- ============================================================================
+-- Known feature flags with their value type and default
+-- A tenant's flag resolves to its override, else its plan's value, else true
+-- when rollout_percent includes the tenant (boolean flags), else the default
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.feature_flags (
+    key VARCHAR(50) PRIMARY KEY, -- e.g., sso
+    value_type VARCHAR(20) NOT NULL CHECK (value_type IN ('boolean', 'number', 'string')),
+    default_value JSONB NOT NULL,
+    description TEXT NOT NULL,
+
+    -- Share of tenants, bucketed by tenant ID, that get a boolean flag turned on
+    rollout_percent INTEGER NOT NULL DEFAULT 0 CHECK (rollout_percent BETWEEN 0 AND 100),
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CHECK (rollout_percent = 0 OR value_type = 'boolean')
+);
+
+INSERT INTO public.feature_flags (key, value_type, default_value, description, rollout_percent)
+VALUES
+    ('sso', 'boolean', 'false', 'Single sign-on through the tenant identity provider', 0),
+    ('api_access', 'boolean', 'false', 'Access to the public REST API', 0),
+    ('advanced_reports', 'boolean', 'false', 'Procurement analytics and custom reports', 0),
+    ('ai_quotation_assistant', 'boolean', 'false', 'AI assistant for drafting quotations', 10)
+ON CONFLICT (key) DO NOTHING;
+
+-- ============================================================================
+-- Plan Catalog
+-- ============================================================================
+-- Subscription plans with their quotas and default features
+-- Plans are not edited once created: a new plan replaces a retired one
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.plans (
+    tier VARCHAR(50) PRIMARY KEY, -- e.g., professional
+    display_name VARCHAR(100) NOT NULL,
+    description TEXT,
+
+    -- Quotas granted to tenants on the plan
+    max_users INTEGER NOT NULL CHECK (max_users > 0),
+    max_storage_gb INTEGER NOT NULL CHECK (max_storage_gb > 0),
+
+    -- Features granted to tenants on the plan
+    features JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+    sort_order INTEGER NOT NULL DEFAULT 0,
+
+    -- Retired plans can no longer be assigned
+    retired_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trigger_plans_updated_at
+    BEFORE UPDATE ON public.plans
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Plan features must be registered feature flags
+INSERT INTO public.plans (tier, display_name, description, max_users, max_storage_gb, features, sort_order)
+VALUES
+    ('free', 'Free', 'For evaluation and small teams', 5, 5, '{}', 10),
+    ('basic', 'Basic', 'For growing teams', 20, 50, '{"api_access": true}', 20),
+    ('professional', 'Professional', 'For established businesses', 100, 500, '{"api_access": true, "advanced_reports": true}', 30),
+    ('enterprise', 'Enterprise', 'For large organizations', 1000, 5000, '{"api_access": true, "advanced_reports": true, "sso": true}', 40)
+ON CONFLICT (tier) DO NOTHING;
+
+-- Named usage limits of each plan, besides its quotas
+-- window_type: none (never resets), day, month (calendar, UTC) or rolling (trailing period_seconds)
+CREATE TABLE IF NOT EXISTS public.plan_entitlements (
+    plan_tier VARCHAR(50) NOT NULL REFERENCES public.plans(tier) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    limit_value BIGINT NOT NULL CHECK (limit_value > 0),
+    window_type VARCHAR(20) NOT NULL CHECK (window_type IN ('none', 'day', 'month', 'rolling')),
+    period_seconds BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (plan_tier, name),
+    CHECK (window_type <> 'rolling' OR period_seconds >= 60)
+);
+
+INSERT INTO public.plan_entitlements (plan_tier, name, limit_value, window_type)
+VALUES
+    ('free', 'monthly_notices_monitored', 50, 'month'),
+    ('basic', 'monthly_notices_monitored', 500, 'month'),
+    ('professional', 'monthly_notices_monitored', 5000, 'month'),
+    ('enterprise', 'monthly_notices_monitored', 50000, 'month'),
+    ('free', 'monthly_quotations', 10, 'month'),
+    ('basic', 'monthly_quotations', 100, 'month'),
+    ('professional', 'monthly_quotations', 1000, 'month'),
+    ('enterprise', 'monthly_quotations', 10000, 'month')
+ON CONFLICT (plan_tier, name) DO NOTHING;
+
 -- ============================================================================
 -- Tenant Registry Table
 -- ============================================================================
@@ -115,13 +213,24 @@ CREATE TABLE IF NOT EXISTS public.tenant_registry (
     tenant_name VARCHAR(255) NOT NULL UNIQUE,
     tenant_slug VARCHAR(100) NOT NULL UNIQUE, -- URL-safe identifier
 
+    -- Hierarchy (holding > company > unit), at most 4 levels; no cycles,
+    -- enforced by the service
+    parent_tenant_id UUID REFERENCES public.tenant_registry(tenant_id),
+    -- Take the parent's effective quotas in place of the plan's
+    inherit_quotas BOOLEAN NOT NULL DEFAULT FALSE,
+
     -- Subscription/Plan information
-    plan_tier VARCHAR(50) NOT NULL CHECK (plan_tier IN (
-        'free',
-        'basic',
-        'professional',
-        'enterprise'
-    )) DEFAULT 'free',
+    plan_tier VARCHAR(50) NOT NULL DEFAULT 'free' REFERENCES public.plans(tier),
+
+    -- Trial of plan_tier; one per tenant, kept with its outcome once ended
+    trial_plan VARCHAR(50) REFERENCES public.plans(tier),
+    trial_fallback_plan VARCHAR(50) REFERENCES public.plans(tier),
+    trial_expiry_policy VARCHAR(20) CHECK (trial_expiry_policy IN ('downgrade', 'suspend')),
+    trial_started_at TIMESTAMP WITH TIME ZONE,
+    trial_ends_at TIMESTAMP WITH TIME ZONE,
+    trial_reminded_at TIMESTAMP WITH TIME ZONE,
+    trial_ended_at TIMESTAMP WITH TIME ZONE,
+    trial_outcome VARCHAR(20) CHECK (trial_outcome IN ('converted', 'downgraded', 'suspended')),
 
     -- Status
     status VARCHAR(50) NOT NULL CHECK (status IN (
@@ -132,6 +241,12 @@ CREATE TABLE IF NOT EXISTS public.tenant_registry (
         'deleted'         -- Marked for deletion, data will be purged
     )) DEFAULT 'provisioning',
 
+    -- Why a suspended tenant was suspended; cleared when it is reinstated
+    suspension_reason VARCHAR(30) CHECK (suspension_reason IN (
+        'billing', 'abuse', 'legal_hold', 'customer_request', 'security'
+    )),
+    suspension_notes TEXT,
+
     -- Schema information
     database_schema VARCHAR(100) NOT NULL UNIQUE, -- e.g., tenant_550e8400e29b41d4a716446655440000
     schema_version VARCHAR(20) NOT NULL DEFAULT '1.0.0',
@@ -152,27 +267,46 @@ CREATE TABLE IF NOT EXISTS public.tenant_registry (
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     activated_at TIMESTAMP WITH TIME ZONE,
     suspended_at TIMESTAMP WITH TIME ZONE,
+    reinstated_at TIMESTAMP WITH TIME ZONE,
     deleted_at TIMESTAMP WITH TIME ZONE,
+    -- Set when the schema of a deleted tenant is dropped and its PII scrubbed
+    purged_at TIMESTAMP WITH TIME ZONE,
 
     -- Audit actors
     created_by UUID,
     updated_by UUID,
 
-    -- Additional configuration
+    -- Typed settings document (locale, notifications, procurement) with a
+    -- version; documents of older versions are upgraded when read
     settings JSONB DEFAULT '{}'::jsonb,
 
-    -- Feature flags per tenant
-    features JSONB DEFAULT '{}'::jsonb
+    -- Feature flags granted by the plan, copied when it is assigned
+    features JSONB DEFAULT '{}'::jsonb,
+
+    -- Feature flag values set for this tenant alone, whatever its plan
+    feature_overrides JSONB NOT NULL DEFAULT '{}'::jsonb
 );
 
 -- Indexes
 CREATE INDEX idx_tenant_registry_status ON public.tenant_registry(status)
     WHERE status IN ('active', 'provisioning');
 
+CREATE INDEX idx_tenant_registry_purge ON public.tenant_registry(deleted_at)
+    WHERE status = 'deleted' AND purged_at IS NULL;
+
 CREATE INDEX idx_tenant_registry_slug ON public.tenant_registry(tenant_slug);
 
 CREATE INDEX idx_tenant_registry_plan ON public.tenant_registry(plan_tier, status);
 
+CREATE INDEX idx_tenant_registry_parent ON public.tenant_registry(parent_tenant_id)
+    WHERE parent_tenant_id IS NOT NULL;
+
+CREATE INDEX idx_tenant_registry_suspension ON public.tenant_registry(suspension_reason)
+    WHERE suspension_reason IS NOT NULL;
+
+CREATE INDEX idx_tenant_registry_trial ON public.tenant_registry(trial_ends_at)
+    WHERE trial_ends_at IS NOT NULL AND trial_ended_at IS NULL;
+
 CREATE INDEX idx_tenant_registry_settings ON public.tenant_registry USING GIN (settings);
 
 CREATE INDEX idx_tenant_registry_features ON public.tenant_registry USING GIN (features);
@@ -183,6 +317,358 @@ CREATE TRIGGER trigger_tenant_registry_updated_at
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+-- ============================================================================
+-- Service Accounts and API Keys
+-- ============================================================================
+-- Machine clients (billing jobs, CI scripts) authenticating with API keys
+-- Only the SHA-256 hash of each key is stored
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.service_accounts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT,
+
+    -- Restricts the account to one tenant (NULL = platform-wide)
+    tenant_id UUID REFERENCES public.tenant_registry(tenant_id),
+
+    is_active BOOLEAN NOT NULL DEFAULT true,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID
+);
+
+CREATE TABLE IF NOT EXISTS public.service_account_api_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    service_account_id UUID NOT NULL REFERENCES public.service_accounts(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+
+    -- Non-secret lookup prefix and SHA-256 hex digest of the full key
+    key_prefix VARCHAR(32) NOT NULL UNIQUE,
+    key_hash VARCHAR(64) NOT NULL,
+
+    -- Permissions granted to the key, e.g. ["tenant:create", "tenant:read"]
+    permissions JSONB NOT NULL DEFAULT '[]'::jsonb,
+
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_service_account ON public.service_account_api_keys(service_account_id);
+
+CREATE TRIGGER trigger_service_accounts_updated_at
+    BEFORE UPDATE ON public.service_accounts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- Tenant Status History
+-- ============================================================================
+-- One row per lifecycle transition (see domain.lifecycleTransitions)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_status_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+
+    -- Lifecycle action, e.g. suspend, archive
+    action VARCHAR(50) NOT NULL,
+
+    -- NULL for the initial provisioning status
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    reason TEXT,
+
+    actor_type VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    actor_name VARCHAR(255),
+
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_status_history_tenant ON public.tenant_status_history(tenant_id, occurred_at);
+
+-- ============================================================================
+-- Tenant Plan History
+-- ============================================================================
+-- One row per plan change, with the quotas before and after
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_plan_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+
+    from_plan VARCHAR(50) NOT NULL,
+    to_plan VARCHAR(50) NOT NULL,
+    from_max_users INTEGER NOT NULL,
+    to_max_users INTEGER NOT NULL,
+    from_max_storage_gb INTEGER NOT NULL,
+    to_max_storage_gb INTEGER NOT NULL,
+
+    actor_type VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    actor_name VARCHAR(255),
+
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_plan_history_tenant ON public.tenant_plan_history(tenant_id, changed_at);
+
+-- ============================================================================
+-- Tenant Quota Overrides
+-- ============================================================================
+-- Per-tenant quotas that replace the plan default, optionally until expires_at
+-- An expired override is ignored at read time and kept for the record
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_quota_overrides (
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    quota_name VARCHAR(50) NOT NULL CHECK (quota_name IN ('max_users', 'max_storage_gb')),
+
+    value INTEGER NOT NULL CHECK (value > 0),
+    reason TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+
+    actor_type VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    actor_name VARCHAR(255),
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (tenant_id, quota_name)
+);
+
+-- ============================================================================
+-- Tenant Entitlements
+-- ============================================================================
+-- Per-tenant limits replacing those of the plan, and usage counters
+-- Usage is counted in buckets: one per calendar window, or one per sixtieth
+-- of the period for rolling windows, summed over the current window
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_entitlement_limits (
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    limit_value BIGINT NOT NULL CHECK (limit_value > 0),
+    window_type VARCHAR(20) NOT NULL CHECK (window_type IN ('none', 'day', 'month', 'rolling')),
+    period_seconds BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (tenant_id, name),
+    CHECK (window_type <> 'rolling' OR period_seconds >= 60)
+);
+
+CREATE TABLE IF NOT EXISTS public.entitlement_usage (
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    bucket_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    used BIGINT NOT NULL DEFAULT 0,
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (tenant_id, name, bucket_start)
+);
+
+-- ============================================================================
+-- Tenant Storage Snapshots
+-- ============================================================================
+-- Periodic measurements of each tenant schema (tables, indexes, TOAST)
+-- quota_bytes is the effective storage quota at measurement time
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_storage_snapshots (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    schema_name VARCHAR(63) NOT NULL,
+    table_bytes BIGINT NOT NULL,
+    index_bytes BIGINT NOT NULL,
+    toast_bytes BIGINT NOT NULL,
+    total_bytes BIGINT NOT NULL,
+    quota_bytes BIGINT NOT NULL,
+    measured_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_storage_snapshots_tenant ON public.tenant_storage_snapshots(tenant_id, measured_at DESC);
+CREATE INDEX IF NOT EXISTS idx_tenant_storage_snapshots_measured_at ON public.tenant_storage_snapshots(measured_at);
+
+-- ============================================================================
+-- Tenant Slug Aliases
+-- ============================================================================
+-- Former slugs of renamed tenants; an alias resolves to its tenant until
+-- released_at, and another tenant may claim it once the cooldown has passed
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_slug_aliases (
+    slug VARCHAR(100) PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    released_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_slug_aliases_tenant ON public.tenant_slug_aliases(tenant_id, created_at);
+
+-- ============================================================================
+-- Tenant Custom Domains
+-- ============================================================================
+-- Hostnames tenants reach the platform at; a domain resolves to its tenant
+-- once a DNS TXT record proves ownership, and is re-verified periodically
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_domains (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    hostname VARCHAR(253) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'verified', 'failed', 'revoked')),
+    verification_token VARCHAR(64) NOT NULL,
+    last_checked_at TIMESTAMP WITH TIME ZONE,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    verified_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- A tenant registers a hostname once; only one tenant may have it verified
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tenant_domains_tenant_hostname
+    ON public.tenant_domains(tenant_id, hostname) WHERE status != 'revoked';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tenant_domains_verified_hostname
+    ON public.tenant_domains(hostname) WHERE status = 'verified';
+CREATE INDEX IF NOT EXISTS idx_tenant_domains_due
+    ON public.tenant_domains(last_checked_at NULLS FIRST) WHERE status IN ('pending', 'verified');
+
+-- ============================================================================
+-- Scheduled Operations
+-- ============================================================================
+-- Future-dated suspensions and activations, applied by the operations worker;
+-- an operation that is illegal by the time it runs is marked failed
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_scheduled_operations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('suspend', 'activate')),
+    suspension_reason VARCHAR(30) CHECK (suspension_reason IN (
+        'billing', 'abuse', 'legal_hold', 'customer_request', 'security'
+    )),
+    notes TEXT,
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reactivate_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (reactivate_after_seconds >= 0),
+    follows_operation_id UUID REFERENCES public.tenant_scheduled_operations(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'completed', 'failed', 'canceled')),
+    error TEXT,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    executed_at TIMESTAMP WITH TIME ZONE,
+    canceled_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_scheduled_operations_due
+    ON public.tenant_scheduled_operations(run_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_tenant_scheduled_operations_tenant
+    ON public.tenant_scheduled_operations(tenant_id, run_at DESC);
+
+-- ============================================================================
+-- Tenant Invitations
+-- ============================================================================
+-- Pending invites by email; accepting a signed, single-use token creates the
+-- user_tenant_mapping row within the tenant's max users quota
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL CHECK (email = LOWER(email)),
+    tenant_role VARCHAR(50) NOT NULL CHECK (tenant_role IN (
+        'tenant_admin', 'tenant_manager', 'tenant_user', 'tenant_viewer'
+    )),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'accepted', 'revoked')),
+    token_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    send_count INTEGER NOT NULL DEFAULT 1 CHECK (send_count > 0),
+    last_sent_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    invited_by UUID,
+    accepted_by UUID,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- One pending invitation per email and tenant; an expired one is resent
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tenant_invitations_pending_email
+    ON public.tenant_invitations(tenant_id, email) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_tenant_invitations_tenant
+    ON public.tenant_invitations(tenant_id, created_at DESC);
+
+-- ============================================================================
+-- Audit Log
+-- ============================================================================
+-- Append-only record of every mutating tenant operation
+-- Written in the same transaction as the change it describes
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.audit_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Who: user, service_account, service (mTLS) or system
+    actor_type VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    actor_name VARCHAR(255),
+
+    -- What: e.g. tenant.created, tenant.suspended
+    action VARCHAR(100) NOT NULL,
+
+    -- No foreign key: events outlive the tenants they describe
+    tenant_id UUID,
+
+    request_id VARCHAR(100),
+    source_ip VARCHAR(64),
+
+    -- {"field": {"before": ..., "after": ...}}
+    changes JSONB NOT NULL DEFAULT '{}'::jsonb
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant ON public.audit_events(tenant_id, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON public.audit_events(actor_id, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON public.audit_events(action, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_occurred_at ON public.audit_events(occurred_at DESC);
+
+-- ============================================================================
+-- Tenant Archives
+-- ============================================================================
+-- Schema exports written when a tenant is archived
+-- The archive file itself lives in the blob store under location
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS public.tenant_archives (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES public.tenant_registry(tenant_id) ON DELETE CASCADE,
+
+    -- Blob store key of the .tar.gz archive
+    location VARCHAR(512) NOT NULL,
+    -- SHA-256 hex digest of the archive
+    checksum CHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    schema_name VARCHAR(63) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    -- Set when the archive was restored by an unarchive
+    restored_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_archives_tenant ON public.tenant_archives(tenant_id, created_at DESC);
+
 -- ============================================================================
 -- Seed Data for Development
 -- ============================================================================
@@ -211,7 +697,7 @@ INSERT INTO public.tenant_registry (
     1000,
     'admin@cotai.local',
     'Admin CotAI',
-    '{"environment": "development", "debug": true}'::jsonb
+    '{"version": 2, "locale": {"language": "pt-BR", "timezone": "America/Sao_Paulo", "currency": "BRL"}}'::jsonb
 ) ON CONFLICT (tenant_id) DO NOTHING;
 
 -- ============================================================================
@@ -223,6 +709,9 @@ COMMENT ON DATABASE cotai_identity IS 'CotAI Identity & Auth Database - Multi-te
 COMMENT ON TABLE public.user_tenant_mapping IS
 'Maps Keycloak users to CotAI tenants. Supports multi-tenant users with role-based access per tenant.';
 
+COMMENT ON COLUMN public.user_tenant_mapping.is_active IS
+'False once the user is removed from the tenant. Adding the user again reactivates the mapping. Active mappings count against max_users.';
+
 COMMENT ON TABLE public.tenant_registry IS
 'Central registry of all tenants. Tracks lifecycle, schema provisioning, and subscription details.';
 
@@ -235,5 +724,65 @@ COMMENT ON COLUMN public.user_tenant_mapping.tenant_id IS
 COMMENT ON COLUMN public.user_tenant_mapping.is_primary IS
 'Only ONE primary tenant per user allowed via exclusion constraint';
 
+COMMENT ON TABLE public.service_accounts IS
+'Machine clients authenticating with API keys instead of user JWTs.';
+
+COMMENT ON COLUMN public.service_account_api_keys.key_hash IS
+'SHA-256 hex digest of the full API key. The plaintext key is shown only once at creation.';
+
+COMMENT ON TABLE public.tenant_status_history IS
+'Lifecycle status transitions of each tenant with actor and reason.';
+
+COMMENT ON TABLE public.feature_flags IS
+'Registry of known feature flags with value type, default and percentage rollout.';
+
+COMMENT ON TABLE public.plans IS
+'Plan catalog: quotas and default features of each subscription plan.';
+
+COMMENT ON TABLE public.tenant_plan_history IS
+'Subscription plan changes of each tenant with quotas before and after.';
+
+COMMENT ON TABLE public.tenant_quota_overrides IS
+'Per-tenant quota overrides of plan defaults, with reason and optional expiry.';
+
+COMMENT ON TABLE public.plan_entitlements IS
+'Named usage limits of each plan, with the window over which usage is counted.';
+
+COMMENT ON TABLE public.tenant_entitlement_limits IS
+'Per-tenant entitlement limits replacing those of the tenant plan.';
+
+COMMENT ON TABLE public.entitlement_usage IS
+'Entitlement usage counters per tenant, entitlement and window bucket.';
+
+COMMENT ON TABLE public.tenant_storage_snapshots IS
+'Time series of tenant schema sizes in bytes, written by the storage metering worker.';
+
+COMMENT ON TABLE public.tenant_slug_aliases IS
+'Former slugs of renamed tenants, resolving to the tenant until released.';
+
+COMMENT ON TABLE public.tenant_domains IS
+'Custom domains of tenants with their DNS TXT ownership verification state.';
+
+COMMENT ON TABLE public.tenant_scheduled_operations IS
+'Pending and past scheduled suspensions/activations; failed rows keep why the transition was illegal.';
+
+COMMENT ON TABLE public.tenant_invitations IS
+'Email invitations to join a tenant; only the SHA-256 hash of the last token sent is stored.';
+
+COMMENT ON TABLE public.audit_events IS
+'Append-only audit log of mutating tenant operations with before/after diffs.';
+
+COMMENT ON TABLE public.tenant_archives IS
+'Schema archives of archived tenants, with blob store location and checksum.';
+
 COMMENT ON COLUMN public.tenant_registry.database_schema IS
 'PostgreSQL schema name where tenant data resides. Format: tenant_{uuid without hyphens}';
+
+COMMENT ON COLUMN public.tenant_registry.parent_tenant_id IS
+'Parent tenant in an organization hierarchy (NULL = root). At most 4 levels deep.';
+
+COMMENT ON COLUMN public.tenant_registry.suspension_reason IS
+'Why the tenant is suspended. abuse and security need tenant:reinstate to lift, legal_hold needs tenant:lift_legal_hold.';
+
+COMMENT ON COLUMN public.tenant_registry.trial_expiry_policy IS
+'What happens to an unconverted trial at trial_ends_at: downgrade to trial_fallback_plan or suspend the tenant.';
@@ -2,24 +2,35 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/cotai/tenant-manager/internal/app"
 	"github.com/cotai/tenant-manager/internal/delivery/grpc"
+	"github.com/cotai/tenant-manager/internal/delivery/grpc/interceptor"
 	"github.com/cotai/tenant-manager/internal/delivery/http"
 	"github.com/cotai/tenant-manager/internal/delivery/http/handler"
 	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
 	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/infrastructure/blobstore"
 	"github.com/cotai/tenant-manager/internal/infrastructure/database"
+	"github.com/cotai/tenant-manager/internal/infrastructure/dns"
+	"github.com/cotai/tenant-manager/internal/infrastructure/mail"
 	"github.com/cotai/tenant-manager/internal/infrastructure/messaging"
 	"github.com/cotai/tenant-manager/internal/infrastructure/observability"
 	"github.com/cotai/tenant-manager/internal/infrastructure/provisioning"
+	"github.com/cotai/tenant-manager/internal/pkg/apikey"
+	"github.com/cotai/tenant-manager/internal/pkg/invitetoken"
 	"github.com/cotai/tenant-manager/internal/pkg/jwt"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
+	"github.com/cotai/tenant-manager/internal/pkg/tlsreload"
 	"github.com/cotai/tenant-manager/internal/usecase"
+	"github.com/cotai/tenant-manager/internal/worker"
 	"go.uber.org/zap"
 )
 
@@ -107,12 +118,68 @@ func main() {
 	// ==========================
 
 	tenantRepo := database.NewTenantRepository(db.DB(), logger)
+	serviceAccountRepo := database.NewServiceAccountRepository(db.DB(), logger)
+	auditRepo := database.NewAuditRepository(db.DB(), logger)
+	archiveRepo := database.NewArchiveRepository(db.DB(), logger)
+	usageRepo := database.NewUsageRepository(db.DB(), logger)
+	planRepo := database.NewPlanRepository(db.DB(), logger)
+	entitlementRepo := database.NewEntitlementRepository(db.DB(), logger)
+	storageRepo := database.NewStorageRepository(db.DB(), logger)
+	featureFlagRepo := database.NewFeatureFlagRepository(db.DB(), logger)
+	customDomainRepo := database.NewCustomDomainRepository(db.DB(), logger)
+	scheduledOperationRepo := database.NewScheduledOperationRepository(db.DB(), logger)
+	memberRepo := database.NewMemberRepository(db.DB(), logger)
+	invitationRepo := database.NewInvitationRepository(db.DB(), logger)
+
+	// Transactions spanning repositories (tenant changes and their audit events)
+	txManager := database.NewTxManager(db.DB(), logger)
+
+	// Cluster-wide locks for background workers
+	advisoryLocker := database.NewAdvisoryLocker(db.DB(), logger)
 
 	// ==========================
 	// Initialize Provisioners
 	// ==========================
 
 	schemaProvisioner := provisioning.NewSchemaProvisioner(db.DB(), cfg.Database.MigrationsPath, logger)
+
+	// Schema archives of archived tenants
+	archiveStore, err := blobstore.NewLocalStore(cfg.Archive.LocalPath, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize archive store", zap.Error(err))
+	}
+
+	// DNS lookups for custom domain ownership verification
+	txtResolver := dns.NewResolver(cfg.Domains.Nameserver, cfg.Domains.LookupTimeout, logger)
+
+	// Outgoing mail: .eml files for local development, or an SMTP stub
+	var mailSender usecase.MailSender
+	switch cfg.Mail.Driver {
+	case "file":
+		mailSender, err = mail.NewFileSender(cfg.Mail.FileDir, cfg.Mail.From, logger)
+	case "smtp":
+		mailSender, err = mail.NewSMTPSender(cfg.Mail.SMTPAddr, cfg.Mail.From, cfg.Mail.SMTPTimeout, logger)
+	default:
+		err = fmt.Errorf("unknown mail driver %q, expected file or smtp", cfg.Mail.Driver)
+	}
+	if err != nil {
+		logger.Fatal("Failed to initialize mail sender", zap.Error(err))
+	}
+
+	// Invitation token signing; a generated key does not survive a restart
+	invitationKey := []byte(cfg.Invitations.SigningKey)
+	if len(invitationKey) == 0 {
+		logger.Warn("INVITATION_SIGNING_KEY not set, using a generated key; invitation links will stop working on restart")
+		invitationKey, err = invitetoken.GenerateKey()
+		if err != nil {
+			logger.Fatal("Failed to generate invitation signing key", zap.Error(err))
+		}
+	}
+	invitationSigner, err := invitetoken.NewSigner(invitationKey)
+	if err != nil {
+		logger.Fatal("Failed to initialize invitation signer", zap.Error(err))
+	}
+
 	// rls manager can be used later for manual RLS management
 	// rlsManager := provisioning.NewRLSManager(db, logger)
 
@@ -149,23 +216,105 @@ func main() {
 	// Initialize Use Cases
 	// ==========================
 
-	createTenantUC := usecase.NewCreateTenantUseCase(tenantRepo, schemaProvisioner, eventPublisher, logger)
+	// Plan catalog, cached in memory and shared by the tenant use cases
+	planCatalog := usecase.NewPlanCatalog(planRepo, cfg.Plans.CacheTTL, logger)
+
+	createTenantUC := usecase.NewCreateTenantUseCase(tenantRepo, planCatalog, txManager, auditRepo, schemaProvisioner, eventPublisher, cfg.Slugs.AliasCooldown, logger)
 	getTenantUC := usecase.NewGetTenantUseCase(tenantRepo, logger)
 	listTenantsUC := usecase.NewListTenantsUseCase(tenantRepo, logger)
-	updateTenantUC := usecase.NewUpdateTenantUseCase(tenantRepo, eventPublisher, logger)
-	suspendTenantUC := usecase.NewSuspendTenantUseCase(tenantRepo, eventPublisher, logger)
-	activateTenantUC := usecase.NewActivateTenantUseCase(tenantRepo, eventPublisher, logger)
-	deleteTenantUC := usecase.NewDeleteTenantUseCase(tenantRepo, eventPublisher, logger)
+	updateTenantUC := usecase.NewUpdateTenantUseCase(tenantRepo, txManager, auditRepo, eventPublisher, logger)
+	suspendTenantUC := usecase.NewSuspendTenantUseCase(tenantRepo, txManager, auditRepo, eventPublisher, logger)
+	activateTenantUC := usecase.NewActivateTenantUseCase(tenantRepo, txManager, auditRepo, eventPublisher, logger)
+	deleteTenantUC := usecase.NewDeleteTenantUseCase(tenantRepo, txManager, auditRepo, eventPublisher, logger)
+	tenantHistoryUC := usecase.NewGetTenantHistoryUseCase(tenantRepo, logger)
+	archiveTenantUC := usecase.NewArchiveTenantUseCase(tenantRepo, archiveRepo, txManager, auditRepo, schemaProvisioner, archiveStore, eventPublisher, logger)
+	unarchiveTenantUC := usecase.NewUnarchiveTenantUseCase(tenantRepo, archiveRepo, txManager, auditRepo, schemaProvisioner, archiveStore, eventPublisher, logger)
+	restoreTenantUC := usecase.NewRestoreTenantUseCase(tenantRepo, archiveRepo, txManager, auditRepo, schemaProvisioner, eventPublisher, cfg.Purge.Retention, logger)
+	purgeTenantsUC := usecase.NewPurgeTenantsUseCase(tenantRepo, archiveRepo, customDomainRepo, invitationRepo, txManager, auditRepo, schemaProvisioner, archiveStore, eventPublisher, logger)
+	changePlanUC := usecase.NewChangePlanUseCase(tenantRepo, planCatalog, featureFlagRepo, memberRepo, usageRepo, txManager, auditRepo, eventPublisher, logger)
+	planHistoryUC := usecase.NewGetPlanHistoryUseCase(tenantRepo, logger)
+	setQuotaUC := usecase.NewSetQuotaOverrideUseCase(tenantRepo, memberRepo, usageRepo, txManager, auditRepo, eventPublisher, logger)
+	removeQuotaUC := usecase.NewRemoveQuotaOverrideUseCase(tenantRepo, memberRepo, usageRepo, txManager, auditRepo, eventPublisher, logger)
+
+	createServiceAccountUC := usecase.NewCreateServiceAccountUseCase(serviceAccountRepo, tenantRepo, logger)
+	getServiceAccountUC := usecase.NewGetServiceAccountUseCase(serviceAccountRepo, logger)
+	deleteServiceAccountUC := usecase.NewDeleteServiceAccountUseCase(serviceAccountRepo, logger)
+	issueAPIKeyUC := usecase.NewIssueAPIKeyUseCase(serviceAccountRepo, logger)
+	revokeAPIKeyUC := usecase.NewRevokeAPIKeyUseCase(serviceAccountRepo, logger)
+
+	listAuditEventsUC := usecase.NewListAuditEventsUseCase(auditRepo, logger)
+
+	getPlanUC := usecase.NewGetPlanUseCase(planCatalog, logger)
+	createPlanUC := usecase.NewCreatePlanUseCase(planRepo, planCatalog, featureFlagRepo, txManager, logger)
+	retirePlanUC := usecase.NewRetirePlanUseCase(planRepo, planCatalog, logger)
+
+	checkEntitlementUC := usecase.NewCheckEntitlementUseCase(tenantRepo, planCatalog, entitlementRepo, memberRepo, storageRepo, logger)
+	consumeEntitlementUC := usecase.NewConsumeEntitlementUseCase(tenantRepo, planCatalog, entitlementRepo, memberRepo, storageRepo, txManager, eventPublisher, logger)
+	releaseEntitlementUC := usecase.NewReleaseEntitlementUseCase(tenantRepo, planCatalog, entitlementRepo, memberRepo, storageRepo, txManager, logger)
+	setEntitlementLimitUC := usecase.NewSetEntitlementLimitUseCase(tenantRepo, entitlementRepo, txManager, auditRepo, logger)
+	removeEntitlementLimitUC := usecase.NewRemoveEntitlementLimitUseCase(tenantRepo, entitlementRepo, txManager, auditRepo, logger)
+
+	meterStorageUC := usecase.NewMeterStorageUseCase(tenantRepo, storageRepo, schemaProvisioner, eventPublisher, logger)
+	storageUsageUC := usecase.NewGetStorageUsageUseCase(tenantRepo, storageRepo, logger)
+
+	evaluateFeaturesUC := usecase.NewEvaluateFeaturesUseCase(tenantRepo, featureFlagRepo, logger)
+	setFeatureOverrideUC := usecase.NewSetFeatureOverrideUseCase(tenantRepo, featureFlagRepo, txManager, auditRepo, eventPublisher, logger)
+	removeFeatureOverrideUC := usecase.NewRemoveFeatureOverrideUseCase(tenantRepo, featureFlagRepo, txManager, auditRepo, eventPublisher, logger)
+	saveFeatureFlagUC := usecase.NewSaveFeatureFlagUseCase(featureFlagRepo, tenantRepo, txManager, auditRepo, eventPublisher, logger)
+
+	renameSlugUC := usecase.NewRenameSlugUseCase(tenantRepo, txManager, auditRepo, eventPublisher, cfg.Slugs.AliasCooldown, logger)
+	releaseSlugAliasUC := usecase.NewReleaseSlugAliasUseCase(tenantRepo, txManager, auditRepo, logger)
+
+	addDomainUC := usecase.NewAddCustomDomainUseCase(tenantRepo, customDomainRepo, txManager, auditRepo, logger)
+	verifyDomainUC := usecase.NewVerifyCustomDomainUseCase(tenantRepo, customDomainRepo, txtResolver, txManager, auditRepo, eventPublisher, logger)
+	revokeDomainUC := usecase.NewRevokeCustomDomainUseCase(tenantRepo, customDomainRepo, txManager, auditRepo, eventPublisher, logger)
+	resolveHostUC := usecase.NewResolveTenantByHostUseCase(tenantRepo, customDomainRepo, logger)
+
+	tenantHierarchyUC := usecase.NewGetTenantHierarchyUseCase(tenantRepo, logger)
+	setTenantParentUC := usecase.NewSetTenantParentUseCase(tenantRepo, txManager, auditRepo, eventPublisher, logger)
+
+	convertTrialUC := usecase.NewConvertTrialUseCase(tenantRepo, planCatalog, featureFlagRepo, memberRepo, usageRepo, txManager, auditRepo, eventPublisher, logger)
+	processTrialsUC := usecase.NewProcessTrialsUseCase(tenantRepo, planCatalog, featureFlagRepo, txManager, auditRepo, eventPublisher, logger)
+
+	scheduleOperationUC := usecase.NewScheduleOperationUseCase(tenantRepo, scheduledOperationRepo, txManager, auditRepo, logger)
+	cancelOperationUC := usecase.NewCancelOperationUseCase(scheduledOperationRepo, txManager, auditRepo, logger)
+	runOperationsUC := usecase.NewRunScheduledOperationsUseCase(tenantRepo, scheduledOperationRepo, suspendTenantUC, activateTenantUC, txManager, auditRepo, eventPublisher, logger)
+
+	addMemberUC := usecase.NewAddMemberUseCase(tenantRepo, memberRepo, txManager, auditRepo, eventPublisher, logger)
+	removeMemberUC := usecase.NewRemoveMemberUseCase(tenantRepo, memberRepo, txManager, auditRepo, eventPublisher, logger)
+	changeMemberRoleUC := usecase.NewChangeMemberRoleUseCase(tenantRepo, memberRepo, txManager, auditRepo, eventPublisher, logger)
+	listUserTenantsUC := usecase.NewListUserTenantsUseCase(tenantRepo, memberRepo, logger)
+
+	inviteMemberUC := usecase.NewInviteMemberUseCase(tenantRepo, invitationRepo, txManager, auditRepo, invitationSigner, mailSender, cfg.Invitations.TTL, cfg.Invitations.AcceptURL, logger)
+	resendInvitationUC := usecase.NewResendInvitationUseCase(tenantRepo, invitationRepo, txManager, auditRepo, invitationSigner, mailSender, cfg.Invitations.TTL, cfg.Invitations.AcceptURL, logger)
+	revokeInvitationUC := usecase.NewRevokeInvitationUseCase(invitationRepo, txManager, auditRepo, logger)
+	acceptInvitationUC := usecase.NewAcceptInvitationUseCase(invitationRepo, addMemberUC, txManager, auditRepo, invitationSigner, logger)
 
 	// ==========================
 	// Initialize HTTP Components
 	// ==========================
 
-	// JWT validator
-	jwtValidator := jwt.NewValidator(cfg.JWT.PublicKeyURL, cfg.JWT.Issuer)
+	// JWT validator (JWKS is refreshed in the background)
+	jwtValidator := jwt.NewValidator(jwt.Config{
+		JWKSURL:         cfg.JWT.PublicKeyURL,
+		Issuer:          cfg.JWT.Issuer,
+		Audience:        cfg.JWT.Audience,
+		TenantClaim:     cfg.JWT.TenantClaim,
+		ClockSkew:       cfg.JWT.ClockSkew,
+		RefreshInterval: cfg.JWT.RefreshInterval,
+	}, logger)
+	jwtValidator.Start(context.Background())
+	defer jwtValidator.Close()
+
+	// Service account API keys (REST only)
+	apiKeyValidator := apikey.NewValidator(serviceAccountRepo, logger)
+
+	// Role to permission mapping shared by HTTP and gRPC; subtree grants
+	// reach descendants through the tenant hierarchy
+	authorizer := rbac.NewAuthorizer(rbac.DefaultPolicy).WithHierarchy(tenantHierarchyUC)
 
 	// Middleware
-	authMiddleware := middleware.NewAuthMiddleware(jwtValidator, logger)
+	authMiddleware := middleware.NewAuthMiddleware(jwtValidator, apiKeyValidator, authorizer, logger)
 	loggingMiddleware := middleware.NewLoggingMiddleware(logger)
 	recoveryMiddleware := middleware.NewRecoveryMiddleware(logger)
 	corsMiddleware := middleware.NewCORSMiddleware()
@@ -180,20 +329,62 @@ func main() {
 		suspendTenantUC,
 		activateTenantUC,
 		deleteTenantUC,
+		tenantHistoryUC,
+		archiveTenantUC,
+		unarchiveTenantUC,
+		restoreTenantUC,
+		changePlanUC,
+		planHistoryUC,
+		setQuotaUC,
+		removeQuotaUC,
+		authMiddleware,
 		logger,
 	)
+	serviceAccountHandler := handler.NewServiceAccountHandler(
+		createServiceAccountUC,
+		getServiceAccountUC,
+		deleteServiceAccountUC,
+		issueAPIKeyUC,
+		revokeAPIKeyUC,
+		logger,
+	)
+	auditHandler := handler.NewAuditHandler(listAuditEventsUC, logger)
+	planHandler := handler.NewPlanHandler(getPlanUC, createPlanUC, retirePlanUC, logger)
+	entitlementHandler := handler.NewEntitlementHandler(checkEntitlementUC, setEntitlementLimitUC, removeEntitlementLimitUC, logger)
+	storageHandler := handler.NewStorageHandler(storageUsageUC, logger)
+	featureHandler := handler.NewFeatureHandler(evaluateFeaturesUC, setFeatureOverrideUC, removeFeatureOverrideUC, saveFeatureFlagUC, logger)
+	slugHandler := handler.NewSlugHandler(getTenantUC, renameSlugUC, releaseSlugAliasUC, logger)
+	domainHandler := handler.NewDomainHandler(addDomainUC, verifyDomainUC, revokeDomainUC, logger)
+	hierarchyHandler := handler.NewHierarchyHandler(tenantHierarchyUC, setTenantParentUC, logger)
+	trialHandler := handler.NewTrialHandler(convertTrialUC, logger)
+	operationHandler := handler.NewOperationHandler(scheduleOperationUC, cancelOperationUC, logger)
+	memberHandler := handler.NewMemberHandler(addMemberUC, removeMemberUC, changeMemberRoleUC, logger)
+	invitationHandler := handler.NewInvitationHandler(inviteMemberUC, resendInvitationUC, revokeInvitationUC, acceptInvitationUC, logger)
 	healthHandler := handler.NewHealthHandler(db, logger)
 
 	// Router
 	routerConfig := http.RouterConfig{
-		TenantHandler:      tenantHandler,
-		HealthHandler:      healthHandler,
-		AuthMiddleware:     authMiddleware,
-		LoggingMiddleware:  loggingMiddleware,
-		RecoveryMiddleware: recoveryMiddleware,
-		CORSMiddleware:     corsMiddleware,
-		MetricsMiddleware:  metricsMiddleware,
-		Logger:             logger,
+		TenantHandler:         tenantHandler,
+		ServiceAccountHandler: serviceAccountHandler,
+		AuditHandler:          auditHandler,
+		PlanHandler:           planHandler,
+		EntitlementHandler:    entitlementHandler,
+		StorageHandler:        storageHandler,
+		FeatureHandler:        featureHandler,
+		SlugHandler:           slugHandler,
+		DomainHandler:         domainHandler,
+		HierarchyHandler:      hierarchyHandler,
+		TrialHandler:          trialHandler,
+		OperationHandler:      operationHandler,
+		MemberHandler:         memberHandler,
+		InvitationHandler:     invitationHandler,
+		HealthHandler:         healthHandler,
+		AuthMiddleware:        authMiddleware,
+		LoggingMiddleware:     loggingMiddleware,
+		RecoveryMiddleware:    recoveryMiddleware,
+		CORSMiddleware:        corsMiddleware,
+		MetricsMiddleware:     metricsMiddleware,
+		Logger:                logger,
 	}
 	router := http.NewRouter(routerConfig)
 
@@ -205,10 +396,56 @@ func main() {
 	// ==========================
 
 	// gRPC service
-	tenantGRPCService := grpc.NewTenantServiceServer(getTenantUC, listTenantsUC, logger)
+	tenantGRPCService := grpc.NewTenantServiceServer(
+		getTenantUC,
+		listTenantsUC,
+		changePlanUC,
+		checkEntitlementUC,
+		consumeEntitlementUC,
+		releaseEntitlementUC,
+		evaluateFeaturesUC,
+		resolveHostUC,
+		tenantHierarchyUC,
+		listUserTenantsUC,
+		logger,
+	)
+
+	// Service identities allowed to call without a JWT (mTLS only)
+	serviceAllowlist, err := interceptor.ParseServiceAllowlist(cfg.GRPCTLS.ServiceAllowlist)
+	if err != nil {
+		logger.Fatal("Invalid gRPC service allowlist", zap.Error(err))
+	}
 
-	// gRPC Server
-	grpcServer := grpc.NewServer(cfg.Server.GRPCPort, tenantGRPCService, logger)
+	// Keycloak clients whose client credentials tokens are service identities
+	serviceClients := interceptor.ParseServiceClients(cfg.Server.GRPCServiceClients)
+
+	// Optional TLS/mTLS with certificates reloaded from disk
+	var grpcTLSConfig *tls.Config
+	if cfg.GRPCTLS.Enabled {
+		certReloader, err := tlsreload.NewReloader(tlsreload.Config{
+			CertFile:       cfg.GRPCTLS.CertFile,
+			KeyFile:        cfg.GRPCTLS.KeyFile,
+			ClientCAFile:   cfg.GRPCTLS.ClientCAFile,
+			ClientAuth:     tlsreload.ClientAuthMode(cfg.GRPCTLS.ClientAuth),
+			ReloadInterval: cfg.GRPCTLS.ReloadInterval,
+		}, logger)
+		if err != nil {
+			logger.Fatal("Failed to load gRPC TLS certificates", zap.Error(err))
+		}
+		certReloader.Start()
+		defer certReloader.Close()
+
+		grpcTLSConfig = certReloader.TLSConfig()
+		logger.Info("gRPC TLS enabled",
+			zap.String("client_auth", cfg.GRPCTLS.ClientAuth),
+			zap.Int("allowlisted_services", len(serviceAllowlist)),
+		)
+	} else if len(serviceAllowlist) > 0 {
+		logger.Warn("gRPC service allowlist ignored: TLS is disabled")
+	}
+
+	// gRPC Server (shares the JWT validator with the HTTP middleware)
+	grpcServer := grpc.NewServer(cfg.Server.GRPCPort, tenantGRPCService, jwtValidator, authorizer, serviceAllowlist, serviceClients, grpcTLSConfig, logger)
 
 	// ==========================
 	// Start Both Servers
@@ -242,6 +479,91 @@ func main() {
 		}
 	}()
 
+	// ==========================
+	// Start Background Workers
+	// ==========================
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+
+	if cfg.Purge.Enabled {
+		purgeWorker := worker.NewPurgeWorker(purgeTenantsUC, advisoryLocker, worker.PurgeConfig{
+			Interval:  cfg.Purge.Interval,
+			Retention: cfg.Purge.Retention,
+			BatchSize: cfg.Purge.BatchSize,
+			DryRun:    cfg.Purge.DryRun,
+		}, logger)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			purgeWorker.Run(workerCtx)
+		}()
+	} else {
+		logger.Info("Purge worker disabled")
+	}
+
+	if cfg.Storage.Enabled {
+		storageWorker := worker.NewStorageWorker(meterStorageUC, advisoryLocker, metrics, worker.StorageConfig{
+			Interval:  cfg.Storage.Interval,
+			Retention: cfg.Storage.Retention,
+		}, logger)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			storageWorker.Run(workerCtx)
+		}()
+	} else {
+		logger.Info("Storage metering worker disabled")
+	}
+
+	if cfg.Domains.Enabled {
+		domainWorker := worker.NewDomainWorker(verifyDomainUC, advisoryLocker, worker.DomainConfig{
+			Interval:     cfg.Domains.Interval,
+			RecheckAfter: cfg.Domains.RecheckAfter,
+			BatchSize:    cfg.Domains.BatchSize,
+		}, logger)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			domainWorker.Run(workerCtx)
+		}()
+	} else {
+		logger.Info("Domain verification worker disabled")
+	}
+
+	if cfg.Trials.Enabled {
+		trialWorker := worker.NewTrialWorker(processTrialsUC, advisoryLocker, worker.TrialConfig{
+			Interval:        cfg.Trials.Interval,
+			ReminderOffsets: cfg.Trials.ReminderOffsets,
+			BatchSize:       cfg.Trials.BatchSize,
+		}, logger)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trialWorker.Run(workerCtx)
+		}()
+	} else {
+		logger.Info("Trial worker disabled")
+	}
+
+	if cfg.Operations.Enabled {
+		operationWorker := worker.NewOperationWorker(runOperationsUC, advisoryLocker, worker.OperationConfig{
+			Interval:  cfg.Operations.Interval,
+			BatchSize: cfg.Operations.BatchSize,
+		}, logger)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			operationWorker.Run(workerCtx)
+		}()
+	} else {
+		logger.Info("Scheduled operations worker disabled")
+	}
+
 	// Wait for shutdown signal or server error
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
@@ -253,12 +575,13 @@ func main() {
 		logger.Info("Shutdown signal received", zap.String("signal", sig.String()))
 	}
 
-	// Shutdown both servers
+	// Shutdown both servers and the workers
 	logger.Info("Shutting down servers...")
+	stopWorkers()
 	httpServer.Shutdown()
 	grpcServer.Shutdown()
 
-	// Wait for both servers to stop
+	// Wait for servers and workers to stop
 	wg.Wait()
 
 	logger.Info("Tenant Manager Service stopped")
@@ -304,3 +627,122 @@ func (p *noopEventPublisher) PublishTenantDeleted(ctx context.Context, tenant *d
 	)
 	return nil
 }
+
+func (p *noopEventPublisher) PublishTenantArchived(ctx context.Context, tenant *domain.Tenant) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantUnarchived(ctx context.Context, tenant *domain.Tenant) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantRestored(ctx context.Context, tenant *domain.Tenant) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantPurged(ctx context.Context, tenant *domain.Tenant) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantQuotaChanged(ctx context.Context, tenant *domain.Tenant) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishEntitlementThresholdReached(ctx context.Context, tenant *domain.Tenant, usage *domain.EntitlementUsage, threshold int) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantStorageOverQuota(ctx context.Context, tenant *domain.Tenant, snapshot *domain.StorageSnapshot) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantFeaturesChanged(ctx context.Context, tenant *domain.Tenant, features []*domain.FeatureValue) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantSlugChanged(ctx context.Context, tenant *domain.Tenant, previousSlug string) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantDomainChanged(ctx context.Context, tenant *domain.Tenant, d *domain.CustomDomain) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantTrialReminder(ctx context.Context, tenant *domain.Tenant, remaining time.Duration) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantTrialEnded(ctx context.Context, tenant *domain.Tenant) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantOperationFailed(ctx context.Context, tenant *domain.Tenant, op *domain.ScheduledOperation) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantMemberAdded(ctx context.Context, tenant *domain.Tenant, m *domain.Member) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantMemberRemoved(ctx context.Context, tenant *domain.Tenant, m *domain.Member) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantMemberRoleChanged(ctx context.Context, tenant *domain.Tenant, m *domain.Member, previousRole domain.MemberRole) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
+
+func (p *noopEventPublisher) PublishTenantPlanChanged(ctx context.Context, tenant *domain.Tenant, change *domain.PlanChange) error {
+	p.logger.Debug("Event publishing not implemented yet (noop)",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+	return nil
+}
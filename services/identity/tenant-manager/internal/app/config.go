@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -13,6 +14,17 @@ type Config struct {
 	Database    DatabaseConfig
 	Kafka       KafkaConfig
 	JWT         JWTConfig
+	GRPCTLS     GRPCTLSConfig
+	Archive     ArchiveConfig
+	Purge       PurgeConfig
+	Storage     StorageConfig
+	Plans       PlansConfig
+	Slugs       SlugsConfig
+	Domains     DomainsConfig
+	Trials      TrialsConfig
+	Operations  OperationsConfig
+	Mail        MailConfig
+	Invitations InvitationsConfig
 	Observability ObservabilityConfig
 }
 
@@ -22,6 +34,9 @@ type ServerConfig struct {
 	GRPCPort int    `mapstructure:"GRPC_PORT"`
 	Env      string `mapstructure:"ENV"`
 	LogLevel string `mapstructure:"LOG_LEVEL"`
+	// GRPCServiceClients lists the Keycloak clients whose client credentials
+	// tokens may call ValidateTenant without a permission
+	GRPCServiceClients string `mapstructure:"GRPC_SERVICE_CLIENTS"`
 }
 
 // DatabaseConfig holds database configuration
@@ -58,9 +73,95 @@ type KafkaConfig struct {
 
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	PublicKeyURL string `mapstructure:"JWT_PUBLIC_KEY_URL"`
-	Issuer       string `mapstructure:"JWT_ISSUER"`
-	Audience     string `mapstructure:"JWT_AUDIENCE"`
+	PublicKeyURL    string        `mapstructure:"JWT_PUBLIC_KEY_URL"`
+	Issuer          string        `mapstructure:"JWT_ISSUER"`
+	Audience        string        `mapstructure:"JWT_AUDIENCE"`
+	TenantClaim     string        `mapstructure:"JWT_TENANT_CLAIM"`
+	ClockSkew       time.Duration `mapstructure:"JWT_CLOCK_SKEW"`
+	RefreshInterval time.Duration `mapstructure:"JWT_JWKS_REFRESH_INTERVAL"`
+}
+
+// GRPCTLSConfig holds gRPC transport security configuration
+type GRPCTLSConfig struct {
+	Enabled          bool          `mapstructure:"GRPC_TLS_ENABLED"`
+	CertFile         string        `mapstructure:"GRPC_TLS_CERT_FILE"`
+	KeyFile          string        `mapstructure:"GRPC_TLS_KEY_FILE"`
+	ClientCAFile     string        `mapstructure:"GRPC_TLS_CLIENT_CA_FILE"`
+	ClientAuth       string        `mapstructure:"GRPC_TLS_CLIENT_AUTH"` // none, request, require
+	ReloadInterval   time.Duration `mapstructure:"GRPC_TLS_RELOAD_INTERVAL"`
+	ServiceAllowlist string        `mapstructure:"GRPC_SERVICE_ALLOWLIST"`
+}
+
+// ArchiveConfig holds tenant archive storage configuration
+type ArchiveConfig struct {
+	LocalPath string `mapstructure:"ARCHIVE_LOCAL_PATH"`
+}
+
+// PurgeConfig holds the schedule of the deleted-tenant purge worker
+type PurgeConfig struct {
+	Enabled   bool          `mapstructure:"PURGE_ENABLED"`
+	Interval  time.Duration `mapstructure:"PURGE_INTERVAL"`
+	Retention time.Duration `mapstructure:"PURGE_RETENTION"`
+	BatchSize int           `mapstructure:"PURGE_BATCH_SIZE"`
+	DryRun    bool          `mapstructure:"PURGE_DRY_RUN"`
+}
+
+// StorageConfig holds the schedule of the tenant storage metering worker
+type StorageConfig struct {
+	Enabled   bool          `mapstructure:"STORAGE_METERING_ENABLED"`
+	Interval  time.Duration `mapstructure:"STORAGE_METERING_INTERVAL"`
+	Retention time.Duration `mapstructure:"STORAGE_SNAPSHOT_RETENTION"`
+}
+
+// PlansConfig holds plan catalog configuration
+type PlansConfig struct {
+	CacheTTL time.Duration `mapstructure:"PLAN_CATALOG_CACHE_TTL"`
+}
+
+// SlugsConfig holds tenant slug configuration
+type SlugsConfig struct {
+	AliasCooldown time.Duration `mapstructure:"SLUG_ALIAS_COOLDOWN"`
+}
+
+// DomainsConfig holds custom domain verification configuration
+type DomainsConfig struct {
+	Enabled       bool          `mapstructure:"DOMAIN_VERIFICATION_ENABLED"`
+	Interval      time.Duration `mapstructure:"DOMAIN_VERIFICATION_INTERVAL"`
+	RecheckAfter  time.Duration `mapstructure:"DOMAIN_RECHECK_AFTER"`
+	BatchSize     int           `mapstructure:"DOMAIN_VERIFICATION_BATCH_SIZE"`
+	Nameserver    string        `mapstructure:"DNS_NAMESERVER"`
+	LookupTimeout time.Duration `mapstructure:"DNS_LOOKUP_TIMEOUT"`
+}
+
+// TrialsConfig holds trial reminder and expiry configuration
+type TrialsConfig struct {
+	Enabled         bool            `mapstructure:"TRIAL_WORKER_ENABLED"`
+	Interval        time.Duration   `mapstructure:"TRIAL_WORKER_INTERVAL"`
+	ReminderOffsets []time.Duration `mapstructure:"TRIAL_REMINDER_OFFSETS"`
+	BatchSize       int             `mapstructure:"TRIAL_BATCH_SIZE"`
+}
+
+// OperationsConfig holds scheduled lifecycle operations configuration
+type OperationsConfig struct {
+	Enabled   bool          `mapstructure:"SCHEDULED_OPERATIONS_ENABLED"`
+	Interval  time.Duration `mapstructure:"SCHEDULED_OPERATIONS_INTERVAL"`
+	BatchSize int           `mapstructure:"SCHEDULED_OPERATIONS_BATCH_SIZE"`
+}
+
+// MailConfig holds outgoing mail configuration
+type MailConfig struct {
+	Driver      string        `mapstructure:"MAIL_DRIVER"`
+	From        string        `mapstructure:"MAIL_FROM"`
+	FileDir     string        `mapstructure:"MAIL_FILE_DIR"`
+	SMTPAddr    string        `mapstructure:"MAIL_SMTP_ADDR"`
+	SMTPTimeout time.Duration `mapstructure:"MAIL_SMTP_TIMEOUT"`
+}
+
+// InvitationsConfig holds tenant invitation configuration
+type InvitationsConfig struct {
+	SigningKey string        `mapstructure:"INVITATION_SIGNING_KEY"`
+	TTL        time.Duration `mapstructure:"INVITATION_TTL"`
+	AcceptURL  string        `mapstructure:"INVITATION_ACCEPT_URL"`
 }
 
 // ObservabilityConfig holds observability configuration
@@ -94,6 +195,54 @@ func LoadConfig() (*Config, error) {
 	viper.SetDefault("KAFKA_MAX_RETRY", 3)
 	viper.SetDefault("KAFKA_TOPIC_TENANT_LIFECYCLE", "tenant.lifecycle")
 
+	viper.SetDefault("JWT_TENANT_CLAIM", "tenant_id")
+	viper.SetDefault("JWT_CLOCK_SKEW", "30s")
+	viper.SetDefault("JWT_JWKS_REFRESH_INTERVAL", "15m")
+
+	viper.SetDefault("GRPC_TLS_ENABLED", false)
+	viper.SetDefault("GRPC_TLS_CLIENT_AUTH", "request")
+	viper.SetDefault("GRPC_TLS_RELOAD_INTERVAL", "1m")
+
+	viper.SetDefault("ARCHIVE_LOCAL_PATH", "./data/archives")
+
+	viper.SetDefault("PURGE_ENABLED", true)
+	viper.SetDefault("PURGE_INTERVAL", "1h")
+	viper.SetDefault("PURGE_RETENTION", "720h")
+	viper.SetDefault("PURGE_BATCH_SIZE", 10)
+	viper.SetDefault("PURGE_DRY_RUN", false)
+
+	viper.SetDefault("STORAGE_METERING_ENABLED", true)
+	viper.SetDefault("STORAGE_METERING_INTERVAL", "1h")
+	viper.SetDefault("STORAGE_SNAPSHOT_RETENTION", "2160h")
+
+	viper.SetDefault("PLAN_CATALOG_CACHE_TTL", "1m")
+
+	viper.SetDefault("SLUG_ALIAS_COOLDOWN", "2160h")
+
+	viper.SetDefault("DOMAIN_VERIFICATION_ENABLED", true)
+	viper.SetDefault("DOMAIN_VERIFICATION_INTERVAL", "15m")
+	viper.SetDefault("DOMAIN_RECHECK_AFTER", "6h")
+	viper.SetDefault("DOMAIN_VERIFICATION_BATCH_SIZE", 100)
+	viper.SetDefault("DNS_LOOKUP_TIMEOUT", "5s")
+
+	viper.SetDefault("TRIAL_WORKER_ENABLED", true)
+	viper.SetDefault("TRIAL_WORKER_INTERVAL", "1h")
+	viper.SetDefault("TRIAL_REMINDER_OFFSETS", "168h,72h,24h")
+	viper.SetDefault("TRIAL_BATCH_SIZE", 100)
+
+	viper.SetDefault("SCHEDULED_OPERATIONS_ENABLED", true)
+	viper.SetDefault("SCHEDULED_OPERATIONS_INTERVAL", "1m")
+	viper.SetDefault("SCHEDULED_OPERATIONS_BATCH_SIZE", 100)
+
+	viper.SetDefault("MAIL_DRIVER", "file")
+	viper.SetDefault("MAIL_FROM", "Cotai <no-reply@cotai.local>")
+	viper.SetDefault("MAIL_FILE_DIR", "./data/mail")
+	viper.SetDefault("MAIL_SMTP_ADDR", "localhost:1025")
+	viper.SetDefault("MAIL_SMTP_TIMEOUT", "10s")
+
+	viper.SetDefault("INVITATION_TTL", "168h")
+	viper.SetDefault("INVITATION_ACCEPT_URL", "http://localhost:3000/invitations/accept")
+
 	viper.SetDefault("JAEGER_SAMPLER_TYPE", "probabilistic")
 	viper.SetDefault("JAEGER_SAMPLER_PARAM", 0.1)
 	viper.SetDefault("PROMETHEUS_ENABLED", true)
@@ -102,6 +251,7 @@ func LoadConfig() (*Config, error) {
 
 	config.Server.Port = viper.GetInt("PORT")
 	config.Server.GRPCPort = viper.GetInt("GRPC_PORT")
+	config.Server.GRPCServiceClients = viper.GetString("GRPC_SERVICE_CLIENTS")
 	config.Server.Env = viper.GetString("ENV")
 	config.Server.LogLevel = viper.GetString("LOG_LEVEL")
 
@@ -130,6 +280,64 @@ func LoadConfig() (*Config, error) {
 	config.JWT.PublicKeyURL = viper.GetString("JWT_PUBLIC_KEY_URL")
 	config.JWT.Issuer = viper.GetString("JWT_ISSUER")
 	config.JWT.Audience = viper.GetString("JWT_AUDIENCE")
+	config.JWT.TenantClaim = viper.GetString("JWT_TENANT_CLAIM")
+	config.JWT.ClockSkew = viper.GetDuration("JWT_CLOCK_SKEW")
+	config.JWT.RefreshInterval = viper.GetDuration("JWT_JWKS_REFRESH_INTERVAL")
+
+	config.GRPCTLS.Enabled = viper.GetBool("GRPC_TLS_ENABLED")
+	config.GRPCTLS.CertFile = viper.GetString("GRPC_TLS_CERT_FILE")
+	config.GRPCTLS.KeyFile = viper.GetString("GRPC_TLS_KEY_FILE")
+	config.GRPCTLS.ClientCAFile = viper.GetString("GRPC_TLS_CLIENT_CA_FILE")
+	config.GRPCTLS.ClientAuth = viper.GetString("GRPC_TLS_CLIENT_AUTH")
+	config.GRPCTLS.ReloadInterval = viper.GetDuration("GRPC_TLS_RELOAD_INTERVAL")
+	config.GRPCTLS.ServiceAllowlist = viper.GetString("GRPC_SERVICE_ALLOWLIST")
+
+	config.Archive.LocalPath = viper.GetString("ARCHIVE_LOCAL_PATH")
+
+	config.Purge.Enabled = viper.GetBool("PURGE_ENABLED")
+	config.Purge.Interval = viper.GetDuration("PURGE_INTERVAL")
+	config.Purge.Retention = viper.GetDuration("PURGE_RETENTION")
+	config.Purge.BatchSize = viper.GetInt("PURGE_BATCH_SIZE")
+	config.Purge.DryRun = viper.GetBool("PURGE_DRY_RUN")
+
+	config.Storage.Enabled = viper.GetBool("STORAGE_METERING_ENABLED")
+	config.Storage.Interval = viper.GetDuration("STORAGE_METERING_INTERVAL")
+	config.Storage.Retention = viper.GetDuration("STORAGE_SNAPSHOT_RETENTION")
+
+	config.Plans.CacheTTL = viper.GetDuration("PLAN_CATALOG_CACHE_TTL")
+
+	config.Slugs.AliasCooldown = viper.GetDuration("SLUG_ALIAS_COOLDOWN")
+
+	config.Domains.Enabled = viper.GetBool("DOMAIN_VERIFICATION_ENABLED")
+	config.Domains.Interval = viper.GetDuration("DOMAIN_VERIFICATION_INTERVAL")
+	config.Domains.RecheckAfter = viper.GetDuration("DOMAIN_RECHECK_AFTER")
+	config.Domains.BatchSize = viper.GetInt("DOMAIN_VERIFICATION_BATCH_SIZE")
+	config.Domains.Nameserver = viper.GetString("DNS_NAMESERVER")
+	config.Domains.LookupTimeout = viper.GetDuration("DNS_LOOKUP_TIMEOUT")
+
+	config.Trials.Enabled = viper.GetBool("TRIAL_WORKER_ENABLED")
+	config.Trials.Interval = viper.GetDuration("TRIAL_WORKER_INTERVAL")
+	config.Trials.BatchSize = viper.GetInt("TRIAL_BATCH_SIZE")
+	// Parse trial reminder offsets (comma-separated)
+	offsets, err := parseDurations(viper.GetString("TRIAL_REMINDER_OFFSETS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TRIAL_REMINDER_OFFSETS: %w", err)
+	}
+	config.Trials.ReminderOffsets = offsets
+
+	config.Operations.Enabled = viper.GetBool("SCHEDULED_OPERATIONS_ENABLED")
+	config.Operations.Interval = viper.GetDuration("SCHEDULED_OPERATIONS_INTERVAL")
+	config.Operations.BatchSize = viper.GetInt("SCHEDULED_OPERATIONS_BATCH_SIZE")
+
+	config.Mail.Driver = viper.GetString("MAIL_DRIVER")
+	config.Mail.From = viper.GetString("MAIL_FROM")
+	config.Mail.FileDir = viper.GetString("MAIL_FILE_DIR")
+	config.Mail.SMTPAddr = viper.GetString("MAIL_SMTP_ADDR")
+	config.Mail.SMTPTimeout = viper.GetDuration("MAIL_SMTP_TIMEOUT")
+
+	config.Invitations.SigningKey = viper.GetString("INVITATION_SIGNING_KEY")
+	config.Invitations.TTL = viper.GetDuration("INVITATION_TTL")
+	config.Invitations.AcceptURL = viper.GetString("INVITATION_ACCEPT_URL")
 
 	config.Observability.JaegerAgentHost = viper.GetString("JAEGER_AGENT_HOST")
 	config.Observability.JaegerAgentPort = viper.GetInt("JAEGER_AGENT_PORT")
@@ -140,3 +348,23 @@ func LoadConfig() (*Config, error) {
 
 	return config, nil
 }
+
+// parseDurations parses a comma-separated list of durations
+func parseDurations(value string) ([]time.Duration, error) {
+	var durations []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("duration must be positive, got %s", d)
+		}
+		durations = append(durations, d)
+	}
+	return durations, nil
+}
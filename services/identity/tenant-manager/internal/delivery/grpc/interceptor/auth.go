@@ -2,72 +2,296 @@ package interceptor
 
 import (
 	"context"
+	"errors"
+	"strings"
 
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
-// AuthInterceptor creates a unary server interceptor for authentication
-// For Phase F, this is a basic implementation
-// TODO: Implement full JWT validation with Keycloak in future phases
-func AuthInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+// errorDomain is reported in ErrorInfo details
+const errorDomain = "tenant-manager.cotai"
+
+var (
+	errMissingMetadata      = errors.New("missing metadata")
+	errMissingAuthorization = errors.New("missing authorization header")
+	errInvalidAuthorization = errors.New("authorization header must be a Bearer token")
+)
+
+// MethodPolicy describes who may invoke a gRPC method
+type MethodPolicy struct {
+	// Public methods skip authentication entirely
+	Public bool
+	// Permission is required to call the method. Tenant-scoped grants apply
+	// when the request carries a tenant_id matching the caller's tenant.
+	Permission rbac.Permission
+	// AllowServiceIdentity admits the client credentials token of any
+	// listed service client. Only ValidateTenant sets it: other methods
+	// require the permission or an allowlisted client certificate.
+	AllowServiceIdentity bool
+}
+
+// DefaultMethodPolicies maps gRPC methods to their required permissions.
+// Methods missing from the map are denied.
+var DefaultMethodPolicies = map[string]MethodPolicy{
+	tenantv1.TenantService_GetTenant_FullMethodName: {
+		Permission: rbac.TenantRead,
+	},
+	tenantv1.TenantService_GetTenantBySlug_FullMethodName: {
+		Permission: rbac.TenantRead,
+	},
+	tenantv1.TenantService_ResolveTenantByHost_FullMethodName: {
+		Permission: rbac.TenantRead,
+	},
+	tenantv1.TenantService_GetTenantHierarchy_FullMethodName: {
+		Permission: rbac.TenantRead,
+	},
+	tenantv1.TenantService_ValidateTenant_FullMethodName: {
+		Permission:           rbac.TenantRead,
+		AllowServiceIdentity: true,
+	},
+	tenantv1.TenantService_ListTenants_FullMethodName: {
+		Permission: rbac.TenantList,
+	},
+	tenantv1.TenantService_ChangePlan_FullMethodName: {
+		Permission: rbac.TenantChangePlan,
+	},
+	tenantv1.TenantService_CheckEntitlement_FullMethodName: {
+		Permission: rbac.EntitlementCheck,
+	},
+	tenantv1.TenantService_ConsumeEntitlement_FullMethodName: {
+		Permission: rbac.EntitlementConsume,
+	},
+	tenantv1.TenantService_ReleaseEntitlement_FullMethodName: {
+		Permission: rbac.EntitlementConsume,
+	},
+	tenantv1.TenantService_EvaluateFeatures_FullMethodName: {
+		Permission: rbac.FeatureEvaluate,
+	},
+	// Spans every tenant of a user, so tenant-scoped grants never apply
+	tenantv1.TenantService_ListUserTenants_FullMethodName: {
+		Permission: rbac.TenantList,
+	},
+
+	// Infrastructure services
+	grpc_health_v1.Health_Check_FullMethodName:                       {Public: true},
+	grpc_health_v1.Health_Watch_FullMethodName:                       {Public: true},
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      {Public: true},
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": {Public: true},
+}
+
+// Authenticator validates bearer tokens and enforces method policies.
+// Callers presenting an allowlisted client certificate skip the JWT check.
+type Authenticator struct {
+	validator  middleware.JWTValidator
+	authorizer *rbac.Authorizer
+	policies   map[string]MethodPolicy
+	allowlist  ServiceAllowlist
+	clients    ServiceClients
+	logger     *zap.Logger
+}
+
+// NewAuthenticator creates a new gRPC authenticator. The allowlist and the
+// service clients may be nil.
+func NewAuthenticator(
+	validator middleware.JWTValidator,
+	authorizer *rbac.Authorizer,
+	policies map[string]MethodPolicy,
+	allowlist ServiceAllowlist,
+	clients ServiceClients,
+	logger *zap.Logger,
+) *Authenticator {
+	return &Authenticator{
+		validator:  validator,
+		authorizer: authorizer,
+		policies:   policies,
+		allowlist:  allowlist,
+		clients:    clients,
+		logger:     logger,
+	}
+}
+
+// AuthInterceptor creates a unary server interceptor for authentication and authorization
+func (a *Authenticator) AuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// For internal gRPC communication, we can skip strict auth for now
-		// In production, you would:
-		// 1. Extract metadata from context
-		// 2. Validate JWT token
-		// 3. Check required scopes/roles
-
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			// Log metadata for debugging
-			logger.Debug("gRPC request metadata",
-				zap.String("method", info.FullMethod),
-				zap.Any("metadata", md),
-			)
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
 		}
 
-		// For now, allow all requests (internal service-to-service)
-		// TODO: Implement proper authentication
 		return handler(ctx, req)
 	}
 }
 
-// RequireAuth is a stricter version that requires authorization header
-// Use this for sensitive operations
-func RequireAuth(logger *zap.Logger) grpc.UnaryServerInterceptor {
+// StreamAuthInterceptor creates a stream server interceptor applying the same policies
+func (a *Authenticator) StreamAuthInterceptor() grpc.StreamServerInterceptor {
 	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize authenticates the caller and checks the method policy.
+// On success the returned context carries the token claims or the
+// service identity of an allowlisted mTLS caller.
+func (a *Authenticator) authorize(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	policy, ok := a.policies[method]
+	if !ok {
+		a.logger.Warn("gRPC method has no auth policy, denying",
+			zap.String("method", method),
+		)
+		return nil, statusError(codes.PermissionDenied, "method not allowed", "METHOD_NOT_ALLOWED", method)
+	}
+
+	if policy.Public {
+		return ctx, nil
+	}
+
+	identities := peerIdentities(ctx)
+	for _, identity := range identities {
+		if a.allowlist.Allows(identity, method) {
+			ctx = withActor(ctx, actor.Actor{Type: domain.ActorService, ID: identity, Name: identity})
+			return ContextWithServiceIdentity(ctx, identity), nil
 		}
+	}
 
-		// Check for authorization header
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	token, err := bearerToken(ctx)
+	if err != nil {
+		if len(identities) > 0 {
+			a.logger.Warn("gRPC service identity not allowlisted",
+				zap.String("method", method),
+				zap.Strings("identities", identities),
+			)
+			return nil, statusError(codes.PermissionDenied, "service identity not allowed", "SERVICE_NOT_ALLOWED", method)
 		}
+		return nil, statusError(codes.Unauthenticated, err.Error(), "MISSING_CREDENTIALS", method)
+	}
 
-		// TODO: Validate JWT token
-		// For now, just log and allow
-		logger.Debug("gRPC auth check",
-			zap.String("method", info.FullMethod),
-			zap.Bool("has_auth", len(authHeaders) > 0),
+	claims, err := a.validator.ValidateToken(token)
+	if err != nil {
+		a.logger.Warn("gRPC token validation failed",
+			zap.String("method", method),
+			zap.Error(err),
 		)
+		return nil, statusError(codes.Unauthenticated, "invalid or expired token", "INVALID_TOKEN", method)
+	}
 
-		return handler(ctx, req)
+	targetTenantID := requestTenantID(req)
+	if !a.allows(ctx, policy, claims, targetTenantID) {
+		a.logger.Warn("gRPC call forbidden",
+			zap.String("method", method),
+			zap.String("subject", claims.Subject),
+			zap.String("permission", string(policy.Permission)),
+			zap.String("target_tenant_id", targetTenantID),
+			zap.Strings("roles", claims.Roles),
+		)
+		return nil, statusError(codes.PermissionDenied, "insufficient permissions", "INSUFFICIENT_PERMISSIONS", method)
+	}
+
+	ctx = withActor(ctx, claims.Actor())
+	return middleware.ContextWithClaims(ctx, claims), nil
+}
+
+// withActor stores the audit actor with the request ID and peer address of the call
+func withActor(ctx context.Context, a actor.Actor) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-request-id"); len(values) > 0 {
+			a.RequestID = values[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		a.SourceIP = actor.HostOnly(p.Addr.String())
+	}
+	return actor.WithActor(ctx, a)
+}
+
+// allows reports whether the claims satisfy the policy
+func (a *Authenticator) allows(ctx context.Context, policy MethodPolicy, claims *middleware.TokenClaims, targetTenantID string) bool {
+	if policy.AllowServiceIdentity && a.clients.Identifies(claims) {
+		return true
+	}
+	if policy.Permission == "" {
+		return false
+	}
+	return a.authorizer.CanContext(ctx, claims.Principal(), policy.Permission, targetTenantID)
+}
+
+// requestTenantID returns the tenant_id field of the request, if it has one
+func requestTenantID(req interface{}) string {
+	if r, ok := req.(interface{ GetTenantId() string }); ok {
+		return r.GetTenantId()
+	}
+	return ""
+}
+
+// bearerToken extracts the bearer token from the incoming metadata
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errMissingMetadata
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errMissingAuthorization
+	}
+
+	parts := strings.SplitN(values[0], " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || parts[1] == "" {
+		return "", errInvalidAuthorization
+	}
+
+	return parts[1], nil
+}
+
+// statusError builds a gRPC status error with ErrorInfo details
+func statusError(code codes.Code, message, reason, method string) error {
+	st := status.New(code, message)
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"method": method},
+	})
+	if err != nil {
+		return st.Err()
 	}
+
+	return detailed.Err()
+}
+
+// authenticatedStream overrides the stream context with the authenticated one
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the authenticated context
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
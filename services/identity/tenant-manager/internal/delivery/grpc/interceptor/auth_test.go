@@ -0,0 +1,150 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// claimsValidator accepts any token and returns fixed claims
+type claimsValidator struct {
+	claims *middleware.TokenClaims
+}
+
+func (v claimsValidator) ValidateToken(string) (*middleware.TokenClaims, error) {
+	return v.claims, nil
+}
+
+func bearerContext() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+}
+
+func TestAuthenticator_DefaultMethodPolicies(t *testing.T) {
+	const (
+		ownTenant   = "550e8400-e29b-41d4-a716-446655440000"
+		otherTenant = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	)
+
+	serviceAccount := &middleware.TokenClaims{
+		Subject:        "svc",
+		Username:       middleware.ServiceAccountUsername("bidding"),
+		ClientID:       "bidding",
+		ServiceAccount: true,
+	}
+	// An unlisted client's service account, and a user who merely has the
+	// service account user name
+	unlistedService := &middleware.TokenClaims{
+		Subject:        "svc",
+		Username:       middleware.ServiceAccountUsername("reports"),
+		ClientID:       "reports",
+		ServiceAccount: true,
+	}
+	lookalikeUser := &middleware.TokenClaims{
+		Subject:  "user",
+		Username: middleware.ServiceAccountUsername("bidding"),
+		ClientID: "bidding",
+	}
+	serviceReader := &middleware.TokenClaims{
+		Subject:        "catalog",
+		Username:       middleware.ServiceAccountUsername("catalog"),
+		ClientID:       "catalog",
+		ServiceAccount: true,
+		Roles:          []string{rbac.RoleServiceReader},
+	}
+	platformAdmin := &middleware.TokenClaims{Subject: "admin", Roles: []string{rbac.RolePlatformAdmin}}
+	entitlementService := &middleware.TokenClaims{
+		Subject:  "metering",
+		Username: middleware.ServiceAccountUsername("metering"),
+		Roles:    []string{rbac.RoleEntitlementService},
+	}
+	tenantKey := &middleware.TokenClaims{
+		Subject:     "key",
+		TenantID:    ownTenant,
+		Permissions: []string{string(rbac.EntitlementConsume)},
+	}
+	tenantAdmin := &middleware.TokenClaims{Subject: "user", Roles: []string{rbac.RoleTenantAdmin}, TenantID: ownTenant}
+
+	tests := []struct {
+		name   string
+		claims *middleware.TokenClaims
+		method string
+		req    interface{}
+		want   codes.Code
+	}{
+		{"service account validates a tenant", serviceAccount, tenantv1.TenantService_ValidateTenant_FullMethodName,
+			&tenantv1.ValidateTenantRequest{TenantId: otherTenant}, codes.OK},
+		{"unlisted service account validates a tenant", unlistedService, tenantv1.TenantService_ValidateTenant_FullMethodName,
+			&tenantv1.ValidateTenantRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"user named like a service account validates a tenant", lookalikeUser, tenantv1.TenantService_ValidateTenant_FullMethodName,
+			&tenantv1.ValidateTenantRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service account reads a tenant", serviceAccount, tenantv1.TenantService_GetTenant_FullMethodName,
+			&tenantv1.GetTenantRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service account resolves a host", serviceAccount, tenantv1.TenantService_ResolveTenantByHost_FullMethodName,
+			&tenantv1.ResolveTenantByHostRequest{}, codes.PermissionDenied},
+		{"service account checks an entitlement", serviceAccount, tenantv1.TenantService_CheckEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service account evaluates features", serviceAccount, tenantv1.TenantService_EvaluateFeatures_FullMethodName,
+			&tenantv1.EvaluateFeaturesRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service reader reads a tenant", serviceReader, tenantv1.TenantService_GetTenant_FullMethodName,
+			&tenantv1.GetTenantRequest{TenantId: otherTenant}, codes.OK},
+		{"service reader resolves a host", serviceReader, tenantv1.TenantService_ResolveTenantByHost_FullMethodName,
+			&tenantv1.ResolveTenantByHostRequest{}, codes.OK},
+		{"service reader checks an entitlement", serviceReader, tenantv1.TenantService_CheckEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.OK},
+		{"service reader evaluates features", serviceReader, tenantv1.TenantService_EvaluateFeatures_FullMethodName,
+			&tenantv1.EvaluateFeaturesRequest{TenantId: otherTenant}, codes.OK},
+		{"service reader consumes an entitlement", serviceReader, tenantv1.TenantService_ConsumeEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service account consumes an entitlement", serviceAccount, tenantv1.TenantService_ConsumeEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service account releases an entitlement", serviceAccount, tenantv1.TenantService_ReleaseEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"service account lists a user's tenants", serviceAccount, tenantv1.TenantService_ListUserTenants_FullMethodName,
+			&tenantv1.ListUserTenantsRequest{}, codes.PermissionDenied},
+		{"entitlement service consumes an entitlement", entitlementService, tenantv1.TenantService_ConsumeEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.OK},
+		{"tenant key consumes its own entitlement", tenantKey, tenantv1.TenantService_ConsumeEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: ownTenant}, codes.OK},
+		{"tenant key consumes another tenant's entitlement", tenantKey, tenantv1.TenantService_ConsumeEntitlement_FullMethodName,
+			&tenantv1.EntitlementRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"platform admin lists a user's tenants", platformAdmin, tenantv1.TenantService_ListUserTenants_FullMethodName,
+			&tenantv1.ListUserTenantsRequest{}, codes.OK},
+		{"tenant admin reads own tenant", tenantAdmin, tenantv1.TenantService_GetTenant_FullMethodName,
+			&tenantv1.GetTenantRequest{TenantId: ownTenant}, codes.OK},
+		{"tenant admin reads other tenant", tenantAdmin, tenantv1.TenantService_GetTenant_FullMethodName,
+			&tenantv1.GetTenantRequest{TenantId: otherTenant}, codes.PermissionDenied},
+		{"tenant admin changes own plan", tenantAdmin, tenantv1.TenantService_ChangePlan_FullMethodName,
+			&tenantv1.ChangePlanRequest{TenantId: ownTenant}, codes.PermissionDenied},
+		{"unknown method", platformAdmin, "/tenant.v1.TenantService/DropEverything", nil, codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := NewAuthenticator(claimsValidator{tt.claims}, rbac.NewAuthorizer(rbac.DefaultPolicy), DefaultMethodPolicies, nil, ServiceClients{"bidding": true}, zap.NewNop())
+
+			_, err := auth.authorize(bearerContext(), tt.method, tt.req)
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+func TestAuthenticator_MissingToken(t *testing.T) {
+	auth := NewAuthenticator(rejectingValidator{}, rbac.NewAuthorizer(rbac.DefaultPolicy), DefaultMethodPolicies, nil, nil, zap.NewNop())
+
+	_, err := auth.authorize(context.Background(), tenantv1.TenantService_GetTenant_FullMethodName, nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = auth.authorize(bearerContext(), tenantv1.TenantService_GetTenant_FullMethodName, nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = auth.authorize(context.Background(), "/grpc.health.v1.Health/Check", nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,137 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ServiceAllowlist maps verified client certificate identities (SPIFFE URI
+// or DNS SANs) to the gRPC methods they may call without a JWT
+type ServiceAllowlist map[string]map[string]bool
+
+// ParseServiceAllowlist parses entries of the form
+//
+//	identity=Method|Method;identity=*
+//
+// Identities are URI SANs (spiffe://...) or DNS SANs prefixed with "dns:".
+// Short method names resolve against the TenantService; "*" allows every
+// TenantService method.
+func ParseServiceAllowlist(spec string) (ServiceAllowlist, error) {
+	allowlist := make(ServiceAllowlist)
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		identity, methods, ok := strings.Cut(entry, "=")
+		identity = strings.TrimSpace(identity)
+		if !ok || identity == "" || strings.TrimSpace(methods) == "" {
+			return nil, fmt.Errorf("invalid service allowlist entry %q", entry)
+		}
+
+		allowed := allowlist[identity]
+		if allowed == nil {
+			allowed = make(map[string]bool)
+			allowlist[identity] = allowed
+		}
+
+		for _, method := range strings.Split(methods, "|") {
+			method = strings.TrimSpace(method)
+			if method == "" {
+				continue
+			}
+			allowed[fullMethodName(method)] = true
+		}
+	}
+
+	return allowlist, nil
+}
+
+// fullMethodName expands a short TenantService method name
+func fullMethodName(method string) string {
+	if strings.HasPrefix(method, "/") {
+		return method
+	}
+	return "/" + tenantv1.TenantService_ServiceDesc.ServiceName + "/" + method
+}
+
+// Allows reports whether the identity may call the method
+func (a ServiceAllowlist) Allows(identity, method string) bool {
+	allowed := a[identity]
+	if allowed == nil {
+		return false
+	}
+	if allowed[method] {
+		return true
+	}
+	return allowed[fullMethodName("*")] &&
+		strings.HasPrefix(method, "/"+tenantv1.TenantService_ServiceDesc.ServiceName+"/")
+}
+
+// ServiceClients is the set of Keycloak clients whose client credentials
+// tokens are service identities
+type ServiceClients map[string]bool
+
+// ParseServiceClients parses a comma-separated list of Keycloak client IDs
+func ParseServiceClients(spec string) ServiceClients {
+	clients := make(ServiceClients)
+	for _, client := range strings.Split(spec, ",") {
+		if client = strings.TrimSpace(client); client != "" {
+			clients[client] = true
+		}
+	}
+	return clients
+}
+
+// Identifies reports whether the claims are a client credentials token of
+// a listed client. A user signing in through a listed client is not a
+// service identity.
+func (c ServiceClients) Identifies(claims *middleware.TokenClaims) bool {
+	return claims.IsServiceAccount() && c[claims.ClientID]
+}
+
+// peerIdentities returns the SAN identities of a verified client certificate.
+// Unverified certificates yield nothing.
+func peerIdentities(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	identities := make([]string, 0, len(leaf.URIs)+len(leaf.DNSNames))
+	for _, uri := range leaf.URIs {
+		identities = append(identities, uri.String())
+	}
+	for _, name := range leaf.DNSNames {
+		identities = append(identities, "dns:"+name)
+	}
+
+	return identities
+}
+
+type serviceIdentityKey struct{}
+
+// ContextWithServiceIdentity stores the mTLS service identity in the context
+func ContextWithServiceIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, serviceIdentityKey{}, identity)
+}
+
+// ServiceIdentityFromContext returns the mTLS service identity of the caller, if any
+func ServiceIdentityFromContext(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(serviceIdentityKey{}).(string)
+	return identity, ok && identity != ""
+}
@@ -0,0 +1,92 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type rejectingValidator struct{}
+
+func (rejectingValidator) ValidateToken(string) (*middleware.TokenClaims, error) {
+	return nil, errors.New("invalid token")
+}
+
+func peerContext(uri string) context.Context {
+	u, _ := url.Parse(uri)
+	leaf := &x509.Certificate{URIs: []*url.URL{u}, DNSNames: []string{"acquisition.cotai.svc"}}
+
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{leaf}}},
+		},
+	})
+}
+
+func TestParseServiceAllowlist(t *testing.T) {
+	allowlist, err := ParseServiceAllowlist(
+		"spiffe://cotai.local/core-bidding=ValidateTenant|GetTenant; dns:acquisition.cotai.svc=*",
+	)
+	require.NoError(t, err)
+
+	assert.True(t, allowlist.Allows("spiffe://cotai.local/core-bidding", tenantv1.TenantService_ValidateTenant_FullMethodName))
+	assert.True(t, allowlist.Allows("spiffe://cotai.local/core-bidding", tenantv1.TenantService_GetTenant_FullMethodName))
+	assert.False(t, allowlist.Allows("spiffe://cotai.local/core-bidding", tenantv1.TenantService_ListTenants_FullMethodName))
+	assert.True(t, allowlist.Allows("dns:acquisition.cotai.svc", tenantv1.TenantService_ListTenants_FullMethodName))
+	assert.False(t, allowlist.Allows("dns:acquisition.cotai.svc", "/grpc.health.v1.Health/Check"))
+	assert.False(t, allowlist.Allows("spiffe://cotai.local/unknown", tenantv1.TenantService_ValidateTenant_FullMethodName))
+
+	_, err = ParseServiceAllowlist("spiffe://cotai.local/core-bidding")
+	assert.Error(t, err)
+}
+
+func TestParseServiceClients(t *testing.T) {
+	clients := ParseServiceClients(" core-bidding, ,acquisition ")
+	assert.Equal(t, ServiceClients{"core-bidding": true, "acquisition": true}, clients)
+	assert.Empty(t, ParseServiceClients(""))
+
+	// Only the client's own client credentials token identifies it
+	assert.True(t, clients.Identifies(&middleware.TokenClaims{ClientID: "core-bidding", ServiceAccount: true}))
+	assert.False(t, clients.Identifies(&middleware.TokenClaims{ClientID: "core-bidding"}))
+	assert.False(t, clients.Identifies(&middleware.TokenClaims{ClientID: "reports", ServiceAccount: true}))
+}
+
+func TestAuthenticator_ServiceAllowlist(t *testing.T) {
+	allowlist, err := ParseServiceAllowlist("spiffe://cotai.local/core-bidding=ValidateTenant")
+	require.NoError(t, err)
+
+	auth := NewAuthenticator(rejectingValidator{}, rbac.NewAuthorizer(rbac.DefaultPolicy), DefaultMethodPolicies, allowlist, nil, zap.NewNop())
+
+	t.Run("allowlisted identity skips the JWT", func(t *testing.T) {
+		ctx, err := auth.authorize(peerContext("spiffe://cotai.local/core-bidding"), tenantv1.TenantService_ValidateTenant_FullMethodName, nil)
+		require.NoError(t, err)
+
+		identity, ok := ServiceIdentityFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "spiffe://cotai.local/core-bidding", identity)
+	})
+
+	t.Run("method outside the allowlist is denied", func(t *testing.T) {
+		_, err := auth.authorize(peerContext("spiffe://cotai.local/core-bidding"), tenantv1.TenantService_ListTenants_FullMethodName, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("no certificate and no token", func(t *testing.T) {
+		_, err := auth.authorize(context.Background(), tenantv1.TenantService_ValidateTenant_FullMethodName, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
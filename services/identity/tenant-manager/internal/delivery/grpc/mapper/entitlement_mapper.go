@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"github.com/cotai/tenant-manager/internal/domain"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EntitlementUsageToProto converts domain.EntitlementUsage to proto EntitlementResponse
+func EntitlementUsageToProto(usage *domain.EntitlementUsage) *tenantv1.EntitlementResponse {
+	if usage == nil {
+		return nil
+	}
+
+	resp := &tenantv1.EntitlementResponse{
+		TenantId:    usage.TenantID.String(),
+		Name:        usage.Name,
+		Allowed:     true,
+		Limit:       usage.Limit,
+		Used:        usage.Used,
+		Remaining:   usage.Remaining(),
+		Window:      string(usage.Window),
+		WindowStart: timestamppb.New(usage.WindowStart),
+	}
+	if usage.ResetsAt != nil {
+		resp.ResetsAt = timestamppb.New(*usage.ResetsAt)
+	}
+
+	return resp
+}
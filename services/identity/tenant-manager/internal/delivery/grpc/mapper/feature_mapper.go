@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"github.com/cotai/tenant-manager/internal/domain"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
+	"github.com/google/uuid"
+)
+
+// FeaturesToProto converts a tenant's evaluated feature flags to proto EvaluateFeaturesResponse
+func FeaturesToProto(tenantID uuid.UUID, values []*domain.FeatureValue) *tenantv1.EvaluateFeaturesResponse {
+	resp := &tenantv1.EvaluateFeaturesResponse{
+		TenantId: tenantID.String(),
+		Features: make([]*tenantv1.FeatureValue, 0, len(values)),
+	}
+
+	for _, v := range values {
+		feature := &tenantv1.FeatureValue{
+			Key:    v.Key,
+			Type:   string(v.Type),
+			Source: string(v.Source),
+		}
+		switch value := v.Value.(type) {
+		case bool:
+			feature.Value = &tenantv1.FeatureValue_BoolValue{BoolValue: value}
+		case float64:
+			feature.Value = &tenantv1.FeatureValue_NumberValue{NumberValue: value}
+		case string:
+			feature.Value = &tenantv1.FeatureValue_StringValue{StringValue: value}
+		}
+		resp.Features = append(resp.Features, feature)
+	}
+
+	return resp
+}
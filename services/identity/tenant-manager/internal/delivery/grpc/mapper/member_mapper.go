@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"github.com/cotai/tenant-manager/internal/domain"
+	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
+	"github.com/google/uuid"
+)
+
+// UserTenantsToProto converts a user's tenants to proto ListUserTenantsResponse
+func UserTenantsToProto(userID uuid.UUID, tenants []*domain.UserTenant) *tenantv1.ListUserTenantsResponse {
+	resp := &tenantv1.ListUserTenantsResponse{
+		KeycloakUserId: userID.String(),
+		Tenants:        make([]*tenantv1.UserTenant, 0, len(tenants)),
+	}
+
+	for _, t := range tenants {
+		resp.Tenants = append(resp.Tenants, &tenantv1.UserTenant{
+			Tenant:    DomainToProto(t.Tenant),
+			Role:      string(t.Member.Role),
+			IsPrimary: t.Member.IsPrimary,
+		})
+	}
+
+	return resp
+}
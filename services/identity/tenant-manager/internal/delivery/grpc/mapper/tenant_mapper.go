@@ -12,20 +12,42 @@ func DomainToProto(tenant *domain.Tenant) *tenantv1.Tenant {
 		return nil
 	}
 
+	parentTenantID := ""
+	if tenant.ParentTenantID != nil {
+		parentTenantID = tenant.ParentTenantID.String()
+	}
+
+	var trialEndsAt *timestamppb.Timestamp
+	if tenant.HasActiveTrial() {
+		trialEndsAt = timestamppb.New(tenant.Trial.EndsAt)
+	}
+
 	return &tenantv1.Tenant{
-		Id:            tenant.ID.String(),
-		TenantId:      tenant.TenantID.String(),
-		Name:          tenant.TenantName,
-		Slug:          tenant.TenantSlug,
-		SchemaName:    tenant.DatabaseSchema,
-		Status:        StatusDomainToProto(tenant.Status),
-		Plan:          string(tenant.PlanTier),
-		ContactEmail:  tenant.PrimaryContactEmail,
-		ContactName:   tenant.PrimaryContactName,
-		BillingEmail:  tenant.BillingEmail,
-		CreatedAt:     timestamppb.New(tenant.CreatedAt),
-		UpdatedAt:     timestamppb.New(tenant.UpdatedAt),
+		Id:             tenant.ID.String(),
+		TenantId:       tenant.TenantID.String(),
+		Name:           tenant.TenantName,
+		Slug:           tenant.TenantSlug,
+		SchemaName:     tenant.DatabaseSchema,
+		Status:         StatusDomainToProto(tenant.Status),
+		Plan:           string(tenant.PlanTier),
+		ContactEmail:   tenant.PrimaryContactEmail,
+		ContactName:    tenant.PrimaryContactName,
+		BillingEmail:   tenant.BillingEmail,
+		CreatedAt:      timestamppb.New(tenant.CreatedAt),
+		UpdatedAt:      timestamppb.New(tenant.UpdatedAt),
+		ParentTenantId: parentTenantID,
+		InheritQuotas:  tenant.InheritQuotas,
+		TrialEndsAt:    trialEndsAt,
+	}
+}
+
+// DomainsToProto converts a list of domain.Tenant to proto Tenants
+func DomainsToProto(tenants []*domain.Tenant) []*tenantv1.Tenant {
+	result := make([]*tenantv1.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		result[i] = DomainToProto(tenant)
 	}
+	return result
 }
 
 // StatusDomainToProto converts domain.TenantStatus to proto TenantStatus
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
@@ -10,9 +11,12 @@ import (
 	"time"
 
 	"github.com/cotai/tenant-manager/internal/delivery/grpc/interceptor"
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
 	tenantv1 "github.com/cotai/tenant-manager/proto/tenant/v1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -26,15 +30,35 @@ type Server struct {
 	tenantService *TenantServiceServer
 }
 
-// NewServer creates a new gRPC server
-func NewServer(port int, tenantService *TenantServiceServer, logger *zap.Logger) *Server {
-	// Create gRPC server with interceptors
-	grpcServer := grpc.NewServer(
+// NewServer creates a new gRPC server. When tlsConfig is nil the server
+// listens in plaintext and the service allowlist never matches.
+func NewServer(
+	port int,
+	tenantService *TenantServiceServer,
+	jwtValidator middleware.JWTValidator,
+	authorizer *rbac.Authorizer,
+	allowlist interceptor.ServiceAllowlist,
+	clients interceptor.ServiceClients,
+	tlsConfig *tls.Config,
+	logger *zap.Logger,
+) *Server {
+	authenticator := interceptor.NewAuthenticator(jwtValidator, authorizer, interceptor.DefaultMethodPolicies, allowlist, clients, logger)
+
+	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			interceptor.LoggingInterceptor(logger),
-			interceptor.AuthInterceptor(logger),
+			authenticator.AuthInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			authenticator.StreamAuthInterceptor(),
 		),
-	)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	// Create gRPC server with interceptors
+	grpcServer := grpc.NewServer(opts...)
 
 	return &Server{
 		port:          port,
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cotai/tenant-manager/internal/delivery/grpc/mapper"
 	"github.com/cotai/tenant-manager/internal/domain"
@@ -18,6 +19,14 @@ type TenantServiceServer struct {
 	tenantv1.UnimplementedTenantServiceServer
 	getTenantUC   *usecase.GetTenantUseCase
 	listTenantsUC *usecase.ListTenantsUseCase
+	changePlanUC  *usecase.ChangePlanUseCase
+	checkUC       *usecase.CheckEntitlementUseCase
+	consumeUC     *usecase.ConsumeEntitlementUseCase
+	releaseUC     *usecase.ReleaseEntitlementUseCase
+	featuresUC    *usecase.EvaluateFeaturesUseCase
+	resolveHostUC *usecase.ResolveTenantByHostUseCase
+	hierarchyUC   *usecase.GetTenantHierarchyUseCase
+	userTenantsUC *usecase.ListUserTenantsUseCase
 	logger        *zap.Logger
 }
 
@@ -25,11 +34,27 @@ type TenantServiceServer struct {
 func NewTenantServiceServer(
 	getTenantUC *usecase.GetTenantUseCase,
 	listTenantsUC *usecase.ListTenantsUseCase,
+	changePlanUC *usecase.ChangePlanUseCase,
+	checkUC *usecase.CheckEntitlementUseCase,
+	consumeUC *usecase.ConsumeEntitlementUseCase,
+	releaseUC *usecase.ReleaseEntitlementUseCase,
+	featuresUC *usecase.EvaluateFeaturesUseCase,
+	resolveHostUC *usecase.ResolveTenantByHostUseCase,
+	hierarchyUC *usecase.GetTenantHierarchyUseCase,
+	userTenantsUC *usecase.ListUserTenantsUseCase,
 	logger *zap.Logger,
 ) *TenantServiceServer {
 	return &TenantServiceServer{
 		getTenantUC:   getTenantUC,
 		listTenantsUC: listTenantsUC,
+		changePlanUC:  changePlanUC,
+		checkUC:       checkUC,
+		consumeUC:     consumeUC,
+		releaseUC:     releaseUC,
+		featuresUC:    featuresUC,
+		resolveHostUC: resolveHostUC,
+		hierarchyUC:   hierarchyUC,
+		userTenantsUC: userTenantsUC,
 		logger:        logger,
 	}
 }
@@ -72,12 +97,55 @@ func (s *TenantServiceServer) GetTenantBySlug(ctx context.Context, req *tenantv1
 		return nil, s.handleError(err)
 	}
 
-	// Convert to proto
+	// Convert to proto; a former slug resolves to the renamed tenant
+	return &tenantv1.TenantResponse{
+		Tenant:            mapper.DomainToProto(tenant),
+		ResolvedFromAlias: tenant.TenantSlug != req.Slug,
+	}, nil
+}
+
+// ResolveTenantByHost retrieves the tenant a host belongs to, through its
+// verified custom domains
+func (s *TenantServiceServer) ResolveTenantByHost(ctx context.Context, req *tenantv1.ResolveTenantByHostRequest) (*tenantv1.TenantResponse, error) {
+	// Validate request
+	if req.Host == "" {
+		return nil, status.Error(codes.InvalidArgument, "host is required")
+	}
+
+	tenant, err := s.resolveHostUC.Execute(ctx, req.Host)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
 	return &tenantv1.TenantResponse{
 		Tenant: mapper.DomainToProto(tenant),
 	}, nil
 }
 
+// GetTenantHierarchy retrieves a tenant with its ancestors and descendants
+func (s *TenantServiceServer) GetTenantHierarchy(ctx context.Context, req *tenantv1.GetTenantHierarchyRequest) (*tenantv1.TenantHierarchyResponse, error) {
+	// Validate request
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant_id format")
+	}
+
+	hierarchy, err := s.hierarchyUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &tenantv1.TenantHierarchyResponse{
+		Tenant:      mapper.DomainToProto(hierarchy.Tenant),
+		Ancestors:   mapper.DomainsToProto(hierarchy.Ancestors),
+		Descendants: mapper.DomainsToProto(hierarchy.Descendants),
+	}, nil
+}
+
 // ValidateTenant checks if a tenant exists and is active
 func (s *TenantServiceServer) ValidateTenant(ctx context.Context, req *tenantv1.ValidateTenantRequest) (*tenantv1.ValidationResponse, error) {
 	// Validate request
@@ -152,6 +220,13 @@ func (s *TenantServiceServer) ListTenants(ctx context.Context, req *tenantv1.Lis
 	if req.Plan != "" {
 		query.PlanTier = domain.PlanTier(req.Plan)
 	}
+	if req.AncestorId != "" {
+		ancestorID, err := uuid.Parse(req.AncestorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid ancestor_id format")
+		}
+		query.AncestorID = &ancestorID
+	}
 
 	// Execute use case
 	result, err := s.listTenantsUC.Execute(ctx, query)
@@ -159,14 +234,8 @@ func (s *TenantServiceServer) ListTenants(ctx context.Context, req *tenantv1.Lis
 		return nil, s.handleError(err)
 	}
 
-	// Convert tenants to proto
-	protoTenants := make([]*tenantv1.Tenant, len(result.Tenants))
-	for i, tenant := range result.Tenants {
-		protoTenants[i] = mapper.DomainToProto(tenant)
-	}
-
 	return &tenantv1.ListTenantsResponse{
-		Tenants:    protoTenants,
+		Tenants:    mapper.DomainsToProto(result.Tenants),
 		TotalCount: int32(result.Total),
 		Page:       int32(result.Page),
 		PageSize:   int32(result.PerPage),
@@ -174,6 +243,175 @@ func (s *TenantServiceServer) ListTenants(ctx context.Context, req *tenantv1.Lis
 	}, nil
 }
 
+// ChangePlan moves a tenant to another subscription plan
+func (s *TenantServiceServer) ChangePlan(ctx context.Context, req *tenantv1.ChangePlanRequest) (*tenantv1.TenantResponse, error) {
+	// Validate request
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+	if req.Plan == "" {
+		return nil, status.Error(codes.InvalidArgument, "plan is required")
+	}
+
+	// Parse tenant ID
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant_id format")
+	}
+
+	// Execute use case
+	tenant, err := s.changePlanUC.Execute(ctx, usecase.ChangePlanCommand{
+		TenantID: tenantID,
+		Plan:     domain.PlanTier(req.Plan),
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// Convert to proto
+	return &tenantv1.TenantResponse{
+		Tenant: mapper.DomainToProto(tenant),
+	}, nil
+}
+
+// CheckEntitlement reports whether a tenant may consume an entitlement.
+// A denial is a normal response with allowed set to false.
+func (s *TenantServiceServer) CheckEntitlement(ctx context.Context, req *tenantv1.EntitlementRequest) (*tenantv1.EntitlementResponse, error) {
+	tenantID, amount, err := parseEntitlementRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute use case
+	check, err := s.checkUC.Execute(ctx, usecase.CheckEntitlementCommand{
+		TenantID: tenantID,
+		Name:     req.Name,
+		Amount:   amount,
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// Convert to proto
+	resp := mapper.EntitlementUsageToProto(check.Usage)
+	resp.Allowed = check.Allowed()
+	switch {
+	case errors.Is(check.Denied, domain.ErrEntitlementExceeded):
+		resp.Reason = "LIMIT_EXCEEDED"
+	case errors.Is(check.Denied, domain.ErrTenantNotActive):
+		resp.Reason = "TENANT_NOT_ACTIVE"
+	}
+
+	return resp, nil
+}
+
+// ConsumeEntitlement counts usage of an entitlement against its limit
+func (s *TenantServiceServer) ConsumeEntitlement(ctx context.Context, req *tenantv1.EntitlementRequest) (*tenantv1.EntitlementResponse, error) {
+	tenantID, amount, err := parseEntitlementRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute use case
+	usage, err := s.consumeUC.Execute(ctx, usecase.ConsumeEntitlementCommand{
+		TenantID: tenantID,
+		Name:     req.Name,
+		Amount:   amount,
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// Convert to proto
+	return mapper.EntitlementUsageToProto(usage), nil
+}
+
+// ReleaseEntitlement gives back usage of an entitlement
+func (s *TenantServiceServer) ReleaseEntitlement(ctx context.Context, req *tenantv1.EntitlementRequest) (*tenantv1.EntitlementResponse, error) {
+	tenantID, amount, err := parseEntitlementRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute use case
+	usage, err := s.releaseUC.Execute(ctx, usecase.ReleaseEntitlementCommand{
+		TenantID: tenantID,
+		Name:     req.Name,
+		Amount:   amount,
+	})
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// Convert to proto
+	return mapper.EntitlementUsageToProto(usage), nil
+}
+
+// EvaluateFeatures resolves every registered feature flag for a tenant
+func (s *TenantServiceServer) EvaluateFeatures(ctx context.Context, req *tenantv1.EvaluateFeaturesRequest) (*tenantv1.EvaluateFeaturesResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant_id format")
+	}
+
+	// Execute use case
+	features, err := s.featuresUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// Convert to proto
+	return mapper.FeaturesToProto(tenantID, features), nil
+}
+
+// ListUserTenants retrieves the tenants a Keycloak user is an active member
+// of, primary first, for the auth service to populate token claims
+func (s *TenantServiceServer) ListUserTenants(ctx context.Context, req *tenantv1.ListUserTenantsRequest) (*tenantv1.ListUserTenantsResponse, error) {
+	if req.KeycloakUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "keycloak_user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.KeycloakUserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keycloak_user_id format")
+	}
+
+	// Execute use case
+	tenants, err := s.userTenantsUC.Execute(ctx, userID)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// Convert to proto
+	return mapper.UserTenantsToProto(userID, tenants), nil
+}
+
+// parseEntitlementRequest validates an entitlement request, defaulting the amount to 1
+func parseEntitlementRequest(req *tenantv1.EntitlementRequest) (uuid.UUID, int64, error) {
+	if req.TenantId == "" {
+		return uuid.Nil, 0, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+	if req.Name == "" {
+		return uuid.Nil, 0, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return uuid.Nil, 0, status.Error(codes.InvalidArgument, "invalid tenant_id format")
+	}
+
+	amount := req.Amount
+	if amount == 0 {
+		amount = 1
+	}
+
+	return tenantID, amount, nil
+}
+
 // handleError converts domain errors to gRPC errors
 func (s *TenantServiceServer) handleError(err error) error {
 	s.logger.Error("gRPC service error", zap.Error(err))
@@ -190,6 +428,29 @@ func (s *TenantServiceServer) handleError(err error) error {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if errors.Is(err, domain.ErrPlanNotFound) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if errors.Is(err, domain.ErrEntitlementNotFound) ||
+		errors.Is(err, domain.ErrFeatureNotFound) ||
+		errors.Is(err, domain.ErrDomainNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if errors.Is(err, domain.ErrEntitlementExceeded) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	if errors.Is(err, domain.ErrPlanAlreadySet) ||
+		errors.Is(err, domain.ErrPlanRetired) ||
+		errors.Is(err, domain.ErrPlanLimitExceeded) ||
+		errors.Is(err, domain.ErrEntitlementNotCounted) ||
+		errors.Is(err, domain.ErrTenantDeleted) ||
+		errors.Is(err, domain.ErrTenantNotActive) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	// Default to internal error
 	return status.Error(codes.Internal, "internal server error")
 }
@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ParseAuditEventsQuery parses audit log filters from query parameters:
+// tenantId, actorId, action, from and to (RFC 3339), page and pageSize
+func ParseAuditEventsQuery(r *http.Request) (domain.AuditFilter, error) {
+	q := r.URL.Query()
+	filter := domain.AuditFilter{
+		Page:    1,
+		PerPage: 20,
+		ActorID: q.Get("actorId"),
+		Action:  domain.AuditAction(q.Get("action")),
+	}
+
+	if page, err := strconv.Atoi(q.Get("page")); err == nil && page > 0 {
+		filter.Page = page
+	}
+
+	if pageSize, err := strconv.Atoi(q.Get("pageSize")); err == nil && pageSize > 0 && pageSize <= 100 {
+		filter.PerPage = pageSize
+	}
+
+	if tenantID := q.Get("tenantId"); tenantID != "" {
+		id, err := uuid.Parse(tenantID)
+		if err != nil {
+			return filter, fmt.Errorf("invalid tenantId: %w", err)
+		}
+		filter.TenantID = &id
+	}
+
+	for param, dest := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if value := q.Get(param); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s: must be an RFC 3339 timestamp", param)
+			}
+			*dest = &t
+		}
+	}
+
+	return filter, nil
+}
+
+// AuditEventResponse represents an audit event in API responses
+type AuditEventResponse struct {
+	ID         uuid.UUID                     `json:"id"`
+	OccurredAt time.Time                     `json:"occurredAt"`
+	Actor      AuditActorResponse            `json:"actor"`
+	Action     string                        `json:"action"`
+	TenantID   *uuid.UUID                    `json:"tenantId,omitempty"`
+	RequestID  string                        `json:"requestId,omitempty"`
+	SourceIP   string                        `json:"sourceIp,omitempty"`
+	Changes    map[string]domain.FieldChange `json:"changes"`
+}
+
+// AuditActorResponse identifies who performed an audited operation
+type AuditActorResponse struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ListAuditEventsResponse represents a paginated list of audit events
+type ListAuditEventsResponse struct {
+	Data []*AuditEventResponse `json:"data"`
+	Meta PaginationMeta        `json:"meta"`
+}
+
+// FromAuditEvent converts a domain audit event to its response
+func FromAuditEvent(event *domain.AuditEvent) *AuditEventResponse {
+	changes := event.Changes
+	if changes == nil {
+		changes = map[string]domain.FieldChange{}
+	}
+
+	return &AuditEventResponse{
+		ID:         event.ID,
+		OccurredAt: event.OccurredAt,
+		Actor: AuditActorResponse{
+			Type: string(event.ActorType),
+			ID:   event.ActorID,
+			Name: event.ActorName,
+		},
+		Action:    string(event.Action),
+		TenantID:  event.TenantID,
+		RequestID: event.RequestID,
+		SourceIP:  event.SourceIP,
+		Changes:   changes,
+	}
+}
+
+// NewListAuditEventsResponse creates a paginated audit events response
+func NewListAuditEventsResponse(events []*domain.AuditEvent, total, page, pageSize, totalPages int) *ListAuditEventsResponse {
+	data := make([]*AuditEventResponse, 0, len(events))
+	for _, event := range events {
+		data = append(data, FromAuditEvent(event))
+	}
+
+	return &ListAuditEventsResponse{
+		Data: data,
+		Meta: PaginationMeta{
+			Page:       page,
+			PerPage:    pageSize,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// AddCustomDomainRequest represents the request to register a custom domain
+type AddCustomDomainRequest struct {
+	// Hostname format is checked by the domain
+	Hostname string `json:"hostname" validate:"required,max=253"`
+}
+
+// VerificationRecordResponse is the DNS TXT record that proves ownership
+type VerificationRecordResponse struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CustomDomainResponse represents a custom domain in API responses
+type CustomDomainResponse struct {
+	ID                  string                      `json:"id"`
+	Hostname            string                      `json:"hostname"`
+	Status              string                      `json:"status"`
+	VerificationRecord  *VerificationRecordResponse `json:"verificationRecord"`
+	LastCheckedAt       *time.Time                  `json:"lastCheckedAt,omitempty"`
+	ConsecutiveFailures int                         `json:"consecutiveFailures"`
+	LastError           string                      `json:"lastError,omitempty"`
+	CreatedAt           time.Time                   `json:"createdAt"`
+	VerifiedAt          *time.Time                  `json:"verifiedAt,omitempty"`
+	RevokedAt           *time.Time                  `json:"revokedAt,omitempty"`
+}
+
+// FromCustomDomain converts domain.CustomDomain to CustomDomainResponse
+func FromCustomDomain(d *domain.CustomDomain) *CustomDomainResponse {
+	return &CustomDomainResponse{
+		ID:       d.ID.String(),
+		Hostname: d.Hostname,
+		Status:   string(d.Status),
+		VerificationRecord: &VerificationRecordResponse{
+			Type:  "TXT",
+			Name:  d.VerificationRecordName(),
+			Value: d.VerificationRecordValue(),
+		},
+		LastCheckedAt:       d.LastCheckedAt,
+		ConsecutiveFailures: d.ConsecutiveFailures,
+		LastError:           d.LastError,
+		CreatedAt:           d.CreatedAt,
+		VerifiedAt:          d.VerifiedAt,
+		RevokedAt:           d.RevokedAt,
+	}
+}
+
+// FromCustomDomains converts the custom domains of a tenant to responses
+func FromCustomDomains(domains []*domain.CustomDomain) []*CustomDomainResponse {
+	result := make([]*CustomDomainResponse, 0, len(domains))
+	for _, d := range domains {
+		result = append(result, FromCustomDomain(d))
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// EntitlementLimit represents a named usage limit in API requests and responses
+type EntitlementLimit struct {
+	Name   string `json:"name" validate:"required,max=50,lowercase"`
+	Limit  int64  `json:"limit" validate:"required,min=1"`
+	Window string `json:"window" validate:"required,oneof=none day month rolling"`
+	// PeriodSeconds is the length of a rolling window
+	PeriodSeconds int64 `json:"periodSeconds,omitempty" validate:"required_if=Window rolling,omitempty,min=60"`
+}
+
+// Period returns the length of a rolling window
+func (l EntitlementLimit) Period() time.Duration {
+	return time.Duration(l.PeriodSeconds) * time.Second
+}
+
+// FromEntitlementLimits converts domain entitlement limits to their API form
+func FromEntitlementLimits(limits []*domain.EntitlementLimit) []EntitlementLimit {
+	result := make([]EntitlementLimit, 0, len(limits))
+	for _, l := range limits {
+		result = append(result, EntitlementLimit{
+			Name:          l.Name,
+			Limit:         l.Limit,
+			Window:        string(l.Window),
+			PeriodSeconds: int64(l.Period / time.Second),
+		})
+	}
+	return result
+}
+
+// SetEntitlementLimitRequest represents the request to set a tenant's own
+// limit of an entitlement
+type SetEntitlementLimitRequest struct {
+	Limit         int64  `json:"limit" validate:"required,min=1"`
+	Window        string `json:"window" validate:"required,oneof=none day month rolling"`
+	PeriodSeconds int64  `json:"periodSeconds,omitempty" validate:"required_if=Window rolling,omitempty,min=60"`
+}
+
+// Period returns the length of a rolling window
+func (r SetEntitlementLimitRequest) Period() time.Duration {
+	return time.Duration(r.PeriodSeconds) * time.Second
+}
+
+// EntitlementUsageResponse represents a tenant's usage of an entitlement in API responses
+type EntitlementUsageResponse struct {
+	Name          string     `json:"name"`
+	Limit         int64      `json:"limit"`
+	Used          int64      `json:"used"`
+	Remaining     int64      `json:"remaining"`
+	Window        string     `json:"window"`
+	PeriodSeconds int64      `json:"periodSeconds,omitempty"`
+	WindowStart   time.Time  `json:"windowStart"`
+	ResetsAt      *time.Time `json:"resetsAt,omitempty"`
+}
+
+// FromEntitlementUsage converts domain.EntitlementUsage to EntitlementUsageResponse
+func FromEntitlementUsage(usage *domain.EntitlementUsage) *EntitlementUsageResponse {
+	return &EntitlementUsageResponse{
+		Name:          usage.Name,
+		Limit:         usage.Limit,
+		Used:          usage.Used,
+		Remaining:     usage.Remaining(),
+		Window:        string(usage.Window),
+		PeriodSeconds: int64(usage.Period / time.Second),
+		WindowStart:   usage.WindowStart,
+		ResetsAt:      usage.ResetsAt,
+	}
+}
+
+// FromEntitlementUsages converts a tenant's entitlement usages to responses
+func FromEntitlementUsages(usages []*domain.EntitlementUsage) []*EntitlementUsageResponse {
+	result := make([]*EntitlementUsageResponse, 0, len(usages))
+	for _, usage := range usages {
+		result = append(result, FromEntitlementUsage(usage))
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// SaveFeatureFlagRequest represents the request to register a feature flag
+type SaveFeatureFlagRequest struct {
+	Type string `json:"type" validate:"required,oneof=boolean number string"`
+	// Default is checked against Type by the domain; false and 0 are valid
+	Default        interface{} `json:"default"`
+	Description    string      `json:"description" validate:"required,max=500"`
+	RolloutPercent int         `json:"rolloutPercent" validate:"min=0,max=100"`
+}
+
+// SetFeatureOverrideRequest represents the request to override a tenant's
+// feature flag
+type SetFeatureOverrideRequest struct {
+	// Value is checked against the flag's type by the domain
+	Value interface{} `json:"value"`
+}
+
+// FeatureFlagResponse represents a registered feature flag in API responses
+type FeatureFlagResponse struct {
+	Key            string      `json:"key"`
+	Type           string      `json:"type"`
+	Default        interface{} `json:"default"`
+	Description    string      `json:"description"`
+	RolloutPercent int         `json:"rolloutPercent"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+}
+
+// FromFeatureFlag converts domain.FeatureFlag to FeatureFlagResponse
+func FromFeatureFlag(flag *domain.FeatureFlag) *FeatureFlagResponse {
+	return &FeatureFlagResponse{
+		Key:            flag.Key,
+		Type:           string(flag.Type),
+		Default:        flag.Default,
+		Description:    flag.Description,
+		RolloutPercent: flag.RolloutPercent,
+		CreatedAt:      flag.CreatedAt,
+		UpdatedAt:      flag.UpdatedAt,
+	}
+}
+
+// FromFeatureFlags converts registered feature flags to responses
+func FromFeatureFlags(flags []*domain.FeatureFlag) []*FeatureFlagResponse {
+	result := make([]*FeatureFlagResponse, 0, len(flags))
+	for _, flag := range flags {
+		result = append(result, FromFeatureFlag(flag))
+	}
+	return result
+}
+
+// FeatureValueResponse represents a feature flag evaluated for a tenant in
+// API responses
+type FeatureValueResponse struct {
+	Type   string      `json:"type"`
+	Value  interface{} `json:"value"`
+	Source string      `json:"source"`
+}
+
+// FromFeatureValues converts a tenant's evaluated feature flags to responses
+// keyed by flag
+func FromFeatureValues(values []*domain.FeatureValue) map[string]*FeatureValueResponse {
+	result := make(map[string]*FeatureValueResponse, len(values))
+	for _, v := range values {
+		result[v.Key] = &FeatureValueResponse{
+			Type:   string(v.Type),
+			Value:  v.Value,
+			Source: string(v.Source),
+		}
+	}
+	return result
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// SetTenantParentRequest represents the request to move a tenant in the
+// hierarchy. A null parentTenantId makes the tenant a root.
+type SetTenantParentRequest struct {
+	ParentTenantID *uuid.UUID `json:"parentTenantId"`
+	InheritQuotas  bool       `json:"inheritQuotas"`
+}
+
+// TenantHierarchyResponse represents a tenant with its ancestors, root
+// first, and its descendants, by depth then name
+type TenantHierarchyResponse struct {
+	Tenant      *TenantResponse   `json:"tenant"`
+	Ancestors   []*TenantResponse `json:"ancestors"`
+	Descendants []*TenantResponse `json:"descendants"`
+}
+
+// FromTenantHierarchy converts domain.TenantHierarchy to TenantHierarchyResponse
+func FromTenantHierarchy(h *domain.TenantHierarchy) *TenantHierarchyResponse {
+	return &TenantHierarchyResponse{
+		Tenant:      FromDomain(h.Tenant),
+		Ancestors:   fromTenants(h.Ancestors),
+		Descendants: fromTenants(h.Descendants),
+	}
+}
+
+func fromTenants(tenants []*domain.Tenant) []*TenantResponse {
+	result := make([]*TenantResponse, 0, len(tenants))
+	for _, t := range tenants {
+		result = append(result, FromDomain(t))
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// InviteMemberRequest represents the request to invite a person to a tenant
+type InviteMemberRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+	// Role is checked against domain.MemberRole by the use case
+	Role string `json:"role" validate:"required"`
+}
+
+// AcceptInvitationRequest represents the request to accept an invitation
+// with the token of its link
+type AcceptInvitationRequest struct {
+	Token string `json:"token" validate:"required,max=200"`
+}
+
+// InvitationResponse represents a tenant invitation in API responses. The
+// token is never returned: it only travels in the invitation email.
+type InvitationResponse struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenantId"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	// Status reports an expired pending invitation as expired
+	Status     string     `json:"status"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	SendCount  int        `json:"sendCount"`
+	LastSentAt time.Time  `json:"lastSentAt"`
+	InvitedBy  *uuid.UUID `json:"invitedBy,omitempty"`
+	AcceptedBy *uuid.UUID `json:"acceptedBy,omitempty"`
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// AcceptInvitationResponse represents an accepted invitation and the
+// membership it created
+type AcceptInvitationResponse struct {
+	Invitation *InvitationResponse `json:"invitation"`
+	Member     *MemberResponse     `json:"member"`
+}
+
+// FromInvitation converts domain.Invitation to InvitationResponse
+func FromInvitation(inv *domain.Invitation) *InvitationResponse {
+	return &InvitationResponse{
+		ID:         inv.ID,
+		TenantID:   inv.TenantID,
+		Email:      inv.Email,
+		Role:       string(inv.Role),
+		Status:     string(inv.EffectiveStatus(time.Now())),
+		ExpiresAt:  inv.ExpiresAt,
+		SendCount:  inv.SendCount,
+		LastSentAt: inv.LastSentAt,
+		InvitedBy:  inv.InvitedBy,
+		AcceptedBy: inv.AcceptedBy,
+		AcceptedAt: inv.AcceptedAt,
+		RevokedAt:  inv.RevokedAt,
+		CreatedAt:  inv.CreatedAt,
+	}
+}
+
+// FromInvitations converts the invitations of a tenant to responses
+func FromInvitations(invitations []*domain.Invitation) []*InvitationResponse {
+	result := make([]*InvitationResponse, 0, len(invitations))
+	for _, inv := range invitations {
+		result = append(result, FromInvitation(inv))
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// AddMemberRequest represents the request to add a user to a tenant
+type AddMemberRequest struct {
+	// UserID is the Keycloak user ID
+	UserID uuid.UUID `json:"userId" validate:"required"`
+	// Role is checked against domain.MemberRole by the use case
+	Role string `json:"role" validate:"required"`
+}
+
+// ChangeMemberRoleRequest represents the request to change the role of a
+// tenant member
+type ChangeMemberRoleRequest struct {
+	// Role is checked against domain.MemberRole by the use case
+	Role string `json:"role" validate:"required"`
+}
+
+// MemberResponse represents a tenant member in API responses
+type MemberResponse struct {
+	UserID    uuid.UUID  `json:"userId"`
+	TenantID  uuid.UUID  `json:"tenantId"`
+	Role      string     `json:"role"`
+	IsPrimary bool       `json:"isPrimary"`
+	IsActive  bool       `json:"isActive"`
+	CreatedBy *uuid.UUID `json:"createdBy,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// FromMember converts domain.Member to MemberResponse
+func FromMember(m *domain.Member) *MemberResponse {
+	return &MemberResponse{
+		UserID:    m.UserID,
+		TenantID:  m.TenantID,
+		Role:      string(m.Role),
+		IsPrimary: m.IsPrimary,
+		IsActive:  m.IsActive,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// FromMembers converts the members of a tenant to responses
+func FromMembers(members []*domain.Member) []*MemberResponse {
+	result := make([]*MemberResponse, 0, len(members))
+	for _, m := range members {
+		result = append(result, FromMember(m))
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// CreatePlanRequest represents the request to add a plan to the catalog
+type CreatePlanRequest struct {
+	Tier         string                 `json:"tier" validate:"required,min=2,max=50,lowercase"`
+	DisplayName  string                 `json:"displayName" validate:"required,max=100"`
+	Description  string                 `json:"description,omitempty" validate:"omitempty,max=500"`
+	MaxUsers     int                    `json:"maxUsers" validate:"required,min=1"`
+	MaxStorageGB int                    `json:"maxStorageGb" validate:"required,min=1"`
+	Features     map[string]interface{} `json:"features,omitempty"`
+	SortOrder    int                    `json:"sortOrder,omitempty"`
+	Entitlements []EntitlementLimit     `json:"entitlements,omitempty" validate:"omitempty,dive"`
+}
+
+// PlanResponse represents a plan in API responses
+type PlanResponse struct {
+	Tier         string                 `json:"tier"`
+	DisplayName  string                 `json:"displayName"`
+	Description  string                 `json:"description,omitempty"`
+	MaxUsers     int                    `json:"maxUsers"`
+	MaxStorageGB int                    `json:"maxStorageGb"`
+	Features     map[string]interface{} `json:"features"`
+	SortOrder    int                    `json:"sortOrder"`
+	Entitlements []EntitlementLimit     `json:"entitlements"`
+	Retired      bool                   `json:"retired"`
+	RetiredAt    *time.Time             `json:"retiredAt,omitempty"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+// FromPlan converts domain.Plan to PlanResponse
+func FromPlan(plan *domain.Plan) *PlanResponse {
+	return &PlanResponse{
+		Tier:         string(plan.Tier),
+		DisplayName:  plan.DisplayName,
+		Description:  plan.Description,
+		MaxUsers:     plan.MaxUsers,
+		MaxStorageGB: plan.MaxStorageGB,
+		Features:     plan.Features,
+		SortOrder:    plan.SortOrder,
+		Entitlements: FromEntitlementLimits(plan.Entitlements),
+		Retired:      plan.IsRetired(),
+		RetiredAt:    plan.RetiredAt,
+		CreatedAt:    plan.CreatedAt,
+		UpdatedAt:    plan.UpdatedAt,
+	}
+}
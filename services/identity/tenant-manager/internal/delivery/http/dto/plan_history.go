@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// PlanQuotasResponse represents the quotas granted by a plan
+type PlanQuotasResponse struct {
+	MaxUsers     int `json:"maxUsers"`
+	MaxStorageGB int `json:"maxStorageGb"`
+}
+
+// PlanChangeResponse represents one entry of a tenant's plan history
+type PlanChangeResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	FromPlan  string             `json:"fromPlan"`
+	ToPlan    string             `json:"toPlan"`
+	OldQuotas PlanQuotasResponse `json:"oldQuotas"`
+	NewQuotas PlanQuotasResponse `json:"newQuotas"`
+	Actor     AuditActorResponse `json:"actor"`
+	ChangedAt time.Time          `json:"changedAt"`
+}
+
+// FromPlanHistory converts plan changes to their responses
+func FromPlanHistory(changes []*domain.PlanChange) []*PlanChangeResponse {
+	response := make([]*PlanChangeResponse, 0, len(changes))
+	for _, c := range changes {
+		response = append(response, &PlanChangeResponse{
+			ID:       c.ID,
+			FromPlan: string(c.FromPlan),
+			ToPlan:   string(c.ToPlan),
+			OldQuotas: PlanQuotasResponse{
+				MaxUsers:     c.FromMaxUsers,
+				MaxStorageGB: c.FromMaxStorageGB,
+			},
+			NewQuotas: PlanQuotasResponse{
+				MaxUsers:     c.ToMaxUsers,
+				MaxStorageGB: c.ToMaxStorageGB,
+			},
+			Actor: AuditActorResponse{
+				Type: string(c.ActorType),
+				ID:   c.ActorID,
+				Name: c.ActorName,
+			},
+			ChangedAt: c.ChangedAt,
+		})
+	}
+	return response
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// SetQuotaOverrideRequest represents the request to override a tenant quota
+type SetQuotaOverrideRequest struct {
+	Value     int        `json:"value" validate:"required,min=1"`
+	Reason    string     `json:"reason" validate:"required,max=500"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// QuotaResponse represents one quota of a tenant in API responses
+type QuotaResponse struct {
+	Default   int `json:"default"`
+	Effective int `json:"effective"`
+	// Inherited tells that Default comes from the parent tenant, not the plan
+	Inherited bool                   `json:"inherited"`
+	Override  *QuotaOverrideResponse `json:"override,omitempty"`
+}
+
+// QuotaOverrideResponse represents a quota override in API responses
+type QuotaOverrideResponse struct {
+	Value     int                `json:"value"`
+	Reason    string             `json:"reason"`
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
+	Expired   bool               `json:"expired"`
+	Actor     AuditActorResponse `json:"actor"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+// FromQuotas converts a tenant's effective quotas to responses keyed by quota name
+func FromQuotas(tenant *domain.Tenant) map[string]*QuotaResponse {
+	now := time.Now()
+	quotas := make(map[string]*QuotaResponse, len(domain.QuotaNames))
+	for _, q := range tenant.EffectiveQuotas(now) {
+		resp := &QuotaResponse{
+			Default:   q.Default,
+			Effective: q.Effective,
+			Inherited: q.Inherited,
+		}
+		if o := q.Override; o != nil {
+			resp.Override = &QuotaOverrideResponse{
+				Value:     o.Value,
+				Reason:    o.Reason,
+				ExpiresAt: o.ExpiresAt,
+				Expired:   !o.IsActive(now),
+				Actor: AuditActorResponse{
+					Type: string(o.ActorType),
+					ID:   o.ActorID,
+					Name: o.ActorName,
+				},
+				CreatedAt: o.CreatedAt,
+			}
+		}
+		quotas[string(q.Name)] = resp
+	}
+	return quotas
+}
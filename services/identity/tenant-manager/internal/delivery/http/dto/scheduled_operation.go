@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ScheduleOperationRequest represents the request to schedule a suspension
+// or activation. runAt carries its UTC offset, e.g. 2026-11-01T00:00:00-03:00.
+type ScheduleOperationRequest struct {
+	Action string    `json:"action" validate:"required,oneof=suspend activate"`
+	RunAt  time.Time `json:"runAt" validate:"required"`
+	Reason string    `json:"reason,omitempty" validate:"required_if=Action suspend,omitempty,oneof=billing abuse legal_hold customer_request security"`
+	Notes  string    `json:"notes,omitempty" validate:"omitempty,max=500"`
+	// ReactivateAfterHours makes a suspension time-boxed
+	ReactivateAfterHours int `json:"reactivateAfterHours,omitempty" validate:"omitempty,min=1,max=8760"`
+}
+
+// ReactivateAfter returns how long after the suspension the tenant is reactivated
+func (r *ScheduleOperationRequest) ReactivateAfter() time.Duration {
+	return time.Duration(r.ReactivateAfterHours) * time.Hour
+}
+
+// ScheduledOperationResponse represents a scheduled operation in API responses
+type ScheduledOperationResponse struct {
+	ID                   uuid.UUID  `json:"id"`
+	TenantID             uuid.UUID  `json:"tenantId"`
+	Action               string     `json:"action"`
+	Reason               string     `json:"reason,omitempty"`
+	Notes                string     `json:"notes,omitempty"`
+	RunAt                time.Time  `json:"runAt"`
+	ReactivateAfterHours int        `json:"reactivateAfterHours,omitempty"`
+	FollowsOperationID   *uuid.UUID `json:"followsOperationId,omitempty"`
+	Status               string     `json:"status"`
+	Error                string     `json:"error,omitempty"`
+	CreatedBy            *uuid.UUID `json:"createdBy,omitempty"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	ExecutedAt           *time.Time `json:"executedAt,omitempty"`
+	CanceledAt           *time.Time `json:"canceledAt,omitempty"`
+}
+
+// FromScheduledOperation converts domain.ScheduledOperation to ScheduledOperationResponse
+func FromScheduledOperation(op *domain.ScheduledOperation) *ScheduledOperationResponse {
+	return &ScheduledOperationResponse{
+		ID:                   op.ID,
+		TenantID:             op.TenantID,
+		Action:               string(op.Action),
+		Reason:               string(op.SuspensionReason),
+		Notes:                op.Notes,
+		RunAt:                op.RunAt,
+		ReactivateAfterHours: int(op.ReactivateAfter / time.Hour),
+		FollowsOperationID:   op.FollowsID,
+		Status:               string(op.Status),
+		Error:                op.Error,
+		CreatedBy:            op.CreatedBy,
+		CreatedAt:            op.CreatedAt,
+		ExecutedAt:           op.ExecutedAt,
+		CanceledAt:           op.CanceledAt,
+	}
+}
+
+// FromScheduledOperations converts the scheduled operations of a tenant to responses
+func FromScheduledOperations(ops []*domain.ScheduledOperation) []*ScheduledOperationResponse {
+	result := make([]*ScheduledOperationResponse, 0, len(ops))
+	for _, op := range ops {
+		result = append(result, FromScheduledOperation(op))
+	}
+	return result
+}
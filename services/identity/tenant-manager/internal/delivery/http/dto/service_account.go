@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// CreateServiceAccountRequest represents the request to create a service account
+type CreateServiceAccountRequest struct {
+	Name        string     `json:"name" validate:"required,min=3,max=100,lowercase"`
+	Description string     `json:"description,omitempty" validate:"omitempty,max=500"`
+	TenantID    *uuid.UUID `json:"tenantId,omitempty"`
+}
+
+// IssueAPIKeyRequest represents the request to issue an API key
+type IssueAPIKeyRequest struct {
+	Name        string     `json:"name" validate:"required,min=3,max=100"`
+	Permissions []string   `json:"permissions" validate:"required,min=1,dive,required"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ServiceAccountResponse represents a service account in API responses
+type ServiceAccountResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	TenantID    *uuid.UUID `json:"tenantId,omitempty"`
+	IsActive    bool       `json:"isActive"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CreatedBy   *uuid.UUID `json:"createdBy,omitempty"`
+
+	Keys []*APIKeyResponse `json:"keys,omitempty"`
+}
+
+// APIKeyResponse represents an API key in API responses (never the secret)
+type APIKeyResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt   *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// IssuedAPIKeyResponse includes the plaintext key, returned only once
+type IssuedAPIKeyResponse struct {
+	*APIKeyResponse
+	Key string `json:"key"`
+}
+
+// FromServiceAccount converts domain.ServiceAccount to ServiceAccountResponse
+func FromServiceAccount(account *domain.ServiceAccount, keys []*domain.APIKey) *ServiceAccountResponse {
+	response := &ServiceAccountResponse{
+		ID:          account.ID,
+		Name:        account.Name,
+		Description: account.Description,
+		TenantID:    account.TenantID,
+		IsActive:    account.IsActive,
+		CreatedAt:   account.CreatedAt,
+		UpdatedAt:   account.UpdatedAt,
+		CreatedBy:   account.CreatedBy,
+	}
+
+	for _, key := range keys {
+		response.Keys = append(response.Keys, FromAPIKey(key))
+	}
+
+	return response
+}
+
+// FromAPIKey converts domain.APIKey to APIKeyResponse
+func FromAPIKey(key *domain.APIKey) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:          key.ID,
+		Name:        key.Name,
+		Prefix:      key.KeyPrefix,
+		Permissions: key.Permissions,
+		ExpiresAt:   key.ExpiresAt,
+		LastUsedAt:  key.LastUsedAt,
+		RevokedAt:   key.RevokedAt,
+		CreatedAt:   key.CreatedAt,
+	}
+}
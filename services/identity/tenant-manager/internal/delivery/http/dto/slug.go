@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// RenameSlugRequest represents the request to rename a tenant slug
+type RenameSlugRequest struct {
+	// Slug format and reserved slugs are checked by the domain
+	Slug string `json:"slug" validate:"required,max=100"`
+}
+
+// SlugAliasResponse represents a former slug of a tenant in API responses
+type SlugAliasResponse struct {
+	Slug       string     `json:"slug"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+}
+
+// FromSlugAlias converts domain.SlugAlias to SlugAliasResponse
+func FromSlugAlias(alias *domain.SlugAlias) *SlugAliasResponse {
+	return &SlugAliasResponse{
+		Slug:       alias.Slug,
+		CreatedAt:  alias.CreatedAt,
+		ReleasedAt: alias.ReleasedAt,
+	}
+}
+
+// FromSlugAliases converts the slug aliases of a tenant to responses
+func FromSlugAliases(aliases []*domain.SlugAlias) []*SlugAliasResponse {
+	result := make([]*SlugAliasResponse, 0, len(aliases))
+	for _, alias := range aliases {
+		result = append(result, FromSlugAlias(alias))
+	}
+	return result
+}
+
+// SlugResolutionResponse represents a tenant looked up by slug. Redirect is
+// set when the slug is a former slug; clients should then move to
+// CanonicalSlug.
+type SlugResolutionResponse struct {
+	Slug          string          `json:"slug"`
+	CanonicalSlug string          `json:"canonicalSlug"`
+	Redirect      bool            `json:"redirect"`
+	Tenant        *TenantResponse `json:"tenant"`
+}
+
+// FromSlugResolution converts a tenant looked up by slug to SlugResolutionResponse
+func FromSlugResolution(slug string, tenant *domain.Tenant) *SlugResolutionResponse {
+	return &SlugResolutionResponse{
+		Slug:          slug,
+		CanonicalSlug: tenant.TenantSlug,
+		Redirect:      slug != tenant.TenantSlug,
+		Tenant:        FromDomain(tenant),
+	}
+}
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// StorageUsageQuery holds the history range of a storage usage request
+type StorageUsageQuery struct {
+	From  *time.Time
+	To    *time.Time
+	Limit int
+}
+
+// ParseStorageUsageQuery parses the storage history range from query
+// parameters: from and to (RFC 3339) and limit
+func ParseStorageUsageQuery(r *http.Request) (StorageUsageQuery, error) {
+	q := r.URL.Query()
+	var query StorageUsageQuery
+
+	if value := q.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return query, fmt.Errorf("invalid limit: must be a positive integer")
+		}
+		query.Limit = limit
+	}
+
+	for param, dest := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		if value := q.Get(param); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return query, fmt.Errorf("invalid %s: must be an RFC 3339 timestamp", param)
+			}
+			*dest = &t
+		}
+	}
+
+	return query, nil
+}
+
+// StorageSnapshotResponse represents one measurement of a tenant's schema size
+type StorageSnapshotResponse struct {
+	MeasuredAt time.Time `json:"measuredAt"`
+	TableBytes int64     `json:"tableBytes"`
+	IndexBytes int64     `json:"indexBytes"`
+	ToastBytes int64     `json:"toastBytes"`
+	TotalBytes int64     `json:"totalBytes"`
+	QuotaBytes int64     `json:"quotaBytes"`
+	OverQuota  bool      `json:"overQuota"`
+}
+
+// StorageUsageResponse represents a tenant's measured storage and its history
+type StorageUsageResponse struct {
+	QuotaBytes int64                      `json:"quotaBytes"`
+	Current    *StorageSnapshotResponse   `json:"current"`
+	History    []*StorageSnapshotResponse `json:"history"`
+}
+
+// NewStorageUsageResponse creates a storage usage response; current is nil
+// until the tenant is first measured
+func NewStorageUsageResponse(quotaBytes int64, current *domain.StorageSnapshot, history []*domain.StorageSnapshot) *StorageUsageResponse {
+	response := &StorageUsageResponse{
+		QuotaBytes: quotaBytes,
+		History:    make([]*StorageSnapshotResponse, 0, len(history)),
+	}
+	if current != nil {
+		response.Current = fromStorageSnapshot(current)
+	}
+	for _, s := range history {
+		response.History = append(response.History, fromStorageSnapshot(s))
+	}
+	return response
+}
+
+// fromStorageSnapshot converts a domain storage snapshot to its response
+func fromStorageSnapshot(s *domain.StorageSnapshot) *StorageSnapshotResponse {
+	return &StorageSnapshotResponse{
+		MeasuredAt: s.MeasuredAt,
+		TableBytes: s.TableBytes,
+		IndexBytes: s.IndexBytes,
+		ToastBytes: s.ToastBytes,
+		TotalBytes: s.TotalBytes(),
+		QuotaBytes: s.QuotaBytes,
+		OverQuota:  s.OverQuota(),
+	}
+}
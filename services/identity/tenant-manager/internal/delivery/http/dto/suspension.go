@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/cotai/tenant-manager/internal/domain"
+
+// SuspensionResponse represents why a suspended tenant was suspended
+type SuspensionResponse struct {
+	Reason string `json:"reason"`
+	Notes  string `json:"notes,omitempty"`
+	// Restricted suspensions cannot be lifted by whoever may suspend the tenant
+	Restricted bool `json:"restricted"`
+}
+
+// FromSuspension converts a tenant's suspension to SuspensionResponse, nil
+// when the tenant is not suspended for a known reason
+func FromSuspension(tenant *domain.Tenant) *SuspensionResponse {
+	if tenant.SuspensionReason == "" {
+		return nil
+	}
+	return &SuspensionResponse{
+		Reason:     string(tenant.SuspensionReason),
+		Notes:      tenant.SuspensionNotes,
+		Restricted: tenant.SuspensionReason.IsRestricted(),
+	}
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// TenantArchiveResponse describes the archive written for an archived tenant
+type TenantArchiveResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Location  string    `json:"location"`
+	Checksum  string    `json:"checksum"`
+	SizeBytes int64     `json:"sizeBytes"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ArchiveTenantResponse represents the result of archiving a tenant
+type ArchiveTenantResponse struct {
+	Tenant  *TenantResponse        `json:"tenant"`
+	Archive *TenantArchiveResponse `json:"archive"`
+}
+
+// FromArchive converts an archived tenant and its archive record to a response
+func FromArchive(tenant *domain.Tenant, archive *domain.TenantArchive) *ArchiveTenantResponse {
+	return &ArchiveTenantResponse{
+		Tenant: FromDomain(tenant),
+		Archive: &TenantArchiveResponse{
+			ID:        archive.ID,
+			Location:  archive.Location,
+			Checksum:  archive.Checksum,
+			SizeBytes: archive.SizeBytes,
+			CreatedAt: archive.CreatedAt,
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// StatusTransitionResponse represents one entry of a tenant's status history
+type StatusTransitionResponse struct {
+	ID         uuid.UUID          `json:"id"`
+	Action     string             `json:"action"`
+	FromStatus string             `json:"fromStatus,omitempty"`
+	ToStatus   string             `json:"toStatus"`
+	Reason     string             `json:"reason,omitempty"`
+	Actor      AuditActorResponse `json:"actor"`
+	OccurredAt time.Time          `json:"occurredAt"`
+}
+
+// FromStatusHistory converts status transitions to their responses
+func FromStatusHistory(transitions []*domain.StatusTransition) []*StatusTransitionResponse {
+	response := make([]*StatusTransitionResponse, 0, len(transitions))
+	for _, tr := range transitions {
+		response = append(response, &StatusTransitionResponse{
+			ID:         tr.ID,
+			Action:     string(tr.Action),
+			FromStatus: string(tr.FromStatus),
+			ToStatus:   string(tr.ToStatus),
+			Reason:     tr.Reason,
+			Actor: AuditActorResponse{
+				Type: string(tr.ActorType),
+				ID:   tr.ActorID,
+				Name: tr.ActorName,
+			},
+			OccurredAt: tr.OccurredAt,
+		})
+	}
+	return response
+}
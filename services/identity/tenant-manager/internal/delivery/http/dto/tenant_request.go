@@ -5,16 +5,22 @@ import (
 	"strconv"
 
 	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
 )
 
 // CreateTenantRequest represents the request to create a tenant
 type CreateTenantRequest struct {
 	Name       string                 `json:"name" validate:"required,min=3,max=255"`
 	Slug       string                 `json:"slug" validate:"required,min=2,max=100,lowercase,alphanum_hyphen"`
-	Plan       string                 `json:"plan" validate:"required,oneof=free basic professional enterprise"`
+	Plan       string                 `json:"plan" validate:"required,max=50,lowercase"`
 	AdminEmail string                 `json:"adminEmail" validate:"required,email"`
 	AdminName  string                 `json:"adminName,omitempty" validate:"omitempty,max=255"`
 	Settings   map[string]interface{} `json:"settings,omitempty"`
+	// ParentTenantID creates the tenant as a child of an existing tenant
+	ParentTenantID *uuid.UUID `json:"parentTenantId,omitempty"`
+	InheritQuotas  bool       `json:"inheritQuotas,omitempty"`
+	// Trial starts the tenant on a trial of Plan
+	Trial *TrialRequest `json:"trial,omitempty"`
 }
 
 // ToTenantPlan converts string to domain.PlanTier
@@ -33,7 +39,28 @@ type UpdateTenantRequest struct {
 
 // SuspendTenantRequest represents the request to suspend a tenant
 type SuspendTenantRequest struct {
-	Reason string `json:"reason" validate:"required,min=10,max=500"`
+	Reason string `json:"reason" validate:"required,oneof=billing abuse legal_hold customer_request security"`
+	Notes  string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// ArchiveTenantRequest represents the optional request body for archiving a tenant
+type ArchiveTenantRequest struct {
+	Reason string `json:"reason" validate:"omitempty,max=500"`
+}
+
+// RestoreTenantRequest represents the optional request body for restoring a deleted tenant
+type RestoreTenantRequest struct {
+	Reason string `json:"reason" validate:"omitempty,max=500"`
+}
+
+// ChangePlanRequest represents the request to change a tenant's plan
+type ChangePlanRequest struct {
+	Plan string `json:"plan" validate:"required,max=50,lowercase"`
+}
+
+// ToTenantPlan converts string to domain.PlanTier
+func (r *ChangePlanRequest) ToTenantPlan() domain.PlanTier {
+	return domain.PlanTier(r.Plan)
 }
 
 // ListTenantsQuery represents query parameters for listing tenants
@@ -41,8 +68,12 @@ type ListTenantsQuery struct {
 	Page     int    `json:"page" validate:"omitempty,min=1"`
 	PageSize int    `json:"pageSize" validate:"omitempty,min=1,max=100"`
 	Status   string `json:"status" validate:"omitempty,oneof=provisioning active suspended archived deleted"`
-	Plan     string `json:"plan" validate:"omitempty,oneof=free basic professional enterprise"`
+	Plan     string `json:"plan" validate:"omitempty,max=50,lowercase"`
 	Search   string `json:"search" validate:"omitempty,max=255"`
+	// SuspensionReason restricts the list to tenants suspended for a reason
+	SuspensionReason string `json:"suspensionReason" validate:"omitempty,oneof=billing abuse legal_hold customer_request security"`
+	// AncestorID restricts the list to the descendants of a tenant
+	AncestorID *uuid.UUID `json:"ancestorId"`
 }
 
 // ParseListTenantsQuery parses query parameters from HTTP request
@@ -67,6 +98,13 @@ func ParseListTenantsQuery(r *http.Request) ListTenantsQuery {
 	query.Status = r.URL.Query().Get("status")
 	query.Plan = r.URL.Query().Get("plan")
 	query.Search = r.URL.Query().Get("search")
+	query.SuspensionReason = r.URL.Query().Get("suspensionReason")
+
+	if ancestorID := r.URL.Query().Get("ancestorId"); ancestorID != "" {
+		if id, err := uuid.Parse(ancestorID); err == nil {
+			query.AncestorID = &id
+		}
+	}
 
 	return query
 }
@@ -80,3 +118,8 @@ func (q *ListTenantsQuery) ToTenantStatus() domain.TenantStatus {
 func (q *ListTenantsQuery) ToTenantPlan() domain.PlanTier {
 	return domain.PlanTier(q.Plan)
 }
+
+// ToSuspensionReason converts string to domain.SuspensionReason
+func (q *ListTenantsQuery) ToSuspensionReason() domain.SuspensionReason {
+	return domain.SuspensionReason(q.SuspensionReason)
+}
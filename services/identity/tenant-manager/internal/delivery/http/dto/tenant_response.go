@@ -9,23 +9,30 @@ import (
 
 // TenantResponse represents a tenant in API responses
 type TenantResponse struct {
-	ID                  uuid.UUID              `json:"id"`
-	TenantID            uuid.UUID              `json:"tenantId"`
-	Name                string                 `json:"name"`
-	Slug                string                 `json:"slug"`
-	SchemaName          string                 `json:"schemaName"`
-	Status              string                 `json:"status"`
-	Plan                string                 `json:"plan"`
-	MaxUsers            int                    `json:"maxUsers"`
-	MaxStorageGB        int                    `json:"maxStorageGb"`
-	PrimaryContactEmail string                 `json:"primaryContactEmail,omitempty"`
-	PrimaryContactName  string                 `json:"primaryContactName,omitempty"`
-	Settings            map[string]interface{} `json:"settings,omitempty"`
-	Features            map[string]interface{} `json:"features,omitempty"`
-	CreatedAt           time.Time              `json:"createdAt"`
-	UpdatedAt           time.Time              `json:"updatedAt"`
-	ActivatedAt         *time.Time             `json:"activatedAt,omitempty"`
-	SuspendedAt         *time.Time             `json:"suspendedAt,omitempty"`
+	ID                  uuid.UUID                 `json:"id"`
+	TenantID            uuid.UUID                 `json:"tenantId"`
+	Name                string                    `json:"name"`
+	Slug                string                    `json:"slug"`
+	SchemaName          string                    `json:"schemaName"`
+	Status              string                    `json:"status"`
+	Plan                string                    `json:"plan"`
+	MaxUsers            int                       `json:"maxUsers"`
+	MaxStorageGB        int                       `json:"maxStorageGb"`
+	PrimaryContactEmail string                    `json:"primaryContactEmail,omitempty"`
+	PrimaryContactName  string                    `json:"primaryContactName,omitempty"`
+	ParentTenantID      *uuid.UUID                `json:"parentTenantId,omitempty"`
+	InheritQuotas       bool                      `json:"inheritQuotas"`
+	Settings            map[string]interface{}    `json:"settings,omitempty"`
+	Features            map[string]interface{}    `json:"features,omitempty"`
+	FeatureOverrides    map[string]interface{}    `json:"featureOverrides,omitempty"`
+	Quotas              map[string]*QuotaResponse `json:"quotas"`
+	Trial               *TrialResponse            `json:"trial,omitempty"`
+	Suspension          *SuspensionResponse       `json:"suspension,omitempty"`
+	CreatedAt           time.Time                 `json:"createdAt"`
+	UpdatedAt           time.Time                 `json:"updatedAt"`
+	ActivatedAt         *time.Time                `json:"activatedAt,omitempty"`
+	SuspendedAt         *time.Time                `json:"suspendedAt,omitempty"`
+	ReinstatedAt        *time.Time                `json:"reinstatedAt,omitempty"`
 }
 
 // FromDomain converts domain.Tenant to TenantResponse
@@ -42,12 +49,19 @@ func FromDomain(tenant *domain.Tenant) *TenantResponse {
 		MaxStorageGB:        tenant.MaxStorageGB,
 		PrimaryContactEmail: tenant.PrimaryContactEmail,
 		PrimaryContactName:  tenant.PrimaryContactName,
-		Settings:            tenant.Settings,
+		ParentTenantID:      tenant.ParentTenantID,
+		InheritQuotas:       tenant.InheritQuotas,
+		Trial:               FromTrial(tenant.Trial),
+		Suspension:          FromSuspension(tenant),
+		Settings:            tenant.Settings.Document(),
 		Features:            tenant.Features,
+		FeatureOverrides:    tenant.FeatureOverrides,
+		Quotas:              FromQuotas(tenant),
 		CreatedAt:           tenant.CreatedAt,
 		UpdatedAt:           tenant.UpdatedAt,
 		ActivatedAt:         tenant.ActivatedAt,
 		SuspendedAt:         tenant.SuspendedAt,
+		ReinstatedAt:        tenant.ReinstatedAt,
 	}
 }
 
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// TrialRequest represents the trial terms of a new tenant. The tenant's plan
+// is the plan on trial.
+type TrialRequest struct {
+	Days int `json:"days" validate:"required,min=1,max=90"`
+	// FallbackPlan is the plan after an unconverted trial; defaults to free
+	FallbackPlan string `json:"fallbackPlan,omitempty" validate:"omitempty,max=50,lowercase"`
+	ExpiryPolicy string `json:"expiryPolicy,omitempty" validate:"omitempty,oneof=downgrade suspend"`
+}
+
+// Duration returns the trial length
+func (r *TrialRequest) Duration() time.Duration {
+	return time.Duration(r.Days) * 24 * time.Hour
+}
+
+// ConvertTrialRequest represents the request to convert a trial. An empty
+// plan keeps the plan on trial.
+type ConvertTrialRequest struct {
+	Plan string `json:"plan,omitempty" validate:"omitempty,max=50,lowercase"`
+}
+
+// TrialResponse represents a tenant's trial
+type TrialResponse struct {
+	Plan         string     `json:"plan"`
+	FallbackPlan string     `json:"fallbackPlan"`
+	ExpiryPolicy string     `json:"expiryPolicy"`
+	StartedAt    time.Time  `json:"startedAt"`
+	EndsAt       time.Time  `json:"endsAt"`
+	Active       bool       `json:"active"`
+	EndedAt      *time.Time `json:"endedAt,omitempty"`
+	Outcome      string     `json:"outcome,omitempty"`
+}
+
+// FromTrial converts domain.Trial to TrialResponse
+func FromTrial(trial *domain.Trial) *TrialResponse {
+	if trial == nil {
+		return nil
+	}
+	return &TrialResponse{
+		Plan:         string(trial.Plan),
+		FallbackPlan: string(trial.FallbackPlan),
+		ExpiryPolicy: string(trial.ExpiryPolicy),
+		StartedAt:    trial.StartedAt,
+		EndsAt:       trial.EndsAt,
+		Active:       trial.IsActive(),
+		EndedAt:      trial.EndedAt,
+		Outcome:      string(trial.Outcome),
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// AuditHandler handles audit log HTTP requests
+type AuditHandler struct {
+	listUC *usecase.ListAuditEventsUseCase
+	logger *zap.Logger
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(listUC *usecase.ListAuditEventsUseCase, logger *zap.Logger) *AuditHandler {
+	return &AuditHandler{
+		listUC: listUC,
+		logger: logger,
+	}
+}
+
+// ListAuditEvents lists audit events, newest first
+// GET /api/v1/audit-events?tenantId=&actorId=&action=&from=&to=
+func (h *AuditHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	filter, err := dto.ParseAuditEventsQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_QUERY", err.Error(), nil)
+		return
+	}
+
+	result, err := h.listUC.Execute(r.Context(), filter)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.NewListAuditEventsResponse(
+		result.Events, result.Total, result.Page, result.PerPage, result.TotalPages,
+	))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *AuditHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidTimeRange):
+		writeError(w, http.StatusBadRequest, "INVALID_QUERY", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// DomainHandler handles tenant custom domain HTTP requests
+type DomainHandler struct {
+	addUC     *usecase.AddCustomDomainUseCase
+	verifyUC  *usecase.VerifyCustomDomainUseCase
+	revokeUC  *usecase.RevokeCustomDomainUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+// NewDomainHandler creates a new custom domain handler
+func NewDomainHandler(
+	addUC *usecase.AddCustomDomainUseCase,
+	verifyUC *usecase.VerifyCustomDomainUseCase,
+	revokeUC *usecase.RevokeCustomDomainUseCase,
+	logger *zap.Logger,
+) *DomainHandler {
+	return &DomainHandler{
+		addUC:     addUC,
+		verifyUC:  verifyUC,
+		revokeUC:  revokeUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// AddDomain registers a custom domain for a tenant, pending verification
+// POST /api/v1/tenants/{id}/domains
+func (h *DomainHandler) AddDomain(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.AddCustomDomainRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	d, err := h.addUC.Execute(r.Context(), usecase.AddCustomDomainCommand{
+		TenantID: tenantID,
+		Hostname: req.Hostname,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, dto.FromCustomDomain(d))
+}
+
+// ListDomains lists the custom domains of a tenant
+// GET /api/v1/tenants/{id}/domains
+func (h *DomainHandler) ListDomains(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	domains, err := h.addUC.List(r.Context(), tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromCustomDomains(domains))
+}
+
+// VerifyDomain checks the verification TXT record of a custom domain right away
+// POST /api/v1/tenants/{id}/domains/{domainId}/verify
+func (h *DomainHandler) VerifyDomain(w http.ResponseWriter, r *http.Request) {
+	tenantID, domainID, ok := parseDomainParams(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.verifyUC.Execute(r.Context(), usecase.VerifyCustomDomainCommand{
+		TenantID: tenantID,
+		DomainID: domainID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromCustomDomain(d))
+}
+
+// RevokeDomain removes a custom domain from a tenant
+// DELETE /api/v1/tenants/{id}/domains/{domainId}
+func (h *DomainHandler) RevokeDomain(w http.ResponseWriter, r *http.Request) {
+	tenantID, domainID, ok := parseDomainParams(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.revokeUC.Execute(r.Context(), usecase.RevokeCustomDomainCommand{
+		TenantID: tenantID,
+		DomainID: domainID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromCustomDomain(d))
+}
+
+// parseDomainParams parses the tenant and domain IDs of a domain route,
+// writing the error response when either is malformed
+func parseDomainParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	domainID, err := uuid.Parse(chi.URLParam(r, "domainId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid domain ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return tenantID, domainID, true
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *DomainHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrInvalidHostname):
+		writeError(w, http.StatusBadRequest, "INVALID_HOSTNAME", err.Error(), nil)
+	case errors.Is(err, domain.ErrDomainNotFound):
+		writeError(w, http.StatusNotFound, "DOMAIN_NOT_FOUND", "Custom domain not found", nil)
+	case errors.Is(err, domain.ErrDomainAlreadyExists):
+		writeError(w, http.StatusConflict, "DOMAIN_EXISTS", "Hostname is already registered", nil)
+	case errors.Is(err, domain.ErrDomainChanged):
+		writeError(w, http.StatusConflict, "DOMAIN_CHANGED", "Custom domain was changed meanwhile, try again", nil)
+	case errors.Is(err, domain.ErrDomainRevoked):
+		writeError(w, http.StatusConflict, "DOMAIN_REVOKED", "Custom domain has been revoked", nil)
+	case errors.Is(err, domain.ErrDomainLookupFailed):
+		writeError(w, http.StatusBadGateway, "DNS_LOOKUP_FAILED", "DNS lookup failed, try again later", nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
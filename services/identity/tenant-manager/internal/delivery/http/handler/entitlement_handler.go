@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// EntitlementHandler handles tenant entitlement HTTP requests
+type EntitlementHandler struct {
+	checkUC   *usecase.CheckEntitlementUseCase
+	setUC     *usecase.SetEntitlementLimitUseCase
+	removeUC  *usecase.RemoveEntitlementLimitUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+// NewEntitlementHandler creates a new entitlement handler
+func NewEntitlementHandler(
+	checkUC *usecase.CheckEntitlementUseCase,
+	setUC *usecase.SetEntitlementLimitUseCase,
+	removeUC *usecase.RemoveEntitlementLimitUseCase,
+	logger *zap.Logger,
+) *EntitlementHandler {
+	return &EntitlementHandler{
+		checkUC:   checkUC,
+		setUC:     setUC,
+		removeUC:  removeUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// ListEntitlements reports the usage of every entitlement of a tenant
+// GET /api/v1/tenants/{id}/entitlements
+func (h *EntitlementHandler) ListEntitlements(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	usages, err := h.checkUC.List(r.Context(), tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromEntitlementUsages(usages))
+}
+
+// SetEntitlementLimit sets a tenant's own limit of an entitlement
+// PUT /api/v1/tenants/{id}/entitlements/{name}
+func (h *EntitlementHandler) SetEntitlementLimit(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.SetEntitlementLimitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	usage, err := h.setUC.Execute(r.Context(), usecase.SetEntitlementLimitCommand{
+		TenantID: tenantID,
+		EntitlementLimitCommand: usecase.EntitlementLimitCommand{
+			Name:   chi.URLParam(r, "name"),
+			Limit:  req.Limit,
+			Window: domain.EntitlementWindow(req.Window),
+			Period: req.Period(),
+		},
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromEntitlementUsage(usage))
+}
+
+// RemoveEntitlementLimit removes a tenant's own limit of an entitlement
+// DELETE /api/v1/tenants/{id}/entitlements/{name}
+func (h *EntitlementHandler) RemoveEntitlementLimit(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	if err := h.removeUC.Execute(r.Context(), tenantID, chi.URLParam(r, "name")); err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *EntitlementHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrEntitlementNotFound):
+		writeError(w, http.StatusNotFound, "ENTITLEMENT_NOT_FOUND", "Tenant has no limit for this entitlement", nil)
+	case errors.Is(err, domain.ErrInvalidEntitlementName),
+		errors.Is(err, domain.ErrInvalidEntitlementLimit),
+		errors.Is(err, domain.ErrInvalidEntitlementWindow):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
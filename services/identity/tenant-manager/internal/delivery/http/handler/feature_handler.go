@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// FeatureHandler handles feature flag HTTP requests
+type FeatureHandler struct {
+	evaluateUC *usecase.EvaluateFeaturesUseCase
+	setUC      *usecase.SetFeatureOverrideUseCase
+	removeUC   *usecase.RemoveFeatureOverrideUseCase
+	saveFlagUC *usecase.SaveFeatureFlagUseCase
+	validator  *validator.Validate
+	logger     *zap.Logger
+}
+
+// NewFeatureHandler creates a new feature handler
+func NewFeatureHandler(
+	evaluateUC *usecase.EvaluateFeaturesUseCase,
+	setUC *usecase.SetFeatureOverrideUseCase,
+	removeUC *usecase.RemoveFeatureOverrideUseCase,
+	saveFlagUC *usecase.SaveFeatureFlagUseCase,
+	logger *zap.Logger,
+) *FeatureHandler {
+	return &FeatureHandler{
+		evaluateUC: evaluateUC,
+		setUC:      setUC,
+		removeUC:   removeUC,
+		saveFlagUC: saveFlagUC,
+		validator:  validator.New(),
+		logger:     logger,
+	}
+}
+
+// ListFeatureFlags lists the feature flag registry
+// GET /api/v1/features
+func (h *FeatureHandler) ListFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	flags, err := h.saveFlagUC.List(r.Context())
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromFeatureFlags(flags))
+}
+
+// SaveFeatureFlag registers a feature flag, or replaces the registered one
+// PUT /api/v1/features/{flag}
+func (h *FeatureHandler) SaveFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	var req dto.SaveFeatureFlagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	flag, err := h.saveFlagUC.Execute(r.Context(), usecase.SaveFeatureFlagCommand{
+		Key:            chi.URLParam(r, "flag"),
+		Type:           domain.FeatureType(req.Type),
+		Default:        req.Default,
+		Description:    req.Description,
+		RolloutPercent: req.RolloutPercent,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromFeatureFlag(flag))
+}
+
+// GetTenantFeatures evaluates every feature flag for a tenant
+// GET /api/v1/tenants/{id}/features
+func (h *FeatureHandler) GetTenantFeatures(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	features, err := h.evaluateUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromFeatureValues(features))
+}
+
+// SetFeatureOverride overrides one feature flag of a tenant
+// PUT /api/v1/tenants/{id}/features/{flag}
+func (h *FeatureHandler) SetFeatureOverride(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.SetFeatureOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	features, err := h.setUC.Execute(r.Context(), usecase.SetFeatureOverrideCommand{
+		TenantID: tenantID,
+		Key:      chi.URLParam(r, "flag"),
+		Value:    req.Value,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromFeatureValues(features))
+}
+
+// RemoveFeatureOverride returns one feature flag of a tenant to its plan value
+// DELETE /api/v1/tenants/{id}/features/{flag}
+func (h *FeatureHandler) RemoveFeatureOverride(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	features, err := h.removeUC.Execute(r.Context(), usecase.RemoveFeatureOverrideCommand{
+		TenantID: tenantID,
+		Key:      chi.URLParam(r, "flag"),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromFeatureValues(features))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *FeatureHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrFeatureNotFound):
+		writeError(w, http.StatusNotFound, "FEATURE_NOT_FOUND", "Feature flag not found", nil)
+	case errors.Is(err, domain.ErrFeatureOverrideNotFound):
+		writeError(w, http.StatusNotFound, "FEATURE_OVERRIDE_NOT_FOUND", "Tenant has no override for this feature flag", nil)
+	case errors.Is(err, domain.ErrInvalidFeatureKey),
+		errors.Is(err, domain.ErrInvalidFeatureType),
+		errors.Is(err, domain.ErrEmptyFeatureDescription),
+		errors.Is(err, domain.ErrInvalidFeatureRollout),
+		errors.Is(err, domain.ErrInvalidFeatureValue):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
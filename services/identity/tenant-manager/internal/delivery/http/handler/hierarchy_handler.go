@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// HierarchyHandler handles tenant hierarchy HTTP requests
+type HierarchyHandler struct {
+	getUC       *usecase.GetTenantHierarchyUseCase
+	setParentUC *usecase.SetTenantParentUseCase
+	logger      *zap.Logger
+}
+
+// NewHierarchyHandler creates a new hierarchy handler
+func NewHierarchyHandler(
+	getUC *usecase.GetTenantHierarchyUseCase,
+	setParentUC *usecase.SetTenantParentUseCase,
+	logger *zap.Logger,
+) *HierarchyHandler {
+	return &HierarchyHandler{
+		getUC:       getUC,
+		setParentUC: setParentUC,
+		logger:      logger,
+	}
+}
+
+// GetTenantHierarchy retrieves a tenant with its ancestors and descendants
+// GET /api/v1/tenants/{id}/hierarchy
+func (h *HierarchyHandler) GetTenantHierarchy(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	hierarchy, err := h.getUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromTenantHierarchy(hierarchy))
+}
+
+// SetTenantParent moves a tenant under another tenant or makes it a root
+// PUT /api/v1/tenants/{id}/parent
+func (h *HierarchyHandler) SetTenantParent(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.SetTenantParentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	tenant, err := h.setParentUC.Execute(r.Context(), usecase.SetTenantParentCommand{
+		TenantID:       tenantID,
+		ParentTenantID: req.ParentTenantID,
+		InheritQuotas:  req.InheritQuotas,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromDomain(tenant))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *HierarchyHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrParentTenantNotFound):
+		writeError(w, http.StatusBadRequest, "PARENT_TENANT_NOT_FOUND", "Parent tenant not found", nil)
+	case errors.Is(err, domain.ErrParentTenantDeleted):
+		writeError(w, http.StatusConflict, "PARENT_TENANT_DELETED", "Parent tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrHierarchyCycle):
+		writeError(w, http.StatusConflict, "HIERARCHY_CYCLE", "Tenant cannot be placed under itself or one of its descendants", nil)
+	case errors.Is(err, domain.ErrHierarchyTooDeep):
+		writeError(w, http.StatusConflict, "HIERARCHY_TOO_DEEP", err.Error(), nil)
+	case errors.Is(err, domain.ErrQuotaInheritanceWithoutParent):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
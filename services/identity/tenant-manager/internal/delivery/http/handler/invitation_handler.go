@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// InvitationHandler handles tenant invitation HTTP requests
+type InvitationHandler struct {
+	inviteUC  *usecase.InviteMemberUseCase
+	resendUC  *usecase.ResendInvitationUseCase
+	revokeUC  *usecase.RevokeInvitationUseCase
+	acceptUC  *usecase.AcceptInvitationUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+// NewInvitationHandler creates a new tenant invitation handler
+func NewInvitationHandler(
+	inviteUC *usecase.InviteMemberUseCase,
+	resendUC *usecase.ResendInvitationUseCase,
+	revokeUC *usecase.RevokeInvitationUseCase,
+	acceptUC *usecase.AcceptInvitationUseCase,
+	logger *zap.Logger,
+) *InvitationHandler {
+	return &InvitationHandler{
+		inviteUC:  inviteUC,
+		resendUC:  resendUC,
+		revokeUC:  revokeUC,
+		acceptUC:  acceptUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// InviteMember invites a person, by email, to join a tenant
+// POST /api/v1/tenants/{id}/invitations
+func (h *InvitationHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.InviteMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	inv, err := h.inviteUC.Execute(r.Context(), usecase.InviteMemberCommand{
+		TenantID: tenantID,
+		Email:    req.Email,
+		Role:     domain.MemberRole(req.Role),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, dto.FromInvitation(inv))
+}
+
+// ListInvitations lists the invitations of a tenant
+// GET /api/v1/tenants/{id}/invitations
+func (h *InvitationHandler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	status := domain.InvitationStatus(r.URL.Query().Get("status"))
+	if status != "" && !status.IsValid() {
+		writeError(w, http.StatusBadRequest, "INVALID_STATUS", "Status must be pending, accepted or revoked", nil)
+		return
+	}
+
+	invitations, err := h.inviteUC.List(r.Context(), tenantID, status)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromInvitations(invitations))
+}
+
+// ResendInvitation mails a pending invitation again with a new link
+// POST /api/v1/tenants/{id}/invitations/{invitationId}/resend
+func (h *InvitationHandler) ResendInvitation(w http.ResponseWriter, r *http.Request) {
+	tenantID, invitationID, ok := parseInvitationParams(w, r)
+	if !ok {
+		return
+	}
+
+	inv, err := h.resendUC.Execute(r.Context(), usecase.ResendInvitationCommand{
+		TenantID:     tenantID,
+		InvitationID: invitationID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromInvitation(inv))
+}
+
+// RevokeInvitation withdraws a pending invitation
+// DELETE /api/v1/tenants/{id}/invitations/{invitationId}
+func (h *InvitationHandler) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	tenantID, invitationID, ok := parseInvitationParams(w, r)
+	if !ok {
+		return
+	}
+
+	inv, err := h.revokeUC.Execute(r.Context(), usecase.RevokeInvitationCommand{
+		TenantID:     tenantID,
+		InvitationID: invitationID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromInvitation(inv))
+}
+
+// AcceptInvitation makes the authenticated user a member of the tenant they
+// were invited to
+// POST /api/v1/invitations/accept
+func (h *InvitationHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required", nil)
+		return
+	}
+
+	// Invitations are for people; the membership is keyed by Keycloak user ID
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil || claims.IsServiceAccount() {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "Only users can accept invitations", nil)
+		return
+	}
+
+	var req dto.AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	result, err := h.acceptUC.Execute(r.Context(), usecase.AcceptInvitationCommand{
+		Token:         req.Token,
+		UserID:        userID,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, &dto.AcceptInvitationResponse{
+		Invitation: dto.FromInvitation(result.Invitation),
+		Member:     dto.FromMember(result.Member),
+	})
+}
+
+// parseInvitationParams parses the tenant and invitation IDs of an
+// invitation route, writing the error response when either is malformed
+func parseInvitationParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	invitationID, err := uuid.Parse(chi.URLParam(r, "invitationId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid invitation ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return tenantID, invitationID, true
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *InvitationHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case domain.IsValidationError(err):
+		writeError(w, http.StatusBadRequest, "INVALID_INVITATION", err.Error(), nil)
+	case errors.Is(err, domain.ErrInvitationNotFound):
+		writeError(w, http.StatusNotFound, "INVITATION_NOT_FOUND", "Invitation not found", nil)
+	case errors.Is(err, domain.ErrInvitationAlreadyExists):
+		writeError(w, http.StatusConflict, "INVITATION_EXISTS", "Email already has a pending invitation to the tenant; resend it instead", nil)
+	case errors.Is(err, domain.ErrInvitationNotPending):
+		writeError(w, http.StatusConflict, "INVITATION_NOT_PENDING", "Invitation was already accepted or revoked", nil)
+	case errors.Is(err, domain.ErrInvalidInvitationToken):
+		writeError(w, http.StatusBadRequest, "INVALID_INVITATION_TOKEN", "Invalid invitation token", nil)
+	case errors.Is(err, domain.ErrInvitationExpired):
+		writeError(w, http.StatusGone, "INVITATION_EXPIRED", "Invitation has expired", nil)
+	case errors.Is(err, domain.ErrInvitationEmailMismatch):
+		writeError(w, http.StatusForbidden, "INVITATION_EMAIL_MISMATCH", "Invitation was sent to another email address", nil)
+	case errors.Is(err, domain.ErrEmailNotVerified):
+		writeError(w, http.StatusForbidden, "EMAIL_NOT_VERIFIED", "Verify your email address before accepting the invitation", nil)
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		writeError(w, http.StatusConflict, "MEMBER_EXISTS", "User is already a member of the tenant", nil)
+	case errors.Is(err, domain.ErrMemberLimitReached):
+		writeError(w, http.StatusConflict, "MEMBER_LIMIT_REACHED", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// MemberHandler handles tenant membership HTTP requests
+type MemberHandler struct {
+	addUC        *usecase.AddMemberUseCase
+	removeUC     *usecase.RemoveMemberUseCase
+	changeRoleUC *usecase.ChangeMemberRoleUseCase
+	validator    *validator.Validate
+	logger       *zap.Logger
+}
+
+// NewMemberHandler creates a new tenant membership handler
+func NewMemberHandler(
+	addUC *usecase.AddMemberUseCase,
+	removeUC *usecase.RemoveMemberUseCase,
+	changeRoleUC *usecase.ChangeMemberRoleUseCase,
+	logger *zap.Logger,
+) *MemberHandler {
+	return &MemberHandler{
+		addUC:        addUC,
+		removeUC:     removeUC,
+		changeRoleUC: changeRoleUC,
+		validator:    validator.New(),
+		logger:       logger,
+	}
+}
+
+// AddMember adds a user to a tenant
+// POST /api/v1/tenants/{id}/members
+func (h *MemberHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.AddMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	m, err := h.addUC.Execute(r.Context(), usecase.AddMemberCommand{
+		TenantID: tenantID,
+		UserID:   req.UserID,
+		Role:     domain.MemberRole(req.Role),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, dto.FromMember(m))
+}
+
+// ListMembers lists the active members of a tenant
+// GET /api/v1/tenants/{id}/members
+func (h *MemberHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	members, err := h.addUC.List(r.Context(), tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromMembers(members))
+}
+
+// ChangeMemberRole gives a tenant member another role
+// PUT /api/v1/tenants/{id}/members/{userId}/role
+func (h *MemberHandler) ChangeMemberRole(w http.ResponseWriter, r *http.Request) {
+	tenantID, userID, ok := parseMemberParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	m, err := h.changeRoleUC.Execute(r.Context(), usecase.ChangeMemberRoleCommand{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     domain.MemberRole(req.Role),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromMember(m))
+}
+
+// RemoveMember removes a user from a tenant
+// DELETE /api/v1/tenants/{id}/members/{userId}
+func (h *MemberHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	tenantID, userID, ok := parseMemberParams(w, r)
+	if !ok {
+		return
+	}
+
+	m, err := h.removeUC.Execute(r.Context(), usecase.RemoveMemberCommand{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromMember(m))
+}
+
+// parseMemberParams parses the tenant and user IDs of a member route,
+// writing the error response when either is malformed
+func parseMemberParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "userId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid user ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return tenantID, userID, true
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *MemberHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrInvalidMemberUser), errors.Is(err, domain.ErrInvalidMemberRole):
+		writeError(w, http.StatusBadRequest, "INVALID_MEMBER", err.Error(), nil)
+	case errors.Is(err, domain.ErrMemberNotFound):
+		writeError(w, http.StatusNotFound, "MEMBER_NOT_FOUND", "Tenant member not found", nil)
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		writeError(w, http.StatusConflict, "MEMBER_EXISTS", "User is already a member of the tenant", nil)
+	case errors.Is(err, domain.ErrMemberRoleUnchanged):
+		writeError(w, http.StatusConflict, "ROLE_UNCHANGED", "Member already has this role", nil)
+	case errors.Is(err, domain.ErrMemberLimitReached):
+		writeError(w, http.StatusConflict, "MEMBER_LIMIT_REACHED", err.Error(), nil)
+	case errors.Is(err, domain.ErrLastTenantAdmin):
+		writeError(w, http.StatusConflict, "LAST_TENANT_ADMIN", "Tenant must keep at least one admin", nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
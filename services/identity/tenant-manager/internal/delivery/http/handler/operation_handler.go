@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// OperationHandler handles scheduled lifecycle operation HTTP requests
+type OperationHandler struct {
+	scheduleUC *usecase.ScheduleOperationUseCase
+	cancelUC   *usecase.CancelOperationUseCase
+	validator  *validator.Validate
+	logger     *zap.Logger
+}
+
+// NewOperationHandler creates a new scheduled operation handler
+func NewOperationHandler(
+	scheduleUC *usecase.ScheduleOperationUseCase,
+	cancelUC *usecase.CancelOperationUseCase,
+	logger *zap.Logger,
+) *OperationHandler {
+	return &OperationHandler{
+		scheduleUC: scheduleUC,
+		cancelUC:   cancelUC,
+		validator:  validator.New(),
+		logger:     logger,
+	}
+}
+
+// ScheduleOperation schedules a suspension or activation of a tenant
+// POST /api/v1/tenants/{id}/scheduled-operations
+func (h *OperationHandler) ScheduleOperation(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.ScheduleOperationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	op, err := h.scheduleUC.Execute(r.Context(), usecase.ScheduleOperationCommand{
+		TenantID:        tenantID,
+		Action:          domain.LifecycleAction(req.Action),
+		Reason:          domain.SuspensionReason(req.Reason),
+		Notes:           req.Notes,
+		RunAt:           req.RunAt,
+		ReactivateAfter: req.ReactivateAfter(),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, dto.FromScheduledOperation(op))
+}
+
+// ListOperations lists the scheduled operations of a tenant, optionally of
+// one status
+// GET /api/v1/tenants/{id}/scheduled-operations?status=pending
+func (h *OperationHandler) ListOperations(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	status := domain.OperationStatus(r.URL.Query().Get("status"))
+	if status != "" && !status.IsValid() {
+		writeError(w, http.StatusBadRequest, "INVALID_STATUS", "Status must be pending, completed, failed or canceled", nil)
+		return
+	}
+
+	ops, err := h.scheduleUC.List(r.Context(), tenantID, status)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromScheduledOperations(ops))
+}
+
+// CancelOperation cancels a pending scheduled operation
+// DELETE /api/v1/tenants/{id}/scheduled-operations/{operationId}
+func (h *OperationHandler) CancelOperation(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	operationID, err := uuid.Parse(chi.URLParam(r, "operationId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid operation ID format", nil)
+		return
+	}
+
+	op, err := h.cancelUC.Execute(r.Context(), usecase.CancelOperationCommand{
+		TenantID:    tenantID,
+		OperationID: operationID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromScheduledOperation(op))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *OperationHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrInvalidOperationAction),
+		errors.Is(err, domain.ErrInvalidOperationTime),
+		errors.Is(err, domain.ErrInvalidSuspensionReason),
+		errors.Is(err, domain.ErrInvalidReactivateAfter),
+		errors.Is(err, domain.ErrRestrictedReactivation):
+		writeError(w, http.StatusBadRequest, "INVALID_OPERATION", err.Error(), nil)
+	case errors.Is(err, domain.ErrOperationNotFound):
+		writeError(w, http.StatusNotFound, "OPERATION_NOT_FOUND", "Scheduled operation not found", nil)
+	case errors.Is(err, domain.ErrOperationNotPending):
+		writeError(w, http.StatusConflict, "OPERATION_NOT_PENDING", "Scheduled operation has already run or been canceled", nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
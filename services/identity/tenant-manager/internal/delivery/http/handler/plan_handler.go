@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// PlanHandler handles plan catalog HTTP requests
+type PlanHandler struct {
+	getUC     *usecase.GetPlanUseCase
+	createUC  *usecase.CreatePlanUseCase
+	retireUC  *usecase.RetirePlanUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+// NewPlanHandler creates a new plan handler
+func NewPlanHandler(
+	getUC *usecase.GetPlanUseCase,
+	createUC *usecase.CreatePlanUseCase,
+	retireUC *usecase.RetirePlanUseCase,
+	logger *zap.Logger,
+) *PlanHandler {
+	return &PlanHandler{
+		getUC:     getUC,
+		createUC:  createUC,
+		retireUC:  retireUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// ListPlans lists the plans of the catalog
+// GET /api/v1/plans?includeRetired=true
+func (h *PlanHandler) ListPlans(w http.ResponseWriter, r *http.Request) {
+	includeRetired := r.URL.Query().Get("includeRetired") == "true"
+
+	plans, err := h.getUC.List(r.Context(), includeRetired)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := make([]*dto.PlanResponse, 0, len(plans))
+	for _, plan := range plans {
+		response = append(response, dto.FromPlan(plan))
+	}
+
+	writeSuccess(w, http.StatusOK, response)
+}
+
+// GetPlan retrieves a plan
+// GET /api/v1/plans/{tier}
+func (h *PlanHandler) GetPlan(w http.ResponseWriter, r *http.Request) {
+	plan, err := h.getUC.Execute(r.Context(), domain.PlanTier(chi.URLParam(r, "tier")))
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromPlan(plan))
+}
+
+// CreatePlan adds a plan to the catalog
+// POST /api/v1/plans
+func (h *PlanHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreatePlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	plan, err := h.createUC.Execute(r.Context(), usecase.CreatePlanCommand{
+		Tier:         domain.PlanTier(req.Tier),
+		DisplayName:  req.DisplayName,
+		Description:  req.Description,
+		MaxUsers:     req.MaxUsers,
+		MaxStorageGB: req.MaxStorageGB,
+		Features:     req.Features,
+		SortOrder:    req.SortOrder,
+		Entitlements: entitlementCommands(req.Entitlements),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, dto.FromPlan(plan))
+}
+
+// RetirePlan stops a plan from being assigned to tenants
+// POST /api/v1/plans/{tier}/retire
+func (h *PlanHandler) RetirePlan(w http.ResponseWriter, r *http.Request) {
+	plan, err := h.retireUC.Execute(r.Context(), domain.PlanTier(chi.URLParam(r, "tier")))
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromPlan(plan))
+}
+
+// entitlementCommands converts requested entitlement limits to use case commands
+func entitlementCommands(limits []dto.EntitlementLimit) []usecase.EntitlementLimitCommand {
+	cmds := make([]usecase.EntitlementLimitCommand, 0, len(limits))
+	for _, l := range limits {
+		cmds = append(cmds, usecase.EntitlementLimitCommand{
+			Name:   l.Name,
+			Limit:  l.Limit,
+			Window: domain.EntitlementWindow(l.Window),
+			Period: l.Period(),
+		})
+	}
+	return cmds
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *PlanHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrPlanNotFound):
+		writeError(w, http.StatusNotFound, "PLAN_NOT_FOUND", "Plan not found", nil)
+	case errors.Is(err, domain.ErrPlanAlreadyExists):
+		writeError(w, http.StatusConflict, "PLAN_EXISTS", "Plan already exists", nil)
+	case errors.Is(err, domain.ErrPlanRetired):
+		writeError(w, http.StatusConflict, "PLAN_RETIRED", "Plan is already retired", nil)
+	case errors.Is(err, domain.ErrInvalidPlanTier),
+		errors.Is(err, domain.ErrEmptyPlanName),
+		errors.Is(err, domain.ErrInvalidPlanQuota),
+		errors.Is(err, domain.ErrInvalidEntitlementName),
+		errors.Is(err, domain.ErrInvalidEntitlementLimit),
+		errors.Is(err, domain.ErrInvalidEntitlementWindow),
+		errors.Is(err, domain.ErrDuplicateEntitlement),
+		errors.Is(err, domain.ErrFeatureNotFound),
+		errors.Is(err, domain.ErrInvalidFeatureValue):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
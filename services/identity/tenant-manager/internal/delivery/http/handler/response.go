@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// writeSuccess sends a success response
+func writeSuccess(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	response := dto.SuccessResponse{
+		Data: data,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeError sends an error response
+func writeError(w http.ResponseWriter, status int, code, message string, details []dto.FieldError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	response := dto.ErrorResponse{
+		Error: dto.ErrorDetail{
+			Code:    code,
+			Message: message,
+			Details: details,
+		},
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// validationFieldErrors converts validator errors to field errors
+func validationFieldErrors(err error) []dto.FieldError {
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return nil
+	}
+
+	fieldErrors := make([]dto.FieldError, 0, len(errs))
+	for _, err := range errs {
+		fieldErrors = append(fieldErrors, dto.FieldError{
+			Field:   err.Field(),
+			Message: validationErrorMessage(err),
+		})
+	}
+
+	return fieldErrors
+}
+
+// settingsFieldErrors converts invalid tenant settings to field errors
+func settingsFieldErrors(err error) []dto.FieldError {
+	var settingsErr *domain.SettingsError
+	if !errors.As(err, &settingsErr) {
+		return nil
+	}
+
+	fieldErrors := make([]dto.FieldError, 0, len(settingsErr.Fields))
+	for _, f := range settingsErr.Fields {
+		fieldErrors = append(fieldErrors, dto.FieldError{
+			Field:   f.Field,
+			Message: f.Message,
+		})
+	}
+
+	return fieldErrors
+}
+
+// validationErrorMessage generates user-friendly validation messages
+func validationErrorMessage(err validator.FieldError) string {
+	switch err.Tag() {
+	case "required":
+		return "This field is required"
+	case "email":
+		return "Must be a valid email address"
+	case "min":
+		return "Must be at least " + err.Param() + " characters"
+	case "max":
+		return "Must be at most " + err.Param() + " characters"
+	case "lowercase":
+		return "Must be lowercase"
+	case "alphanum_hyphen":
+		return "Must contain only alphanumeric characters and hyphens"
+	case "oneof":
+		return "Must be one of: " + err.Param()
+	default:
+		return "Invalid value"
+	}
+}
+
+// actorID returns the authenticated caller's ID, if it is a UUID
+func actorID(r *http.Request) *uuid.UUID {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
+
+// transitionMessage describes an illegal lifecycle transition without the
+// use case's error wrapping
+func transitionMessage(err error) string {
+	var transitionErr *domain.TransitionError
+	if errors.As(err, &transitionErr) {
+		return transitionErr.Error()
+	}
+	return "Illegal tenant status transition"
+}
+
+// reinstatementMessage describes the authority a refused reactivation needs
+func reinstatementMessage(err error) string {
+	var reinstatementErr *domain.ReinstatementError
+	if errors.As(err, &reinstatementErr) {
+		return reinstatementErr.Error()
+	}
+	return "Suspension reason does not allow self-service reactivation"
+}
+
+// planLimitMessage describes the quota a refused plan change would exceed
+func planLimitMessage(err error) string {
+	var limitErr *domain.PlanLimitError
+	if errors.As(err, &limitErr) {
+		return limitErr.Error()
+	}
+	return "Tenant usage exceeds the plan limits"
+}
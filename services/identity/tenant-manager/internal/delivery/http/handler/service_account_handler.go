@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// ServiceAccountHandler handles service account and API key HTTP requests
+type ServiceAccountHandler struct {
+	createUC    *usecase.CreateServiceAccountUseCase
+	getUC       *usecase.GetServiceAccountUseCase
+	deleteUC    *usecase.DeleteServiceAccountUseCase
+	issueKeyUC  *usecase.IssueAPIKeyUseCase
+	revokeKeyUC *usecase.RevokeAPIKeyUseCase
+	validator   *validator.Validate
+	logger      *zap.Logger
+}
+
+// NewServiceAccountHandler creates a new service account handler
+func NewServiceAccountHandler(
+	createUC *usecase.CreateServiceAccountUseCase,
+	getUC *usecase.GetServiceAccountUseCase,
+	deleteUC *usecase.DeleteServiceAccountUseCase,
+	issueKeyUC *usecase.IssueAPIKeyUseCase,
+	revokeKeyUC *usecase.RevokeAPIKeyUseCase,
+	logger *zap.Logger,
+) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		createUC:    createUC,
+		getUC:       getUC,
+		deleteUC:    deleteUC,
+		issueKeyUC:  issueKeyUC,
+		revokeKeyUC: revokeKeyUC,
+		validator:   validator.New(),
+		logger:      logger,
+	}
+}
+
+// CreateServiceAccount creates a new service account
+// POST /api/v1/service-accounts
+func (h *ServiceAccountHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	account, err := h.createUC.Execute(r.Context(), usecase.CreateServiceAccountCommand{
+		Name:        req.Name,
+		Description: req.Description,
+		TenantID:    req.TenantID,
+		CreatedBy:   actorID(r),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, dto.FromServiceAccount(account, nil))
+}
+
+// ListServiceAccounts lists all service accounts
+// GET /api/v1/service-accounts
+func (h *ServiceAccountHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	accounts, err := h.getUC.List(r.Context())
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := make([]*dto.ServiceAccountResponse, 0, len(accounts))
+	for _, account := range accounts {
+		response = append(response, dto.FromServiceAccount(account, nil))
+	}
+
+	writeSuccess(w, http.StatusOK, response)
+}
+
+// GetServiceAccount retrieves a service account with its API keys
+// GET /api/v1/service-accounts/{id}
+func (h *ServiceAccountHandler) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	details, err := h.getUC.Execute(r.Context(), id)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromServiceAccount(details.Account, details.Keys))
+}
+
+// DeleteServiceAccount deactivates a service account
+// DELETE /api/v1/service-accounts/{id}
+func (h *ServiceAccountHandler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.deleteUC.Execute(r.Context(), id); err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// IssueAPIKey issues a new API key; the plaintext key is only returned here
+// POST /api/v1/service-accounts/{id}/keys
+func (h *ServiceAccountHandler) IssueAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req dto.IssueAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	result, err := h.issueKeyUC.Execute(r.Context(), usecase.IssueAPIKeyCommand{
+		ServiceAccountID: id,
+		Name:             req.Name,
+		Permissions:      req.Permissions,
+		ExpiresAt:        req.ExpiresAt,
+		CreatedBy:        actorID(r),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, &dto.IssuedAPIKeyResponse{
+		APIKeyResponse: dto.FromAPIKey(result.Key),
+		Key:            result.Plaintext,
+	})
+}
+
+// RevokeAPIKey revokes an API key
+// DELETE /api/v1/service-accounts/{id}/keys/{keyId}
+func (h *ServiceAccountHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	keyID, ok := h.parseID(w, r, "keyId")
+	if !ok {
+		return
+	}
+
+	if err := h.revokeKeyUC.Execute(r.Context(), id, keyID); err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseID parses a UUID route parameter, responding with 400 on failure
+func (h *ServiceAccountHandler) parseID(w http.ResponseWriter, r *http.Request, param string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid "+param+" format", nil)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *ServiceAccountHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrServiceAccountNotFound):
+		writeError(w, http.StatusNotFound, "SERVICE_ACCOUNT_NOT_FOUND", "Service account not found", nil)
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		writeError(w, http.StatusNotFound, "API_KEY_NOT_FOUND", "API key not found", nil)
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrServiceAccountExists):
+		writeError(w, http.StatusConflict, "SERVICE_ACCOUNT_EXISTS", "Service account name already exists", nil)
+	case errors.Is(err, domain.ErrServiceAccountInactive):
+		writeError(w, http.StatusConflict, "SERVICE_ACCOUNT_INACTIVE", "Service account is inactive", nil)
+	case errors.Is(err, domain.ErrAPIKeyRevoked):
+		writeError(w, http.StatusConflict, "API_KEY_REVOKED", "API key is already revoked", nil)
+	case errors.Is(err, domain.ErrEmptyServiceAccountName),
+		errors.Is(err, domain.ErrInvalidServiceAccountName),
+		errors.Is(err, domain.ErrEmptyPermissions),
+		errors.Is(err, domain.ErrInvalidPermission),
+		errors.Is(err, domain.ErrInvalidKeyExpiry):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// SlugHandler handles tenant slug HTTP requests
+type SlugHandler struct {
+	getUC     *usecase.GetTenantUseCase
+	renameUC  *usecase.RenameSlugUseCase
+	releaseUC *usecase.ReleaseSlugAliasUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+// NewSlugHandler creates a new slug handler
+func NewSlugHandler(
+	getUC *usecase.GetTenantUseCase,
+	renameUC *usecase.RenameSlugUseCase,
+	releaseUC *usecase.ReleaseSlugAliasUseCase,
+	logger *zap.Logger,
+) *SlugHandler {
+	return &SlugHandler{
+		getUC:     getUC,
+		renameUC:  renameUC,
+		releaseUC: releaseUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// GetTenantBySlug resolves a slug, current or former, to its tenant
+// GET /api/v1/tenants/by-slug/{slug}
+func (h *SlugHandler) GetTenantBySlug(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "slug")
+
+	tenant, err := h.getUC.ExecuteBySlug(r.Context(), slug)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromSlugResolution(slug, tenant))
+}
+
+// RenameSlug changes the slug of a tenant, keeping the former one as an alias
+// POST /api/v1/tenants/{id}/slug
+func (h *SlugHandler) RenameSlug(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	var req dto.RenameSlugRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	tenant, err := h.renameUC.Execute(r.Context(), usecase.RenameSlugCommand{
+		TenantID: tenantID,
+		Slug:     req.Slug,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromDomain(tenant))
+}
+
+// ListSlugAliases lists the former slugs of a tenant
+// GET /api/v1/tenants/{id}/slug-aliases
+func (h *SlugHandler) ListSlugAliases(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	aliases, err := h.releaseUC.List(r.Context(), tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromSlugAliases(aliases))
+}
+
+// ReleaseSlugAlias stops a former slug from resolving to the tenant
+// DELETE /api/v1/tenants/{id}/slug-aliases/{slug}
+func (h *SlugHandler) ReleaseSlugAlias(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	alias, err := h.releaseUC.Execute(r.Context(), usecase.ReleaseSlugAliasCommand{
+		TenantID: tenantID,
+		Slug:     chi.URLParam(r, "slug"),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromSlugAlias(alias))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *SlugHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	var cooldownErr *domain.SlugCooldownError
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrSlugAlreadyExists):
+		writeError(w, http.StatusConflict, "SLUG_EXISTS", "Tenant slug already exists", nil)
+	case errors.As(err, &cooldownErr):
+		writeError(w, http.StatusConflict, "SLUG_IN_COOLDOWN",
+			fmt.Sprintf("Tenant slug was released recently and can be claimed after %s", cooldownErr.Until.Format(time.RFC3339)), nil)
+	case errors.Is(err, domain.ErrSlugUnchanged):
+		writeError(w, http.StatusConflict, "SLUG_UNCHANGED", "Tenant already has this slug", nil)
+	case errors.Is(err, domain.ErrSlugReserved):
+		writeError(w, http.StatusBadRequest, "SLUG_RESERVED", "Tenant slug is reserved", nil)
+	case errors.Is(err, domain.ErrSlugAliasNotFound):
+		writeError(w, http.StatusNotFound, "SLUG_ALIAS_NOT_FOUND", "Tenant has no such former slug", nil)
+	case errors.Is(err, domain.ErrSlugAliasReleased):
+		writeError(w, http.StatusConflict, "SLUG_ALIAS_RELEASED", "Former slug is already released", nil)
+	case errors.Is(err, domain.ErrEmptyTenantSlug),
+		errors.Is(err, domain.ErrTenantSlugTooLong),
+		errors.Is(err, domain.ErrInvalidTenantSlug):
+		writeError(w, http.StatusBadRequest, "INVALID_SLUG", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
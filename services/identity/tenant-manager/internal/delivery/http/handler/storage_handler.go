@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// StorageHandler handles tenant storage usage HTTP requests
+type StorageHandler struct {
+	usageUC *usecase.GetStorageUsageUseCase
+	logger  *zap.Logger
+}
+
+// NewStorageHandler creates a new storage handler
+func NewStorageHandler(usageUC *usecase.GetStorageUsageUseCase, logger *zap.Logger) *StorageHandler {
+	return &StorageHandler{
+		usageUC: usageUC,
+		logger:  logger,
+	}
+}
+
+// GetStorageUsage reports the measured storage of a tenant and its history
+// GET /api/v1/tenants/{id}/usage/storage?from=&to=&limit=
+func (h *StorageHandler) GetStorageUsage(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	query, err := dto.ParseStorageUsageQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_QUERY", err.Error(), nil)
+		return
+	}
+
+	usage, err := h.usageUC.Execute(r.Context(), usecase.GetStorageUsageQuery{
+		TenantID: tenantID,
+		From:     query.From,
+		To:       query.To,
+		Limit:    query.Limit,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.NewStorageUsageResponse(usage.QuotaBytes, usage.Current, usage.History))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *StorageHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrInvalidTimeRange):
+		writeError(w, http.StatusBadRequest, "INVALID_QUERY", err.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
 	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
 	"github.com/cotai/tenant-manager/internal/usecase"
 )
 
@@ -24,10 +27,32 @@ type TenantHandler struct {
 	suspendTenantUC *usecase.SuspendTenantUseCase
 	activateTenantUC *usecase.ActivateTenantUseCase
 	deleteTenantUC  *usecase.DeleteTenantUseCase
+	historyUC       *usecase.GetTenantHistoryUseCase
+	archiveUC       *usecase.ArchiveTenantUseCase
+	unarchiveUC     *usecase.UnarchiveTenantUseCase
+	restoreUC       *usecase.RestoreTenantUseCase
+	changePlanUC    *usecase.ChangePlanUseCase
+	planHistoryUC   *usecase.GetPlanHistoryUseCase
+	setQuotaUC      *usecase.SetQuotaOverrideUseCase
+	removeQuotaUC   *usecase.RemoveQuotaOverrideUseCase
+	permissions     PermissionChecker
 	validator       *validator.Validate
 	logger          *zap.Logger
 }
 
+// PermissionChecker answers whether the authenticated caller holds a
+// permission on a tenant
+type PermissionChecker interface {
+	Can(ctx context.Context, perm rbac.Permission, targetTenantID string) bool
+}
+
+// reinstatementPermissions are the permissions conferring the authority to
+// lift restricted suspensions
+var reinstatementPermissions = map[domain.ReinstatementAuthority]rbac.Permission{
+	domain.AuthorityPlatform:   rbac.TenantReinstate,
+	domain.AuthorityCompliance: rbac.TenantLiftLegalHold,
+}
+
 // NewTenantHandler creates a new tenant handler
 func NewTenantHandler(
 	createTenantUC *usecase.CreateTenantUseCase,
@@ -37,6 +62,15 @@ func NewTenantHandler(
 	suspendTenantUC *usecase.SuspendTenantUseCase,
 	activateTenantUC *usecase.ActivateTenantUseCase,
 	deleteTenantUC *usecase.DeleteTenantUseCase,
+	historyUC *usecase.GetTenantHistoryUseCase,
+	archiveUC *usecase.ArchiveTenantUseCase,
+	unarchiveUC *usecase.UnarchiveTenantUseCase,
+	restoreUC *usecase.RestoreTenantUseCase,
+	changePlanUC *usecase.ChangePlanUseCase,
+	planHistoryUC *usecase.GetPlanHistoryUseCase,
+	setQuotaUC *usecase.SetQuotaOverrideUseCase,
+	removeQuotaUC *usecase.RemoveQuotaOverrideUseCase,
+	permissions PermissionChecker,
 	logger *zap.Logger,
 ) *TenantHandler {
 	return &TenantHandler{
@@ -47,6 +81,15 @@ func NewTenantHandler(
 		suspendTenantUC:  suspendTenantUC,
 		activateTenantUC: activateTenantUC,
 		deleteTenantUC:   deleteTenantUC,
+		historyUC:        historyUC,
+		archiveUC:        archiveUC,
+		unarchiveUC:      unarchiveUC,
+		restoreUC:        restoreUC,
+		changePlanUC:     changePlanUC,
+		planHistoryUC:    planHistoryUC,
+		setQuotaUC:       setQuotaUC,
+		removeQuotaUC:    removeQuotaUC,
+		permissions:      permissions,
 		validator:        validator.New(),
 		logger:           logger,
 	}
@@ -73,12 +116,21 @@ func (h *TenantHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
 
 	// Convert DTO to use case command
 	cmd := usecase.CreateTenantCommand{
-		Name:       req.Name,
-		Slug:       req.Slug,
-		Plan:       req.ToTenantPlan(),
-		AdminEmail: req.AdminEmail,
-		AdminName:  req.AdminName,
-		Settings:   req.Settings,
+		Name:           req.Name,
+		Slug:           req.Slug,
+		Plan:           req.ToTenantPlan(),
+		AdminEmail:     req.AdminEmail,
+		AdminName:      req.AdminName,
+		Settings:       req.Settings,
+		ParentTenantID: req.ParentTenantID,
+		InheritQuotas:  req.InheritQuotas,
+	}
+	if req.Trial != nil {
+		cmd.Trial = &usecase.TrialTerms{
+			Duration:     req.Trial.Duration(),
+			FallbackPlan: domain.PlanTier(req.Trial.FallbackPlan),
+			ExpiryPolicy: domain.TrialExpiryPolicy(req.Trial.ExpiryPolicy),
+		}
 	}
 
 	// Execute use case
@@ -101,7 +153,7 @@ func (h *TenantHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListTenants lists tenants with pagination
-// GET /api/v1/tenants?page=1&pageSize=20&status=active&plan=professional&search=acme
+// GET /api/v1/tenants?page=1&pageSize=20&status=active&plan=professional&search=acme&ancestorId=...
 func (h *TenantHandler) ListTenants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -110,11 +162,13 @@ func (h *TenantHandler) ListTenants(w http.ResponseWriter, r *http.Request) {
 
 	// Convert to use case query
 	ucQuery := usecase.ListTenantsQuery{
-		Page:     query.Page,
-		PerPage:  query.PageSize,
-		Status:   query.ToTenantStatus(),
-		PlanTier: query.ToTenantPlan(),
-		Search:   query.Search,
+		Page:             query.Page,
+		PerPage:          query.PageSize,
+		Status:           query.ToTenantStatus(),
+		PlanTier:         query.ToTenantPlan(),
+		Search:           query.Search,
+		SuspensionReason: query.ToSuspensionReason(),
+		AncestorID:       query.AncestorID,
 	}
 
 	// Execute use case
@@ -144,7 +198,7 @@ func (h *TenantHandler) GetTenant(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Execute use case
-	tenant, err := h.getTenantUC.ExecuteByID(ctx, tenantID)
+	tenant, err := h.getTenantUC.ExecuteByTenantID(ctx, tenantID)
 	if err != nil {
 		h.handleUseCaseError(w, err)
 		return
@@ -156,6 +210,29 @@ func (h *TenantHandler) GetTenant(w http.ResponseWriter, r *http.Request) {
 	h.respondSuccess(w, http.StatusOK, response)
 }
 
+// GetTenantHistory retrieves the status history of a tenant
+// GET /api/v1/tenants/{id}/history
+func (h *TenantHandler) GetTenantHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID from URL
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Execute use case
+	transitions, err := h.historyUC.Execute(ctx, tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, dto.FromStatusHistory(transitions))
+}
+
 // UpdateTenant updates tenant information
 // PATCH /api/v1/tenants/{id}
 func (h *TenantHandler) UpdateTenant(w http.ResponseWriter, r *http.Request) {
@@ -240,7 +317,8 @@ func (h *TenantHandler) SuspendTenant(w http.ResponseWriter, r *http.Request) {
 	// Convert to use case command
 	cmd := usecase.SuspendTenantCommand{
 		TenantID: tenantID,
-		Reason:   req.Reason,
+		Reason:   domain.SuspensionReason(req.Reason),
+		Notes:    req.Notes,
 	}
 
 	// Execute use case
@@ -276,7 +354,8 @@ func (h *TenantHandler) ActivateTenant(w http.ResponseWriter, r *http.Request) {
 
 	// Convert to use case command
 	cmd := usecase.ActivateTenantCommand{
-		TenantID: tenantID,
+		TenantID:    tenantID,
+		Authorities: h.reinstatementAuthorities(r, tenantID),
 	}
 
 	// Execute use case
@@ -296,6 +375,96 @@ func (h *TenantHandler) ActivateTenant(w http.ResponseWriter, r *http.Request) {
 	h.respondSuccess(w, http.StatusOK, response)
 }
 
+// reinstatementAuthorities returns the caller's authorities over restricted
+// suspensions of the tenant
+func (h *TenantHandler) reinstatementAuthorities(r *http.Request, tenantID uuid.UUID) []domain.ReinstatementAuthority {
+	var authorities []domain.ReinstatementAuthority
+	for authority, perm := range reinstatementPermissions {
+		if h.permissions.Can(r.Context(), perm, tenantID.String()) {
+			authorities = append(authorities, authority)
+		}
+	}
+	return authorities
+}
+
+// ArchiveTenant exports a tenant's schema to the archive store and drops it
+// POST /api/v1/tenants/{id}/archive
+func (h *TenantHandler) ArchiveTenant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Parse request (body is optional)
+	var req dto.ArchiveTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.respondError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		validationErrors := h.parseValidationErrors(err.(validator.ValidationErrors))
+		h.respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationErrors)
+		return
+	}
+
+	// Convert to use case command
+	cmd := usecase.ArchiveTenantCommand{
+		TenantID: tenantID,
+		Reason:   req.Reason,
+	}
+
+	// Execute use case
+	tenant, archive, err := h.archiveUC.Execute(ctx, cmd)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	h.logger.Warn("Tenant archived",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.String("location", archive.Location),
+	)
+
+	h.respondSuccess(w, http.StatusOK, dto.FromArchive(tenant, archive))
+}
+
+// UnarchiveTenant restores an archived tenant's schema and reactivates it
+// POST /api/v1/tenants/{id}/unarchive
+func (h *TenantHandler) UnarchiveTenant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Execute use case
+	tenant, err := h.unarchiveUC.Execute(ctx, tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to response DTO
+	response := dto.FromDomain(tenant)
+
+	h.logger.Info("Tenant unarchived",
+		zap.String("tenant_id", tenant.TenantID.String()),
+	)
+
+	h.respondSuccess(w, http.StatusOK, response)
+}
+
 // DeleteTenant soft deletes a tenant
 // DELETE /api/v1/tenants/{id}
 func (h *TenantHandler) DeleteTenant(w http.ResponseWriter, r *http.Request) {
@@ -328,34 +497,311 @@ func (h *TenantHandler) DeleteTenant(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// RestoreTenant returns a soft-deleted tenant to its pre-deletion status
+// POST /api/v1/tenants/{id}/restore
+func (h *TenantHandler) RestoreTenant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Parse request (body is optional)
+	var req dto.RestoreTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.respondError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		validationErrors := h.parseValidationErrors(err.(validator.ValidationErrors))
+		h.respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationErrors)
+		return
+	}
+
+	// Convert to use case command
+	cmd := usecase.RestoreTenantCommand{
+		TenantID: tenantID,
+		Reason:   req.Reason,
+	}
+
+	// Execute use case
+	tenant, err := h.restoreUC.Execute(ctx, cmd)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to response DTO
+	response := dto.FromDomain(tenant)
+
+	h.logger.Warn("Tenant restored",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.String("status", string(tenant.Status)),
+	)
+
+	h.respondSuccess(w, http.StatusOK, response)
+}
+
+// ChangePlan moves a tenant to another subscription plan
+// POST /api/v1/tenants/{id}/plan
+func (h *TenantHandler) ChangePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Parse request
+	var req dto.ChangePlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		validationErrors := h.parseValidationErrors(err.(validator.ValidationErrors))
+		h.respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationErrors)
+		return
+	}
+
+	// Convert to use case command
+	cmd := usecase.ChangePlanCommand{
+		TenantID: tenantID,
+		Plan:     req.ToTenantPlan(),
+	}
+
+	// Execute use case
+	tenant, err := h.changePlanUC.Execute(ctx, cmd)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to response DTO
+	response := dto.FromDomain(tenant)
+
+	h.logger.Info("Tenant plan changed",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.String("plan", string(tenant.PlanTier)),
+	)
+
+	h.respondSuccess(w, http.StatusOK, response)
+}
+
+// GetPlanHistory retrieves the plan changes of a tenant
+// GET /api/v1/tenants/{id}/plan-history
+func (h *TenantHandler) GetPlanHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID from URL
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Execute use case
+	changes, err := h.planHistoryUC.Execute(ctx, tenantID)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, dto.FromPlanHistory(changes))
+}
+
+// SetQuotaOverride overrides one quota of a tenant
+// PUT /api/v1/tenants/{id}/quotas/{name}
+func (h *TenantHandler) SetQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// Parse request
+	var req dto.SetQuotaOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		validationErrors := h.parseValidationErrors(err.(validator.ValidationErrors))
+		h.respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationErrors)
+		return
+	}
+
+	// Convert to use case command
+	cmd := usecase.SetQuotaOverrideCommand{
+		TenantID:  tenantID,
+		Name:      domain.QuotaName(chi.URLParam(r, "name")),
+		Value:     req.Value,
+		Reason:    req.Reason,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	// Execute use case
+	tenant, err := h.setQuotaUC.Execute(ctx, cmd)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	h.logger.Info("Tenant quota override set",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.String("quota", string(cmd.Name)),
+	)
+
+	h.respondSuccess(w, http.StatusOK, dto.FromDomain(tenant))
+}
+
+// RemoveQuotaOverride returns one quota of a tenant to its plan default
+// DELETE /api/v1/tenants/{id}/quotas/{name}
+func (h *TenantHandler) RemoveQuotaOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse tenant ID
+	idParam := chi.URLParam(r, "id")
+	tenantID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.respondError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	cmd := usecase.RemoveQuotaOverrideCommand{
+		TenantID: tenantID,
+		Name:     domain.QuotaName(chi.URLParam(r, "name")),
+	}
+
+	// Execute use case
+	tenant, err := h.removeQuotaUC.Execute(ctx, cmd)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	h.logger.Info("Tenant quota override removed",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.String("quota", string(cmd.Name)),
+	)
+
+	h.respondSuccess(w, http.StatusOK, dto.FromDomain(tenant))
+}
+
 // handleUseCaseError maps domain errors to HTTP responses
 func (h *TenantHandler) handleUseCaseError(w http.ResponseWriter, err error) {
 	h.logger.Error("Use case error", zap.Error(err))
 
-	switch err {
-	case domain.ErrTenantNotFound:
+	// Specific errors first: illegal transitions also match ErrTenantAlready*
+	// and ErrTenantDeleted where those apply
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
 		h.respondError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
-	case domain.ErrSlugAlreadyExists:
+	case errors.Is(err, domain.ErrSlugAlreadyExists):
 		h.respondError(w, http.StatusConflict, "SLUG_EXISTS", "Tenant slug already exists", nil)
-	case domain.ErrTenantDeleted:
+	case errors.Is(err, domain.ErrSlugInCooldown):
+		h.respondError(w, http.StatusConflict, "SLUG_IN_COOLDOWN", "Tenant slug was released recently and cannot be claimed yet", nil)
+	case errors.Is(err, domain.ErrSlugReserved):
+		h.respondError(w, http.StatusBadRequest, "SLUG_RESERVED", "Tenant slug is reserved", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
 		h.respondError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
-	case domain.ErrInvalidPlanTier:
+	case errors.Is(err, domain.ErrInvalidPlanTier):
 		h.respondError(w, http.StatusBadRequest, "INVALID_PLAN", "Invalid plan tier", nil)
-	case domain.ErrInvalidTenantName:
+	case errors.Is(err, domain.ErrPlanNotFound):
+		h.respondError(w, http.StatusBadRequest, "INVALID_PLAN", "Unknown plan", nil)
+	case errors.Is(err, domain.ErrPlanRetired):
+		h.respondError(w, http.StatusConflict, "PLAN_RETIRED", "Plan is retired and can no longer be assigned", nil)
+	case errors.Is(err, domain.ErrPlanAlreadySet):
+		h.respondError(w, http.StatusConflict, "PLAN_ALREADY_SET", "Tenant already has this plan", nil)
+	case errors.Is(err, domain.ErrPlanLimitExceeded):
+		h.respondError(w, http.StatusConflict, "PLAN_LIMIT_EXCEEDED", planLimitMessage(err), nil)
+	case errors.Is(err, domain.ErrInvalidQuotaName):
+		h.respondError(w, http.StatusBadRequest, "INVALID_QUOTA", "Unknown quota", nil)
+	case errors.Is(err, domain.ErrInvalidQuotaValue):
+		h.respondError(w, http.StatusBadRequest, "INVALID_QUOTA_VALUE", "Quota value must be positive", nil)
+	case errors.Is(err, domain.ErrEmptyQuotaReason):
+		h.respondError(w, http.StatusBadRequest, "INVALID_QUOTA_REASON", "Quota override reason is required", nil)
+	case errors.Is(err, domain.ErrInvalidQuotaExpiry):
+		h.respondError(w, http.StatusBadRequest, "INVALID_QUOTA_EXPIRY", "Quota override expiry must be in the future", nil)
+	case errors.Is(err, domain.ErrQuotaOverrideNotFound):
+		h.respondError(w, http.StatusNotFound, "QUOTA_OVERRIDE_NOT_FOUND", "Tenant has no override for this quota", nil)
+	case errors.Is(err, domain.ErrSettingsUnreadable):
+		h.respondError(w, http.StatusConflict, "SETTINGS_UNREADABLE", "Tenant settings were written by a newer version and cannot be changed", nil)
+	case errors.Is(err, domain.ErrInvalidSettings):
+		h.respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid tenant settings", settingsFieldErrors(err))
+	case errors.Is(err, domain.ErrInvalidTenantName):
 		h.respondError(w, http.StatusBadRequest, "INVALID_NAME", "Invalid tenant name", nil)
-	case domain.ErrInvalidSlug:
+	case errors.Is(err, domain.ErrInvalidSlug):
 		h.respondError(w, http.StatusBadRequest, "INVALID_SLUG", "Invalid tenant slug", nil)
-	case domain.ErrInvalidEmail:
+	case errors.Is(err, domain.ErrInvalidEmail):
 		h.respondError(w, http.StatusBadRequest, "INVALID_EMAIL", "Invalid email address", nil)
-	case domain.ErrTenantAlreadyActive:
+	case errors.Is(err, domain.ErrTenantAlreadyActive):
 		h.respondError(w, http.StatusConflict, "ALREADY_ACTIVE", "Tenant is already active", nil)
-	case domain.ErrTenantAlreadySuspended:
+	case errors.Is(err, domain.ErrTenantAlreadySuspended):
 		h.respondError(w, http.StatusConflict, "ALREADY_SUSPENDED", "Tenant is already suspended", nil)
-	case domain.ErrCannotSuspendDeletedTenant:
+	case errors.Is(err, domain.ErrTenantAlreadyArchived):
+		h.respondError(w, http.StatusConflict, "ALREADY_ARCHIVED", "Tenant is already archived", nil)
+	case errors.Is(err, domain.ErrTenantAlreadyDeleted):
+		h.respondError(w, http.StatusConflict, "ALREADY_DELETED", "Tenant is already deleted", nil)
+	case errors.Is(err, domain.ErrCannotSuspendDeletedTenant):
 		h.respondError(w, http.StatusConflict, "CANNOT_SUSPEND_DELETED", "Cannot suspend deleted tenant", nil)
-	case context.Canceled:
+	case errors.Is(err, domain.ErrTenantNotDeleted):
+		h.respondError(w, http.StatusConflict, "TENANT_NOT_DELETED", "Tenant is not deleted", nil)
+	case errors.Is(err, domain.ErrTenantAlreadyPurged):
+		h.respondError(w, http.StatusGone, "TENANT_PURGED", "Tenant data has been purged", nil)
+	case errors.Is(err, domain.ErrRestoreWindowExpired):
+		h.respondError(w, http.StatusGone, "RESTORE_WINDOW_EXPIRED", "Tenant retention period has elapsed", nil)
+	case errors.Is(err, domain.ErrTenantSchemaMissing):
+		h.respondError(w, http.StatusConflict, "SCHEMA_MISSING", "Tenant schema no longer exists", nil)
+	case errors.Is(err, domain.ErrArchiveNotFound):
+		h.respondError(w, http.StatusNotFound, "ARCHIVE_NOT_FOUND", "No archive found for tenant", nil)
+	case errors.Is(err, domain.ErrArchiveChecksumMismatch):
+		h.respondError(w, http.StatusConflict, "ARCHIVE_CORRUPT", "Tenant archive failed checksum verification", nil)
+	case errors.Is(err, domain.ErrIllegalTransition):
+		h.respondError(w, http.StatusConflict, "ILLEGAL_TRANSITION", transitionMessage(err), nil)
+	case errors.Is(err, domain.ErrInvalidSuspensionReason):
+		h.respondError(w, http.StatusBadRequest, "INVALID_SUSPENSION_REASON", err.Error(), nil)
+	case errors.Is(err, domain.ErrReinstatementRestricted):
+		h.respondError(w, http.StatusForbidden, "REINSTATEMENT_RESTRICTED", reinstatementMessage(err), nil)
+	case errors.Is(err, domain.ErrRestrictedSuspension):
+		h.respondError(w, http.StatusConflict, "SUSPENSION_RESTRICTED", err.Error(), nil)
+	case errors.Is(err, domain.ErrSuspensionDowngrade):
+		h.respondError(w, http.StatusConflict, "SUSPENSION_DOWNGRADE", err.Error(), nil)
+	case errors.Is(err, domain.ErrParentTenantNotFound):
+		h.respondError(w, http.StatusBadRequest, "PARENT_TENANT_NOT_FOUND", "Parent tenant not found", nil)
+	case errors.Is(err, domain.ErrParentTenantDeleted):
+		h.respondError(w, http.StatusConflict, "PARENT_TENANT_DELETED", "Parent tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrHierarchyTooDeep):
+		h.respondError(w, http.StatusConflict, "HIERARCHY_TOO_DEEP", err.Error(), nil)
+	case errors.Is(err, domain.ErrQuotaInheritanceWithoutParent):
+		h.respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, domain.ErrInvalidTrialDuration),
+		errors.Is(err, domain.ErrInvalidTrialExpiryPolicy),
+		errors.Is(err, domain.ErrInvalidTrialFallback):
+		h.respondError(w, http.StatusBadRequest, "INVALID_TRIAL", err.Error(), nil)
+	case errors.Is(err, domain.ErrTrialAlreadyUsed):
+		h.respondError(w, http.StatusConflict, "TRIAL_ALREADY_USED", "Tenant has already had a trial", nil)
+	case errors.Is(err, context.Canceled):
 		h.respondError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
-	case context.DeadlineExceeded:
+	case errors.Is(err, context.DeadlineExceeded):
 		h.respondError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
 	default:
 		h.respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
@@ -364,64 +810,15 @@ func (h *TenantHandler) handleUseCaseError(w http.ResponseWriter, err error) {
 
 // parseValidationErrors converts validator errors to field errors
 func (h *TenantHandler) parseValidationErrors(errs validator.ValidationErrors) []dto.FieldError {
-	fieldErrors := make([]dto.FieldError, 0, len(errs))
-
-	for _, err := range errs {
-		fieldErrors = append(fieldErrors, dto.FieldError{
-			Field:   err.Field(),
-			Message: h.getValidationErrorMessage(err),
-		})
-	}
-
-	return fieldErrors
-}
-
-// getValidationErrorMessage generates user-friendly validation messages
-func (h *TenantHandler) getValidationErrorMessage(err validator.FieldError) string {
-	switch err.Tag() {
-	case "required":
-		return "This field is required"
-	case "email":
-		return "Must be a valid email address"
-	case "min":
-		return "Must be at least " + err.Param() + " characters"
-	case "max":
-		return "Must be at most " + err.Param() + " characters"
-	case "lowercase":
-		return "Must be lowercase"
-	case "alphanum_hyphen":
-		return "Must contain only alphanumeric characters and hyphens"
-	case "oneof":
-		return "Must be one of: " + err.Param()
-	default:
-		return "Invalid value"
-	}
+	return validationFieldErrors(errs)
 }
 
 // respondSuccess sends a success response
 func (h *TenantHandler) respondSuccess(w http.ResponseWriter, status int, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-
-	response := dto.SuccessResponse{
-		Data: data,
-	}
-
-	json.NewEncoder(w).Encode(response)
+	writeSuccess(w, status, data)
 }
 
 // respondError sends an error response
 func (h *TenantHandler) respondError(w http.ResponseWriter, status int, code, message string, details []dto.FieldError) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-
-	response := dto.ErrorResponse{
-		Error: dto.ErrorDetail{
-			Code:    code,
-			Message: message,
-			Details: details,
-		},
-	}
-
-	json.NewEncoder(w).Encode(response)
+	writeError(w, status, code, message, details)
 }
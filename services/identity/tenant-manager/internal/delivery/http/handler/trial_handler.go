@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/usecase"
+)
+
+// TrialHandler handles tenant trial HTTP requests
+type TrialHandler struct {
+	convertUC *usecase.ConvertTrialUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+// NewTrialHandler creates a new trial handler
+func NewTrialHandler(convertUC *usecase.ConvertTrialUseCase, logger *zap.Logger) *TrialHandler {
+	return &TrialHandler{
+		convertUC: convertUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// ConvertTrial ends a tenant's trial early, optionally on another plan
+// POST /api/v1/tenants/{id}/trial/convert
+func (h *TrialHandler) ConvertTrial(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ID", "Invalid tenant ID format", nil)
+		return
+	}
+
+	// The body is optional
+	var req dto.ConvertTrialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", "Invalid JSON payload", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationFieldErrors(err))
+		return
+	}
+
+	tenant, err := h.convertUC.Execute(r.Context(), usecase.ConvertTrialCommand{
+		TenantID: tenantID,
+		Plan:     domain.PlanTier(req.Plan),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, dto.FromDomain(tenant))
+}
+
+// handleUseCaseError maps domain errors to HTTP responses
+func (h *TrialHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	h.logger.Error("Use case error", zap.Error(err))
+
+	switch {
+	case errors.Is(err, domain.ErrTenantNotFound):
+		writeError(w, http.StatusNotFound, "TENANT_NOT_FOUND", "Tenant not found", nil)
+	case errors.Is(err, domain.ErrTenantDeleted):
+		writeError(w, http.StatusGone, "TENANT_DELETED", "Tenant has been deleted", nil)
+	case errors.Is(err, domain.ErrNoActiveTrial):
+		writeError(w, http.StatusConflict, "NO_ACTIVE_TRIAL", "Tenant has no active trial", nil)
+	case errors.Is(err, domain.ErrInvalidPlanTier):
+		writeError(w, http.StatusBadRequest, "INVALID_PLAN", "Invalid plan tier", nil)
+	case errors.Is(err, domain.ErrPlanNotFound):
+		writeError(w, http.StatusBadRequest, "INVALID_PLAN", "Unknown plan", nil)
+	case errors.Is(err, domain.ErrPlanRetired):
+		writeError(w, http.StatusConflict, "PLAN_RETIRED", "Plan is retired and can no longer be assigned", nil)
+	case errors.Is(err, domain.ErrPlanLimitExceeded):
+		writeError(w, http.StatusConflict, "PLAN_LIMIT_EXCEEDED", planLimitMessage(err), nil)
+	case errors.Is(err, context.Canceled):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_CANCELED", "Request was canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusRequestTimeout, "REQUEST_TIMEOUT", "Request timeout", nil)
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error", nil)
+	}
+}
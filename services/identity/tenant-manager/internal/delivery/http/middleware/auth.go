@@ -4,15 +4,23 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cotai/tenant-manager/internal/delivery/http/dto"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 )
 
-// AuthMiddleware handles JWT authentication
+// AuthMiddleware handles JWT and API key authentication and permission checks
 type AuthMiddleware struct {
-	jwtValidator JWTValidator
-	logger       *zap.Logger
+	jwtValidator    JWTValidator
+	apiKeyValidator APIKeyValidator
+	authorizer      *rbac.Authorizer
+	logger          *zap.Logger
 }
 
 // JWTValidator interface for validating JWT tokens
@@ -20,36 +28,153 @@ type JWTValidator interface {
 	ValidateToken(tokenString string) (*TokenClaims, error)
 }
 
+// APIKeyValidator interface for validating service account API keys
+type APIKeyValidator interface {
+	ValidateAPIKey(ctx context.Context, key string) (*TokenClaims, error)
+}
+
 // TokenClaims represents JWT token claims
 type TokenClaims struct {
-	Subject  string
-	Email    string
-	Roles    []string
-	TenantID string
+	Subject   string
+	Email     string
+	Username  string
+	ClientID  string
+	Roles     []string
+	TenantID  string
+	ExpiresAt time.Time
+
+	// EmailVerified is set when the identity provider verified Email
+	EmailVerified bool
+
+	// Permissions are granted directly (API keys) rather than through roles
+	Permissions []string
+
+	// ServiceAccount is set by the validator when the credential was issued
+	// to a service rather than to a human user
+	ServiceAccount bool
+}
+
+// Well-known Keycloak realm roles
+const (
+	// RolePlatformAdmin is the CotAI platform administrator role
+	RolePlatformAdmin = rbac.RolePlatformAdmin
+
+	// serviceAccountPrefix prefixes preferred_username on Keycloak service account tokens
+	serviceAccountPrefix = "service-account-"
+)
+
+// HasRole reports whether the claims carry the given role
+func (c *TokenClaims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyRole reports whether the claims carry at least one of the given roles
+func (c *TokenClaims) HasAnyRole(roles ...string) bool {
+	for _, role := range roles {
+		if c.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceAccountUsername returns the username used for a service account
+func ServiceAccountUsername(name string) string {
+	return serviceAccountPrefix + name
+}
+
+// IsServiceAccount reports whether the token was issued to a service
+// (client credentials grant or API key) rather than to a human user
+func (c *TokenClaims) IsServiceAccount() bool {
+	return c.ServiceAccount
+}
+
+// Principal returns the claims as an RBAC principal. Direct permissions
+// are scoped to the claims' tenant when one is set.
+func (c *TokenClaims) Principal() rbac.Principal {
+	scope := rbac.ScopeGlobal
+	if c.TenantID != "" {
+		scope = rbac.ScopeTenant
+	}
+
+	grants := make([]rbac.Grant, 0, len(c.Permissions))
+	for _, perm := range c.Permissions {
+		grants = append(grants, rbac.Grant{Permission: rbac.Permission(perm), Scope: scope})
+	}
+
+	return rbac.Principal{
+		Roles:    c.Roles,
+		TenantID: c.TenantID,
+		Grants:   grants,
+	}
 }
 
-// NewAuthMiddleware creates a new auth middleware
-func NewAuthMiddleware(jwtValidator JWTValidator, logger *zap.Logger) *AuthMiddleware {
+// Actor returns the audit actor for the claims
+func (c *TokenClaims) Actor() actor.Actor {
+	a := actor.Actor{Type: domain.ActorUser, ID: c.Subject, Name: c.Username}
+	if a.Name == "" {
+		a.Name = c.Email
+	}
+	if c.IsServiceAccount() {
+		a.Type = domain.ActorServiceAccount
+	}
+	return a
+}
+
+// ContextWithClaims stores the token claims in the context
+func ContextWithClaims(ctx context.Context, claims *TokenClaims) context.Context {
+	ctx = context.WithValue(ctx, "claims", claims)
+	return context.WithValue(ctx, "user_id", claims.Subject)
+}
+
+// ClaimsFromContext returns the token claims stored in the context, if any
+func ClaimsFromContext(ctx context.Context) (*TokenClaims, bool) {
+	claims, ok := ctx.Value("claims").(*TokenClaims)
+	return claims, ok && claims != nil
+}
+
+// NewAuthMiddleware creates a new auth middleware. apiKeyValidator may be nil
+// to accept bearer tokens only.
+func NewAuthMiddleware(jwtValidator JWTValidator, apiKeyValidator APIKeyValidator, authorizer *rbac.Authorizer, logger *zap.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtValidator: jwtValidator,
-		logger:       logger,
+		jwtValidator:    jwtValidator,
+		apiKeyValidator: apiKeyValidator,
+		authorizer:      authorizer,
+		logger:          logger,
 	}
 }
 
-// Handler returns the authentication middleware handler
+// Handler returns the authentication middleware handler.
+// It accepts a Bearer JWT, or an API key in X-API-Key or "Authorization: ApiKey <key>".
 func (m *AuthMiddleware) Handler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Extract token from Authorization header
 		authHeader := r.Header.Get("Authorization")
+		apiKey := r.Header.Get("X-API-Key")
+
+		// Extract API key from Authorization header
+		parts := strings.SplitN(authHeader, " ", 2)
+		if apiKey == "" && len(parts) == 2 && parts[0] == "ApiKey" {
+			apiKey = parts[1]
+		}
+
+		if apiKey != "" {
+			m.authenticateAPIKey(w, r, next, apiKey)
+			return
+		}
+
 		if authHeader == "" {
 			m.respondError(w, http.StatusUnauthorized, "MISSING_AUTH_HEADER", "Authorization header is required")
 			return
 		}
 
 		// Check Bearer prefix
-		parts := strings.SplitN(authHeader, " ", 2)
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			m.respondError(w, http.StatusUnauthorized, "INVALID_AUTH_HEADER", "Authorization header must be Bearer token")
+			m.respondError(w, http.StatusUnauthorized, "INVALID_AUTH_HEADER", "Authorization header must be Bearer token or ApiKey")
 			return
 		}
 
@@ -66,9 +191,8 @@ func (m *AuthMiddleware) Handler(next http.Handler) http.Handler {
 			return
 		}
 
-		// Store claims in context
-		ctx := context.WithValue(r.Context(), "claims", claims)
-		ctx = context.WithValue(ctx, "user_id", claims.Subject)
+		// Store claims and audit actor in context
+		ctx := authenticatedContext(r, claims)
 
 		m.logger.Debug("Request authenticated",
 			zap.String("user_id", claims.Subject),
@@ -79,29 +203,107 @@ func (m *AuthMiddleware) Handler(next http.Handler) http.Handler {
 	})
 }
 
+// authenticateAPIKey validates an API key and continues the chain with its claims
+func (m *AuthMiddleware) authenticateAPIKey(w http.ResponseWriter, r *http.Request, next http.Handler, apiKey string) {
+	if m.apiKeyValidator == nil {
+		m.respondError(w, http.StatusUnauthorized, "INVALID_AUTH_HEADER", "API keys are not accepted")
+		return
+	}
+
+	claims, err := m.apiKeyValidator.ValidateAPIKey(r.Context(), apiKey)
+	if err != nil {
+		m.logger.Warn("API key validation failed",
+			zap.Error(err),
+			zap.String("path", r.URL.Path),
+		)
+		m.respondError(w, http.StatusUnauthorized, "INVALID_API_KEY", "Invalid, expired or revoked API key")
+		return
+	}
+
+	ctx := authenticatedContext(r, claims)
+
+	m.logger.Debug("Request authenticated with API key",
+		zap.String("service_account_id", claims.Subject),
+		zap.String("key_prefix", claims.ClientID),
+		zap.String("path", r.URL.Path),
+	)
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// authenticatedContext stores the claims and the audit actor of the request
+func authenticatedContext(r *http.Request, claims *TokenClaims) context.Context {
+	a := claims.Actor()
+	a.RequestID = chimiddleware.GetReqID(r.Context())
+	a.SourceIP = actor.HostOnly(r.RemoteAddr)
+
+	return actor.WithActor(ContextWithClaims(r.Context(), claims), a)
+}
+
 // RequireRole returns a middleware that checks for specific role
 func (m *AuthMiddleware) RequireRole(requiredRole string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			claims, ok := r.Context().Value("claims").(*TokenClaims)
+			claims, ok := ClaimsFromContext(r.Context())
 			if !ok {
 				m.respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
 				return
 			}
 
 			// Check if user has required role
-			hasRole := false
-			for _, role := range claims.Roles {
-				if role == requiredRole {
-					hasRole = true
-					break
-				}
+			if !claims.HasRole(requiredRole) {
+				m.logger.Warn("Insufficient permissions",
+					zap.String("user_id", claims.Subject),
+					zap.String("required_role", requiredRole),
+					zap.Strings("user_roles", claims.Roles),
+				)
+				m.respondError(w, http.StatusForbidden, "FORBIDDEN", "Insufficient permissions")
+				return
 			}
 
-			if !hasRole {
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequirePermission returns a middleware that checks for a permission
+// that is not tied to a tenant. Only global grants satisfy it.
+func (m *AuthMiddleware) RequirePermission(perm rbac.Permission) func(http.Handler) http.Handler {
+	return m.requirePermission(perm, func(*http.Request) string { return "" })
+}
+
+// RequireTenantPermission returns a middleware that checks for a permission
+// on the tenant in the {id} route parameter. Tenant-scoped grants apply when
+// the caller's tenant matches it.
+func (m *AuthMiddleware) RequireTenantPermission(perm rbac.Permission) func(http.Handler) http.Handler {
+	return m.requirePermission(perm, func(r *http.Request) string { return chi.URLParam(r, "id") })
+}
+
+// Can reports whether the authenticated caller holds the permission on the
+// target tenant, for checks that depend on the resource rather than the route
+func (m *AuthMiddleware) Can(ctx context.Context, perm rbac.Permission, targetTenantID string) bool {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return false
+	}
+	return m.authorizer.CanContext(ctx, claims.Principal(), perm, targetTenantID)
+}
+
+func (m *AuthMiddleware) requirePermission(perm rbac.Permission, target func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				m.respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+				return
+			}
+
+			targetTenantID := target(r)
+			if err := m.authorizer.AuthorizeContext(r.Context(), claims.Principal(), perm, targetTenantID); err != nil {
 				m.logger.Warn("Insufficient permissions",
 					zap.String("user_id", claims.Subject),
-					zap.String("required_role", requiredRole),
+					zap.String("permission", string(perm)),
+					zap.String("target_tenant_id", targetTenantID),
 					zap.Strings("user_roles", claims.Roles),
 				)
 				m.respondError(w, http.StatusForbidden, "FORBIDDEN", "Insufficient permissions")
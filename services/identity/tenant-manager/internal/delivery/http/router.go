@@ -10,11 +10,25 @@ import (
 
 	"github.com/cotai/tenant-manager/internal/delivery/http/handler"
 	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
 )
 
 // RouterConfig holds router dependencies
 type RouterConfig struct {
 	TenantHandler *handler.TenantHandler
+	ServiceAccountHandler *handler.ServiceAccountHandler
+	AuditHandler *handler.AuditHandler
+	PlanHandler *handler.PlanHandler
+	EntitlementHandler *handler.EntitlementHandler
+	StorageHandler *handler.StorageHandler
+	FeatureHandler *handler.FeatureHandler
+	SlugHandler *handler.SlugHandler
+	DomainHandler *handler.DomainHandler
+	HierarchyHandler *handler.HierarchyHandler
+	TrialHandler *handler.TrialHandler
+	OperationHandler *handler.OperationHandler
+	MemberHandler *handler.MemberHandler
+	InvitationHandler *handler.InvitationHandler
 	HealthHandler *handler.HealthHandler
 	AuthMiddleware *middleware.AuthMiddleware
 	LoggingMiddleware *middleware.LoggingMiddleware
@@ -55,21 +69,115 @@ func NewRouter(cfg RouterConfig) http.Handler {
 		r.Use(cfg.AuthMiddleware.Handler)
 
 		// Tenant Management Routes
-		// All require cotai_admin role
+		// Each route requires a permission; tenant admins are scoped to their own {id}
 		r.Route("/tenants", func(r chi.Router) {
-			// Require admin role for all tenant operations
-			r.Use(cfg.AuthMiddleware.RequireRole("cotai_admin"))
+			auth := cfg.AuthMiddleware
 
-			r.Post("/", cfg.TenantHandler.CreateTenant)           // POST /api/v1/tenants
-			r.Get("/", cfg.TenantHandler.ListTenants)             // GET /api/v1/tenants
-			r.Get("/{id}", cfg.TenantHandler.GetTenant)           // GET /api/v1/tenants/{id}
-			r.Patch("/{id}", cfg.TenantHandler.UpdateTenant)      // PATCH /api/v1/tenants/{id}
-			r.Delete("/{id}", cfg.TenantHandler.DeleteTenant)     // DELETE /api/v1/tenants/{id}
+			r.With(auth.RequirePermission(rbac.TenantCreate)).Post("/", cfg.TenantHandler.CreateTenant)             // POST /api/v1/tenants
+			r.With(auth.RequirePermission(rbac.TenantList)).Get("/", cfg.TenantHandler.ListTenants)                 // GET /api/v1/tenants
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}", cfg.TenantHandler.GetTenant)         // GET /api/v1/tenants/{id}
+			r.With(auth.RequireTenantPermission(rbac.TenantUpdate)).Patch("/{id}", cfg.TenantHandler.UpdateTenant)  // PATCH /api/v1/tenants/{id}
+			r.With(auth.RequireTenantPermission(rbac.TenantDelete)).Delete("/{id}", cfg.TenantHandler.DeleteTenant) // DELETE /api/v1/tenants/{id}
+
+			// Slugs: former slugs keep resolving until released
+			r.With(auth.RequirePermission(rbac.TenantRead)).Get("/by-slug/{slug}", cfg.SlugHandler.GetTenantBySlug)                       // GET /api/v1/tenants/by-slug/{slug}
+			r.With(auth.RequireTenantPermission(rbac.TenantUpdate)).Post("/{id}/slug", cfg.SlugHandler.RenameSlug)                        // POST /api/v1/tenants/{id}/slug
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/slug-aliases", cfg.SlugHandler.ListSlugAliases)              // GET /api/v1/tenants/{id}/slug-aliases
+			r.With(auth.RequireTenantPermission(rbac.TenantUpdate)).Delete("/{id}/slug-aliases/{slug}", cfg.SlugHandler.ReleaseSlugAlias) // DELETE /api/v1/tenants/{id}/slug-aliases/{slug}
+
+			// Custom domains: verified domains resolve to their tenant over gRPC
+			r.With(auth.RequireTenantPermission(rbac.TenantManageDomains)).Post("/{id}/domains", cfg.DomainHandler.AddDomain)                      // POST /api/v1/tenants/{id}/domains
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/domains", cfg.DomainHandler.ListDomains)                              // GET /api/v1/tenants/{id}/domains
+			r.With(auth.RequireTenantPermission(rbac.TenantManageDomains)).Post("/{id}/domains/{domainId}/verify", cfg.DomainHandler.VerifyDomain) // POST /api/v1/tenants/{id}/domains/{domainId}/verify
+			r.With(auth.RequireTenantPermission(rbac.TenantManageDomains)).Delete("/{id}/domains/{domainId}", cfg.DomainHandler.RevokeDomain)      // DELETE /api/v1/tenants/{id}/domains/{domainId}
+
+			// Members: adds are capped by the max users quota, and a tenant keeps at least one admin
+			r.With(auth.RequireTenantPermission(rbac.TenantManageMembers)).Post("/{id}/members", cfg.MemberHandler.AddMember)                     // POST /api/v1/tenants/{id}/members
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/members", cfg.MemberHandler.ListMembers)                             // GET /api/v1/tenants/{id}/members
+			r.With(auth.RequireTenantPermission(rbac.TenantManageMembers)).Put("/{id}/members/{userId}/role", cfg.MemberHandler.ChangeMemberRole) // PUT /api/v1/tenants/{id}/members/{userId}/role
+			r.With(auth.RequireTenantPermission(rbac.TenantManageMembers)).Delete("/{id}/members/{userId}", cfg.MemberHandler.RemoveMember)       // DELETE /api/v1/tenants/{id}/members/{userId}
+
+			// Invitations: single-use signed links, checked against the max users quota when accepted
+			r.With(auth.RequireTenantPermission(rbac.TenantManageMembers)).Post("/{id}/invitations", cfg.InvitationHandler.InviteMember)                           // POST /api/v1/tenants/{id}/invitations
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/invitations", cfg.InvitationHandler.ListInvitations)                                  // GET /api/v1/tenants/{id}/invitations
+			r.With(auth.RequireTenantPermission(rbac.TenantManageMembers)).Post("/{id}/invitations/{invitationId}/resend", cfg.InvitationHandler.ResendInvitation) // POST /api/v1/tenants/{id}/invitations/{invitationId}/resend
+			r.With(auth.RequireTenantPermission(rbac.TenantManageMembers)).Delete("/{id}/invitations/{invitationId}", cfg.InvitationHandler.RevokeInvitation)      // DELETE /api/v1/tenants/{id}/invitations/{invitationId}
+
+			// Hierarchy: moving a tenant takes a global grant, as it spans two subtrees
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/hierarchy", cfg.HierarchyHandler.GetTenantHierarchy) // GET /api/v1/tenants/{id}/hierarchy
+			r.With(auth.RequirePermission(rbac.TenantUpdate)).Put("/{id}/parent", cfg.HierarchyHandler.SetTenantParent)           // PUT /api/v1/tenants/{id}/parent
+
+			// Scheduled lifecycle operations: applied by the operations worker, which marks illegal ones failed
+			r.With(auth.RequireTenantPermission(rbac.TenantSuspend)).Post("/{id}/scheduled-operations", cfg.OperationHandler.ScheduleOperation)               // POST /api/v1/tenants/{id}/scheduled-operations
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/scheduled-operations", cfg.OperationHandler.ListOperations)                      // GET /api/v1/tenants/{id}/scheduled-operations
+			r.With(auth.RequireTenantPermission(rbac.TenantSuspend)).Delete("/{id}/scheduled-operations/{operationId}", cfg.OperationHandler.CancelOperation) // DELETE /api/v1/tenants/{id}/scheduled-operations/{operationId}
 
 			// Tenant lifecycle operations
-			r.Post("/{id}/suspend", cfg.TenantHandler.SuspendTenant)   // POST /api/v1/tenants/{id}/suspend
-			r.Post("/{id}/activate", cfg.TenantHandler.ActivateTenant) // POST /api/v1/tenants/{id}/activate
+			r.With(auth.RequireTenantPermission(rbac.TenantSuspend)).Post("/{id}/suspend", cfg.TenantHandler.SuspendTenant)     // POST /api/v1/tenants/{id}/suspend
+			r.With(auth.RequireTenantPermission(rbac.TenantSuspend)).Post("/{id}/activate", cfg.TenantHandler.ActivateTenant)   // POST /api/v1/tenants/{id}/activate
+			r.With(auth.RequireTenantPermission(rbac.TenantArchive)).Post("/{id}/archive", cfg.TenantHandler.ArchiveTenant)     // POST /api/v1/tenants/{id}/archive
+			r.With(auth.RequireTenantPermission(rbac.TenantArchive)).Post("/{id}/unarchive", cfg.TenantHandler.UnarchiveTenant) // POST /api/v1/tenants/{id}/unarchive
+			r.With(auth.RequireTenantPermission(rbac.TenantDelete)).Post("/{id}/restore", cfg.TenantHandler.RestoreTenant)      // POST /api/v1/tenants/{id}/restore
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/history", cfg.TenantHandler.GetTenantHistory)      // GET /api/v1/tenants/{id}/history
+
+			// Subscription plan
+			r.With(auth.RequireTenantPermission(rbac.TenantChangePlan)).Post("/{id}/plan", cfg.TenantHandler.ChangePlan)           // POST /api/v1/tenants/{id}/plan
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/plan-history", cfg.TenantHandler.GetPlanHistory)      // GET /api/v1/tenants/{id}/plan-history
+			r.With(auth.RequireTenantPermission(rbac.TenantChangePlan)).Post("/{id}/trial/convert", cfg.TrialHandler.ConvertTrial) // POST /api/v1/tenants/{id}/trial/convert
+
+			// Quota overrides
+			r.With(auth.RequireTenantPermission(rbac.TenantManageQuotas)).Put("/{id}/quotas/{name}", cfg.TenantHandler.SetQuotaOverride)       // PUT /api/v1/tenants/{id}/quotas/{name}
+			r.With(auth.RequireTenantPermission(rbac.TenantManageQuotas)).Delete("/{id}/quotas/{name}", cfg.TenantHandler.RemoveQuotaOverride) // DELETE /api/v1/tenants/{id}/quotas/{name}
+
+			// Entitlements
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/entitlements", cfg.EntitlementHandler.ListEntitlements)                            // GET /api/v1/tenants/{id}/entitlements
+			r.With(auth.RequireTenantPermission(rbac.TenantManageQuotas)).Put("/{id}/entitlements/{name}", cfg.EntitlementHandler.SetEntitlementLimit)       // PUT /api/v1/tenants/{id}/entitlements/{name}
+			r.With(auth.RequireTenantPermission(rbac.TenantManageQuotas)).Delete("/{id}/entitlements/{name}", cfg.EntitlementHandler.RemoveEntitlementLimit) // DELETE /api/v1/tenants/{id}/entitlements/{name}
+
+			// Feature flags
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/features", cfg.FeatureHandler.GetTenantFeatures)                              // GET /api/v1/tenants/{id}/features
+			r.With(auth.RequireTenantPermission(rbac.TenantManageFeatures)).Put("/{id}/features/{flag}", cfg.FeatureHandler.SetFeatureOverride)       // PUT /api/v1/tenants/{id}/features/{flag}
+			r.With(auth.RequireTenantPermission(rbac.TenantManageFeatures)).Delete("/{id}/features/{flag}", cfg.FeatureHandler.RemoveFeatureOverride) // DELETE /api/v1/tenants/{id}/features/{flag}
+
+			// Resource usage
+			r.With(auth.RequireTenantPermission(rbac.TenantRead)).Get("/{id}/usage/storage", cfg.StorageHandler.GetStorageUsage) // GET /api/v1/tenants/{id}/usage/storage
 		})
+
+		// Invitation acceptance: any authenticated user, matched to the invitee by email
+		r.Post("/invitations/accept", cfg.InvitationHandler.AcceptInvitation) // POST /api/v1/invitations/accept
+
+		// Service Account Routes (platform-wide)
+		r.Route("/service-accounts", func(r chi.Router) {
+			r.Use(cfg.AuthMiddleware.RequirePermission(rbac.ServiceAccountManage))
+
+			r.Post("/", cfg.ServiceAccountHandler.CreateServiceAccount)            // POST /api/v1/service-accounts
+			r.Get("/", cfg.ServiceAccountHandler.ListServiceAccounts)              // GET /api/v1/service-accounts
+			r.Get("/{id}", cfg.ServiceAccountHandler.GetServiceAccount)            // GET /api/v1/service-accounts/{id}
+			r.Delete("/{id}", cfg.ServiceAccountHandler.DeleteServiceAccount)      // DELETE /api/v1/service-accounts/{id}
+			r.Post("/{id}/keys", cfg.ServiceAccountHandler.IssueAPIKey)            // POST /api/v1/service-accounts/{id}/keys
+			r.Delete("/{id}/keys/{keyId}", cfg.ServiceAccountHandler.RevokeAPIKey) // DELETE /api/v1/service-accounts/{id}/keys/{keyId}
+		})
+
+		// Plan Catalog Routes (platform-wide)
+		r.Route("/plans", func(r chi.Router) {
+			r.Use(cfg.AuthMiddleware.RequirePermission(rbac.PlanManage))
+
+			r.Get("/", cfg.PlanHandler.ListPlans)                 // GET /api/v1/plans
+			r.Post("/", cfg.PlanHandler.CreatePlan)               // POST /api/v1/plans
+			r.Get("/{tier}", cfg.PlanHandler.GetPlan)             // GET /api/v1/plans/{tier}
+			r.Post("/{tier}/retire", cfg.PlanHandler.RetirePlan) // POST /api/v1/plans/{tier}/retire
+		})
+
+		// Feature Flag Registry Routes (platform-wide)
+		r.Route("/features", func(r chi.Router) {
+			r.Use(cfg.AuthMiddleware.RequirePermission(rbac.FeatureManage))
+
+			r.Get("/", cfg.FeatureHandler.ListFeatureFlags)        // GET /api/v1/features
+			r.Put("/{flag}", cfg.FeatureHandler.SaveFeatureFlag) // PUT /api/v1/features/{flag}
+		})
+
+		// Audit Log Routes (platform-wide)
+		r.With(cfg.AuthMiddleware.RequirePermission(rbac.AuditRead)).Get("/audit-events", cfg.AuditHandler.ListAuditEvents) // GET /api/v1/audit-events
 	})
 
 	// ==========================
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenantArchive records an export of a tenant schema kept in the blob store
+type TenantArchive struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+
+	// Location is the blob store key of the compressed archive
+	Location string
+	// Checksum is the SHA-256 hex digest of the archive
+	Checksum  string
+	SizeBytes int64
+
+	SchemaName string
+	CreatedAt  time.Time
+	CreatedBy  *uuid.UUID
+	RestoredAt *time.Time
+}
+
+// NewTenantArchive creates an archive record for a tenant schema export
+func NewTenantArchive(tenant *Tenant, location, checksum string, sizeBytes int64) *TenantArchive {
+	return &TenantArchive{
+		ID:         uuid.New(),
+		TenantID:   tenant.TenantID,
+		Location:   location,
+		Checksum:   checksum,
+		SizeBytes:  sizeBytes,
+		SchemaName: tenant.DatabaseSchema,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// MarkRestored records that the archive was restored into a live schema
+func (a *TenantArchive) MarkRestored() {
+	now := time.Now()
+	a.RestoredAt = &now
+}
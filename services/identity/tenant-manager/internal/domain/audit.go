@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction identifies a mutating operation recorded in the audit log
+type AuditAction string
+
+const (
+	AuditTenantCreated      AuditAction = "tenant.created"
+	AuditTenantProvisioned  AuditAction = "tenant.provisioned"
+	AuditTenantUpdated      AuditAction = "tenant.updated"
+	AuditTenantSuspended    AuditAction = "tenant.suspended"
+	AuditTenantActivated    AuditAction = "tenant.activated"
+	AuditTenantDeleted      AuditAction = "tenant.deleted"
+	AuditTenantArchived     AuditAction = "tenant.archived"
+	AuditTenantUnarchived   AuditAction = "tenant.unarchived"
+	AuditTenantRestored     AuditAction = "tenant.restored"
+	AuditTenantPlanChanged  AuditAction = "tenant.plan_changed"
+	AuditTenantQuotaChanged AuditAction = "tenant.quota_changed"
+	AuditTenantPurged       AuditAction = "tenant.purged"
+
+	AuditTenantEntitlementChanged AuditAction = "tenant.entitlement_changed"
+	AuditTenantFeaturesChanged    AuditAction = "tenant.features_changed"
+	AuditFeatureFlagChanged       AuditAction = "feature_flag.changed"
+	AuditTenantSlugChanged        AuditAction = "tenant.slug_changed"
+	AuditTenantSlugAliasReleased  AuditAction = "tenant.slug_alias_released"
+	AuditTenantDomainAdded        AuditAction = "tenant.domain_added"
+	AuditTenantDomainVerified     AuditAction = "tenant.domain_verified"
+	AuditTenantDomainFailed       AuditAction = "tenant.domain_failed"
+	AuditTenantDomainRevoked      AuditAction = "tenant.domain_revoked"
+	AuditTenantParentChanged      AuditAction = "tenant.parent_changed"
+	AuditTenantTrialConverted     AuditAction = "tenant.trial_converted"
+	AuditTenantTrialExpired       AuditAction = "tenant.trial_expired"
+	AuditTenantOperationScheduled AuditAction = "tenant.operation_scheduled"
+	AuditTenantOperationCanceled  AuditAction = "tenant.operation_canceled"
+	AuditTenantOperationFailed    AuditAction = "tenant.operation_failed"
+	AuditTenantMemberAdded        AuditAction = "tenant.member_added"
+	AuditTenantMemberRemoved      AuditAction = "tenant.member_removed"
+	AuditTenantMemberRoleChanged  AuditAction = "tenant.member_role_changed"
+	AuditTenantMemberInvited      AuditAction = "tenant.member_invited"
+	AuditTenantInvitationResent   AuditAction = "tenant.invitation_resent"
+	AuditTenantInvitationRevoked  AuditAction = "tenant.invitation_revoked"
+	AuditTenantInvitationAccepted AuditAction = "tenant.invitation_accepted"
+)
+
+// ActorType identifies the kind of principal that performed an operation
+type ActorType string
+
+const (
+	ActorUser           ActorType = "user"
+	ActorServiceAccount ActorType = "service_account"
+	ActorService        ActorType = "service"
+	ActorSystem         ActorType = "system"
+)
+
+// FieldChange holds the value of a field before and after an operation
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditEvent is an immutable record of a mutating operation
+type AuditEvent struct {
+	ID         uuid.UUID
+	OccurredAt time.Time
+	ActorType  ActorType
+	ActorID    string
+	ActorName  string
+	Action     AuditAction
+	TenantID   *uuid.UUID
+	RequestID  string
+	SourceIP   string
+	Changes    map[string]FieldChange
+}
+
+// NewAuditEvent creates an audit event with the diff between two snapshots
+func NewAuditEvent(action AuditAction, tenantID *uuid.UUID, before, after map[string]interface{}) *AuditEvent {
+	return &AuditEvent{
+		ID:         uuid.New(),
+		OccurredAt: time.Now(),
+		ActorType:  ActorSystem,
+		Action:     action,
+		TenantID:   tenantID,
+		Changes:    Diff(before, after),
+	}
+}
+
+// Snapshot returns the audited state of the tenant as a JSON-compatible map
+func (t *Tenant) Snapshot() map[string]interface{} {
+	return normalize(map[string]interface{}{
+		"tenant_name":           t.TenantName,
+		"tenant_slug":           t.TenantSlug,
+		"status":                t.Status,
+		"plan_tier":             t.PlanTier,
+		"max_users":             t.MaxUsers,
+		"max_storage_gb":        t.MaxStorageGB,
+		"parent_tenant_id":      t.ParentTenantID,
+		"inherit_quotas":        t.InheritQuotas,
+		"trial":                 t.trialSnapshot(),
+		"suspension":            t.suspensionSnapshot(),
+		"quota_overrides":       t.quotaOverridesSnapshot(),
+		"primary_contact_email": t.PrimaryContactEmail,
+		"primary_contact_name":  t.PrimaryContactName,
+		"billing_email":         t.BillingEmail,
+		"settings":              t.Settings,
+		"features":              t.Features,
+		"feature_overrides":     t.FeatureOverrides,
+		"activated_at":          t.ActivatedAt,
+		"suspended_at":          t.SuspendedAt,
+		"reinstated_at":         t.ReinstatedAt,
+		"deleted_at":            t.DeletedAt,
+	})
+}
+
+// quotaOverridesSnapshot returns the audited state of the quota overrides
+func (t *Tenant) quotaOverridesSnapshot() map[string]interface{} {
+	overrides := make(map[string]interface{}, len(t.QuotaOverrides))
+	for _, o := range t.QuotaOverrides {
+		overrides[string(o.Name)] = map[string]interface{}{
+			"value":      o.Value,
+			"reason":     o.Reason,
+			"expires_at": o.ExpiresAt,
+		}
+	}
+	return overrides
+}
+
+// Diff returns the fields whose values differ between two snapshots.
+// A nil before snapshot records every field of after as a change.
+func Diff(before, after map[string]interface{}) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+
+	for key, newValue := range after {
+		oldValue := before[key]
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes[key] = FieldChange{Before: oldValue, After: newValue}
+		}
+	}
+
+	for key, oldValue := range before {
+		if _, ok := after[key]; !ok {
+			changes[key] = FieldChange{Before: oldValue, After: nil}
+		}
+	}
+
+	return changes
+}
+
+// normalize round-trips a snapshot through JSON so that values compare
+// the way they are stored (typed strings, time pointers, nested maps)
+func normalize(snapshot map[string]interface{}) map[string]interface{} {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return snapshot
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return snapshot
+	}
+
+	return normalized
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff_TenantSnapshots(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+
+	before := tenant.Snapshot()
+	tenant.UpdateName("Renamed Company")
+	require.NoError(t, tenant.UpdateSettings(map[string]interface{}{"locale": map[string]interface{}{"language": "en-US"}}))
+	after := tenant.Snapshot()
+
+	changes := Diff(before, after)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, FieldChange{Before: "Test Company", After: "Renamed Company"}, changes["tenant_name"])
+	assert.Equal(t, map[string]interface{}{"version": float64(SettingsVersion)}, changes["settings"].Before)
+	assert.Equal(t, map[string]interface{}{
+		"version": float64(SettingsVersion),
+		"locale":  map[string]interface{}{"language": "en-US"},
+	}, changes["settings"].After)
+
+	// Unchanged snapshots produce no diff
+	assert.Empty(t, Diff(after, tenant.Snapshot()))
+}
+
+func TestDiff_Creation(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanFree], "admin@test.com")
+
+	changes := Diff(nil, tenant.Snapshot())
+	assert.Equal(t, FieldChange{Before: nil, After: "provisioning"}, changes["status"])
+	assert.Equal(t, FieldChange{Before: nil, After: "test-company"}, changes["tenant_slug"])
+	// Nil fields are unchanged from an empty snapshot
+	assert.NotContains(t, changes, "deleted_at")
+}
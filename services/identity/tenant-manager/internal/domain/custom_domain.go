@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DomainStatus represents the ownership verification state of a custom domain
+type DomainStatus string
+
+const (
+	// DomainPending is registered and waiting for its TXT record
+	DomainPending DomainStatus = "pending"
+	// DomainVerified resolves to its tenant
+	DomainVerified DomainStatus = "verified"
+	// DomainFailed was never verified in time, or lost its TXT record
+	DomainFailed DomainStatus = "failed"
+	// DomainRevoked was removed from its tenant
+	DomainRevoked DomainStatus = "revoked"
+)
+
+const (
+	// DomainVerificationLabel is prepended to a hostname to name its
+	// verification TXT record
+	DomainVerificationLabel = "_cotai-verification"
+
+	// DomainVerificationWindow is how long a pending domain may wait for its
+	// TXT record before it fails
+	DomainVerificationWindow = 7 * 24 * time.Hour
+
+	// DomainFailureThreshold is how many checks in a row a verified domain may
+	// miss its TXT record before it fails, so that a short DNS mishap does not
+	// take a tenant offline
+	DomainFailureThreshold = 3
+)
+
+// TXTResolver looks up DNS TXT records. A name without TXT records yields no
+// records and no error; errors are reserved for failed lookups.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// CustomDomain is a hostname a tenant reaches the platform at, once it has
+// proven ownership with a DNS TXT record
+type CustomDomain struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Hostname string
+	Status   DomainStatus
+
+	// VerificationToken is the value expected in the verification TXT record
+	VerificationToken string
+
+	// Outcome of the latest checks
+	LastCheckedAt *time.Time
+	// ConsecutiveFailures counts checks in a row that missed the TXT record
+	ConsecutiveFailures int
+	LastError           string
+
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	VerifiedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// NewCustomDomain registers a hostname for a tenant, pending verification
+func NewCustomDomain(tenantID uuid.UUID, hostname string) (*CustomDomain, error) {
+	hostname, err := NormalizeHostname(hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	now := time.Now()
+	return &CustomDomain{
+		ID:                uuid.New(),
+		TenantID:          tenantID,
+		Hostname:          hostname,
+		Status:            DomainPending,
+		VerificationToken: hex.EncodeToString(token),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
+
+// NormalizeHostname lowercases a hostname, drops a port and a trailing dot,
+// and checks that it is a fully qualified DNS name
+func NormalizeHostname(host string) (string, error) {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if i := strings.LastIndexByte(host, ':'); i >= 0 {
+		host = host[:i]
+	}
+	host = strings.TrimSuffix(host, ".")
+
+	if len(host) == 0 || len(host) > 253 {
+		return "", ErrInvalidHostname
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return "", ErrInvalidHostname
+	}
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return "", ErrInvalidHostname
+		}
+		for _, ch := range label {
+			if !((ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '-') {
+				return "", ErrInvalidHostname
+			}
+		}
+	}
+
+	return host, nil
+}
+
+// VerificationRecordName is the name of the TXT record that proves ownership
+func (d *CustomDomain) VerificationRecordName() string {
+	return DomainVerificationLabel + "." + d.Hostname
+}
+
+// VerificationRecordValue is the value the TXT record must hold
+func (d *CustomDomain) VerificationRecordValue() string {
+	return "cotai-verification=" + d.VerificationToken
+}
+
+// IsVerified checks if the domain resolves to its tenant
+func (d *CustomDomain) IsVerified() bool {
+	return d.Status == DomainVerified
+}
+
+// IsRevoked checks if the domain was removed from its tenant
+func (d *CustomDomain) IsRevoked() bool {
+	return d.Status == DomainRevoked
+}
+
+// Verify looks up the verification TXT record and records the outcome:
+// a found record verifies the domain, a missing one fails a pending domain
+// past the verification window or a verified domain past the failure
+// threshold. A failed lookup is returned without being recorded, so that a
+// DNS outage does not fail domains.
+func (d *CustomDomain) Verify(ctx context.Context, resolver TXTResolver, now time.Time) error {
+	if d.IsRevoked() {
+		return ErrDomainRevoked
+	}
+
+	records, err := resolver.LookupTXT(ctx, d.VerificationRecordName())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDomainLookupFailed, err)
+	}
+
+	d.LastCheckedAt = &now
+	d.UpdatedAt = now
+
+	expected := d.VerificationRecordValue()
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			if !d.IsVerified() {
+				d.VerifiedAt = &now
+			}
+			d.Status = DomainVerified
+			d.ConsecutiveFailures = 0
+			d.LastError = ""
+			return nil
+		}
+	}
+
+	d.ConsecutiveFailures++
+	d.LastError = fmt.Sprintf("TXT record %s does not contain %s", d.VerificationRecordName(), expected)
+
+	switch d.Status {
+	case DomainPending:
+		if now.Sub(d.CreatedAt) >= DomainVerificationWindow {
+			d.Status = DomainFailed
+		}
+	case DomainVerified:
+		if d.ConsecutiveFailures >= DomainFailureThreshold {
+			d.Status = DomainFailed
+		}
+	}
+
+	return nil
+}
+
+// Snapshot returns the audited state of the domain
+func (d *CustomDomain) Snapshot() map[string]interface{} {
+	if d == nil {
+		return nil
+	}
+	return normalize(map[string]interface{}{
+		"hostname":    d.Hostname,
+		"status":      d.Status,
+		"verified_at": d.VerifiedAt,
+		"revoked_at":  d.RevokedAt,
+		"last_error":  d.LastError,
+	})
+}
+
+// Revoke removes the domain from its tenant. The hostname can then be
+// registered again, by any tenant.
+func (d *CustomDomain) Revoke() error {
+	if d.IsRevoked() {
+		return ErrDomainRevoked
+	}
+
+	now := time.Now()
+	d.Status = DomainRevoked
+	d.RevokedAt = &now
+	d.UpdatedAt = now
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResolver serves TXT records from a map, or fails every lookup with err
+type fakeResolver struct {
+	records map[string][]string
+	err     error
+}
+
+func (r *fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.records[name], nil
+}
+
+func TestNormalizeHostname(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+		err  error
+	}{
+		{"Compras.Example.com", "compras.example.com", nil},
+		{"compras.example.com:8443", "compras.example.com", nil},
+		{"compras.example.com.", "compras.example.com", nil},
+		{"localhost", "", ErrInvalidHostname},
+		{"-bad.example.com", "", ErrInvalidHostname},
+		{"bad_label.example.com", "", ErrInvalidHostname},
+		{"", "", ErrInvalidHostname},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			got, err := NormalizeHostname(tt.host)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomDomain_Verify(t *testing.T) {
+	ctx := context.Background()
+	d, err := NewCustomDomain(uuid.New(), "Compras.Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "_cotai-verification.compras.example.com", d.VerificationRecordName())
+
+	resolver := &fakeResolver{records: map[string][]string{}}
+	now := d.CreatedAt
+
+	// A missing record keeps a domain pending within the verification window
+	require.NoError(t, d.Verify(ctx, resolver, now))
+	assert.Equal(t, DomainPending, d.Status)
+	assert.Equal(t, 1, d.ConsecutiveFailures)
+
+	// A failed lookup is not recorded
+	resolver.err = errors.New("i/o timeout")
+	assert.ErrorIs(t, d.Verify(ctx, resolver, now), ErrDomainLookupFailed)
+	assert.Equal(t, 1, d.ConsecutiveFailures)
+	resolver.err = nil
+
+	resolver.records[d.VerificationRecordName()] = []string{"v=spf1 -all", d.VerificationRecordValue()}
+	require.NoError(t, d.Verify(ctx, resolver, now))
+	assert.True(t, d.IsVerified())
+	assert.Zero(t, d.ConsecutiveFailures)
+	require.NotNil(t, d.VerifiedAt)
+
+	// A verified domain survives a few missed checks before it fails
+	delete(resolver.records, d.VerificationRecordName())
+	for i := 1; i < DomainFailureThreshold; i++ {
+		require.NoError(t, d.Verify(ctx, resolver, now))
+		assert.True(t, d.IsVerified())
+	}
+	require.NoError(t, d.Verify(ctx, resolver, now))
+	assert.Equal(t, DomainFailed, d.Status)
+
+	require.NoError(t, d.Revoke())
+	assert.ErrorIs(t, d.Verify(ctx, resolver, now), ErrDomainRevoked)
+	assert.ErrorIs(t, d.Revoke(), ErrDomainRevoked)
+}
+
+func TestCustomDomain_VerifyPendingExpires(t *testing.T) {
+	d, err := NewCustomDomain(uuid.New(), "compras.example.com")
+	require.NoError(t, err)
+
+	resolver := &fakeResolver{records: map[string][]string{}}
+	require.NoError(t, d.Verify(context.Background(), resolver, d.CreatedAt.Add(DomainVerificationWindow)))
+	assert.Equal(t, DomainFailed, d.Status)
+	assert.Nil(t, d.VerifiedAt)
+}
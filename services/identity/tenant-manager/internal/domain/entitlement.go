@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// entitlementNameRegex matches entitlement names such as "monthly_notices"
+var entitlementNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// EntitlementThresholds are the usage percentages that raise an event when crossed
+var EntitlementThresholds = []int{80, 100}
+
+// EntitlementWindow is the period over which an entitlement's usage is counted
+type EntitlementWindow string
+
+const (
+	// WindowNone counts usage that never resets, such as seats or storage
+	WindowNone EntitlementWindow = "none"
+	// WindowDay resets at midnight UTC
+	WindowDay EntitlementWindow = "day"
+	// WindowMonth resets on the first day of each month, UTC
+	WindowMonth EntitlementWindow = "month"
+	// WindowRolling counts usage over the trailing Period
+	WindowRolling EntitlementWindow = "rolling"
+)
+
+// IsValid checks if the window is known
+func (w EntitlementWindow) IsValid() bool {
+	switch w {
+	case WindowNone, WindowDay, WindowMonth, WindowRolling:
+		return true
+	}
+	return false
+}
+
+// EntitlementLimit is a named limit granted by a plan or to a single tenant
+type EntitlementLimit struct {
+	Name   string
+	Limit  int64
+	Window EntitlementWindow
+	// Period is the length of a rolling window
+	Period time.Duration
+}
+
+// NewEntitlementLimit creates a new entitlement limit. The quota names are
+// reserved: their limits are the tenant's effective quotas.
+func NewEntitlementLimit(name string, limit int64, window EntitlementWindow, period time.Duration) (*EntitlementLimit, error) {
+	if !entitlementNameRegex.MatchString(name) || QuotaName(name).IsValid() {
+		return nil, ErrInvalidEntitlementName
+	}
+	if limit <= 0 {
+		return nil, ErrInvalidEntitlementLimit
+	}
+	if !window.IsValid() {
+		return nil, ErrInvalidEntitlementWindow
+	}
+	if window == WindowRolling && period < time.Minute {
+		return nil, ErrInvalidEntitlementWindow
+	}
+	if window != WindowRolling {
+		period = 0
+	}
+
+	return &EntitlementLimit{
+		Name:   name,
+		Limit:  limit,
+		Window: window,
+		Period: period,
+	}, nil
+}
+
+// WindowStart returns the start of the window containing now. Usage
+// counted before it no longer applies.
+func (l *EntitlementLimit) WindowStart(now time.Time) time.Time {
+	now = now.UTC()
+	switch l.Window {
+	case WindowDay:
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	case WindowMonth:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case WindowRolling:
+		return now.Add(-l.Period).Truncate(l.bucketSize())
+	default:
+		return time.Unix(0, 0).UTC()
+	}
+}
+
+// ResetsAt returns when the window containing now ends, or nil for windows
+// that never reset or roll continuously
+func (l *EntitlementLimit) ResetsAt(now time.Time) *time.Time {
+	var end time.Time
+	switch l.Window {
+	case WindowDay:
+		end = l.WindowStart(now).AddDate(0, 0, 1)
+	case WindowMonth:
+		end = l.WindowStart(now).AddDate(0, 1, 0)
+	default:
+		return nil
+	}
+	return &end
+}
+
+// Bucket returns the start of the counter that usage at now is added to.
+// Rolling windows count in buckets of a sixtieth of their period, so usage
+// ages out of the window one bucket at a time.
+func (l *EntitlementLimit) Bucket(now time.Time) time.Time {
+	if l.Window == WindowRolling {
+		return now.UTC().Truncate(l.bucketSize())
+	}
+	return l.WindowStart(now)
+}
+
+// bucketSize returns the counter granularity of a rolling window
+func (l *EntitlementLimit) bucketSize() time.Duration {
+	size := (l.Period / 60).Truncate(time.Minute)
+	if size < time.Minute {
+		return time.Minute
+	}
+	return size
+}
+
+// UsageBucket is the usage of an entitlement counted in one bucket
+type UsageBucket struct {
+	Start time.Time
+	Used  int64
+}
+
+// ReleaseFromBuckets spreads a release of amount over the buckets of the
+// current window, newest first, taking no bucket below zero. It returns the
+// negative delta to add to each bucket drawn from. Releasing from the newest
+// buckets keeps a rolling window from going negative when the bucket the
+// usage was consumed in ages out before the release does.
+func ReleaseFromBuckets(buckets []UsageBucket, amount int64) []UsageBucket {
+	newest := make([]UsageBucket, len(buckets))
+	copy(newest, buckets)
+	sort.SliceStable(newest, func(i, j int) bool {
+		return newest[i].Start.After(newest[j].Start)
+	})
+
+	var deltas []UsageBucket
+	for _, b := range newest {
+		if amount == 0 {
+			break
+		}
+		if b.Used <= 0 {
+			continue
+		}
+		take := b.Used
+		if take > amount {
+			take = amount
+		}
+		deltas = append(deltas, UsageBucket{Start: b.Start, Used: -take})
+		amount -= take
+	}
+	return deltas
+}
+
+// Snapshot returns the audited state of a tenant's entitlement limit, keyed by
+// its name; a nil limit records that none is set
+func (l *EntitlementLimit) Snapshot(name string) map[string]interface{} {
+	if l == nil {
+		return map[string]interface{}{"entitlement." + name: nil}
+	}
+	return map[string]interface{}{
+		"entitlement." + name: map[string]interface{}{
+			"limit":          l.Limit,
+			"window":         string(l.Window),
+			"period_seconds": int64(l.Period / time.Second),
+		},
+	}
+}
+
+// QuotaEntitlement returns the limit enforced for a tenant quota: its
+// effective value, counted without a window
+func QuotaEntitlement(quota EffectiveQuota) *EntitlementLimit {
+	return &EntitlementLimit{
+		Name:   string(quota.Name),
+		Limit:  int64(quota.Effective),
+		Window: WindowNone,
+	}
+}
+
+// CountsMembers reports whether the limit is the max_users quota, whose usage
+// is the tenant's active members rather than a counter
+func (l *EntitlementLimit) CountsMembers() bool {
+	return l.Name == string(QuotaMaxUsers)
+}
+
+// MeasuresStorage reports whether the limit is the max_storage_gb quota, whose
+// usage is the tenant's schema size at its latest storage snapshot rather
+// than a counter
+func (l *EntitlementLimit) MeasuresStorage() bool {
+	return l.Name == string(QuotaMaxStorageGB)
+}
+
+// Measured reports whether the usage of the limit is measured from the tenant
+// itself, and so is never consumed or released
+func (l *EntitlementLimit) Measured() bool {
+	return l.CountsMembers() || l.MeasuresStorage()
+}
+
+// EntitlementUsage is a tenant's consumption of an entitlement in the current window
+type EntitlementUsage struct {
+	TenantID uuid.UUID
+	*EntitlementLimit
+	Used        int64
+	WindowStart time.Time
+	ResetsAt    *time.Time
+}
+
+// NewEntitlementUsage creates the usage of an entitlement in the window containing now
+func NewEntitlementUsage(tenantID uuid.UUID, limit *EntitlementLimit, used int64, now time.Time) *EntitlementUsage {
+	return &EntitlementUsage{
+		TenantID:         tenantID,
+		EntitlementLimit: limit,
+		Used:             used,
+		WindowStart:      limit.WindowStart(now),
+		ResetsAt:         limit.ResetsAt(now),
+	}
+}
+
+// Remaining returns the capacity left in the current window
+func (u *EntitlementUsage) Remaining() int64 {
+	if u.Used >= u.Limit {
+		return 0
+	}
+	return u.Limit - u.Used
+}
+
+// Allows reports whether amount more can be consumed within the limit
+func (u *EntitlementUsage) Allows(amount int64) bool {
+	return u.Used+amount <= u.Limit
+}
+
+// CrossedThresholds returns the thresholds passed when usage grew from previous to Used
+func (u *EntitlementUsage) CrossedThresholds(previous int64) []int {
+	var crossed []int
+	for _, t := range EntitlementThresholds {
+		mark := int64(t) * u.Limit
+		if previous*100 < mark && u.Used*100 >= mark {
+			crossed = append(crossed, t)
+		}
+	}
+	return crossed
+}
+
+// CheckEntitlement verifies that the tenant may consume amount of an entitlement
+func (t *Tenant) CheckEntitlement(usage *EntitlementUsage, amount int64) error {
+	if amount <= 0 {
+		return ErrInvalidEntitlementAmount
+	}
+	if !t.IsActive() {
+		return ErrTenantNotActive
+	}
+	if !usage.Allows(amount) {
+		return ErrEntitlementExceeded
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntitlementLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		entName string
+		limit   int64
+		window  EntitlementWindow
+		period  time.Duration
+		wantErr error
+	}{
+		{"monthly", "monthly_notices_monitored", 500, WindowMonth, 0, nil},
+		{"rolling", "api_calls", 1000, WindowRolling, 24 * time.Hour, nil},
+		{"quota name is reserved", "max_users", 10, WindowNone, 0, ErrInvalidEntitlementName},
+		{"invalid name", "Monthly-Notices", 10, WindowMonth, 0, ErrInvalidEntitlementName},
+		{"zero limit", "api_calls", 0, WindowDay, 0, ErrInvalidEntitlementLimit},
+		{"unknown window", "api_calls", 10, "week", 0, ErrInvalidEntitlementWindow},
+		{"rolling without period", "api_calls", 10, WindowRolling, 0, ErrInvalidEntitlementWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, err := NewEntitlementLimit(tt.entName, tt.limit, tt.window, tt.period)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.entName, limit.Name)
+		})
+	}
+}
+
+func TestEntitlementLimit_Windows(t *testing.T) {
+	now := time.Date(2026, time.March, 14, 15, 42, 0, 0, time.UTC)
+
+	month := &EntitlementLimit{Name: "notices", Limit: 10, Window: WindowMonth}
+	assert.Equal(t, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), month.WindowStart(now))
+	assert.Equal(t, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), *month.ResetsAt(now))
+	assert.Equal(t, month.WindowStart(now), month.Bucket(now))
+
+	day := &EntitlementLimit{Name: "notices", Limit: 10, Window: WindowDay}
+	assert.Equal(t, time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC), *day.ResetsAt(now))
+
+	// A 24h rolling window counts in 24-minute buckets
+	rolling := &EntitlementLimit{Name: "api_calls", Limit: 10, Window: WindowRolling, Period: 24 * time.Hour}
+	assert.Equal(t, time.Date(2026, time.March, 14, 15, 36, 0, 0, time.UTC), rolling.Bucket(now))
+	assert.Equal(t, time.Date(2026, time.March, 13, 15, 36, 0, 0, time.UTC), rolling.WindowStart(now))
+	assert.Nil(t, rolling.ResetsAt(now))
+
+	none := &EntitlementLimit{Name: "seats", Limit: 10, Window: WindowNone}
+	assert.Equal(t, none.WindowStart(now), none.WindowStart(now.AddDate(1, 0, 0)))
+	assert.Nil(t, none.ResetsAt(now))
+}
+
+func TestEntitlementUsage_CrossedThresholds(t *testing.T) {
+	limit := &EntitlementLimit{Name: "notices", Limit: 50, Window: WindowMonth}
+
+	usage := &EntitlementUsage{EntitlementLimit: limit, Used: 40}
+	assert.Equal(t, []int{80}, usage.CrossedThresholds(39))
+	assert.Empty(t, usage.CrossedThresholds(40))
+
+	usage.Used = 50
+	assert.Equal(t, []int{80, 100}, usage.CrossedThresholds(10))
+	assert.Equal(t, []int{100}, usage.CrossedThresholds(45))
+	assert.Equal(t, int64(0), usage.Remaining())
+}
+
+func TestTenant_CheckEntitlement(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanBasic], "admin@test.com")
+	usage := &EntitlementUsage{
+		EntitlementLimit: &EntitlementLimit{Name: "notices", Limit: 10, Window: WindowMonth},
+		Used:             8,
+	}
+
+	assert.ErrorIs(t, tenant.CheckEntitlement(usage, 1), ErrTenantNotActive)
+
+	require.NoError(t, tenant.CompleteProvisioning())
+	assert.NoError(t, tenant.CheckEntitlement(usage, 2))
+	assert.ErrorIs(t, tenant.CheckEntitlement(usage, 3), ErrEntitlementExceeded)
+	assert.ErrorIs(t, tenant.CheckEntitlement(usage, 0), ErrInvalidEntitlementAmount)
+}
+
+func TestReleaseFromBuckets(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	buckets := []UsageBucket{
+		{Start: t0, Used: 4},
+		{Start: t0.Add(2 * time.Minute), Used: 0},
+		{Start: t0.Add(time.Minute), Used: 3},
+	}
+
+	deltas := ReleaseFromBuckets(buckets, 5)
+	assert.Equal(t, []UsageBucket{
+		{Start: t0.Add(time.Minute), Used: -3},
+		{Start: t0, Used: -2},
+	}, deltas)
+
+	deltas = ReleaseFromBuckets(buckets, 20)
+	assert.Equal(t, []UsageBucket{
+		{Start: t0.Add(time.Minute), Used: -3},
+		{Start: t0, Used: -4},
+	}, deltas)
+
+	assert.Empty(t, ReleaseFromBuckets(nil, 5))
+}
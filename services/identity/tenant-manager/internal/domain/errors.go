@@ -27,6 +27,141 @@ var (
 	ErrTenantAlreadyDeleted        = errors.New("tenant is already deleted")
 	ErrCannotSuspendDeletedTenant  = errors.New("cannot suspend deleted tenant")
 	ErrPlanAlreadySet              = errors.New("tenant already has this plan")
+	ErrPlanLimitExceeded           = errors.New("tenant usage exceeds the plan limits")
+	ErrTenantAlreadyArchived       = errors.New("tenant is already archived")
+	ErrIllegalTransition           = errors.New("illegal tenant status transition")
+	ErrTenantNotDeleted            = errors.New("tenant is not deleted")
+	ErrTenantAlreadyPurged         = errors.New("tenant is already purged")
+
+	// Slug errors
+	ErrSlugReserved      = errors.New("tenant slug is reserved")
+	ErrSlugUnchanged     = errors.New("tenant already has this slug")
+	ErrSlugInCooldown    = errors.New("tenant slug was released recently")
+	ErrSlugAliasNotFound = errors.New("tenant slug alias not found")
+	ErrSlugAliasReleased = errors.New("tenant slug alias is already released")
+
+	// Hierarchy errors
+	ErrParentTenantNotFound          = errors.New("parent tenant not found")
+	ErrParentTenantDeleted           = errors.New("parent tenant is deleted")
+	ErrHierarchyCycle                = errors.New("tenant cannot be its own ancestor")
+	ErrHierarchyTooDeep              = errors.New("tenant hierarchy cannot exceed 4 levels")
+	ErrQuotaInheritanceWithoutParent = errors.New("only a tenant with a parent can inherit quotas")
+
+	// Trial errors
+	ErrNoActiveTrial            = errors.New("tenant has no active trial")
+	ErrTrialAlreadyUsed         = errors.New("tenant has already had a trial")
+	ErrTrialNotExpired          = errors.New("trial has not ended yet")
+	ErrInvalidTrialDuration     = errors.New("trial must last between 1 and 90 days")
+	ErrInvalidTrialExpiryPolicy = errors.New("trial expiry policy must be downgrade or suspend")
+	ErrInvalidTrialFallback     = errors.New("trial fallback plan must differ from the trial plan")
+
+	// Suspension errors
+	ErrInvalidSuspensionReason = errors.New("suspension reason must be billing, abuse, legal_hold, customer_request or security")
+	ErrReinstatementRestricted = errors.New("suspension reason does not allow self-service reactivation")
+	ErrRestrictedSuspension    = errors.New("a tenant under an abuse, security or legal hold suspension cannot be archived or deleted")
+	ErrSuspensionDowngrade     = errors.New("a suspension can only be replaced by one at least as restrictive")
+
+	// Scheduled operation errors
+	ErrInvalidOperationAction = errors.New("only suspend and activate can be scheduled")
+	ErrInvalidOperationTime   = errors.New("scheduled operations must run in the future, within a year")
+	ErrInvalidReactivateAfter = errors.New("only a suspension can be followed by a reactivation, within a year")
+	ErrRestrictedReactivation = errors.New("a suspension for this reason cannot end in a scheduled reactivation")
+	ErrOperationNotFound      = errors.New("scheduled operation not found")
+	ErrOperationNotPending    = errors.New("scheduled operation is no longer pending")
+	ErrSuspensionSuperseded   = errors.New("tenant is no longer under the suspension this reactivation ends")
+
+	// Membership errors
+	ErrInvalidMemberUser   = errors.New("member user ID is required")
+	ErrInvalidMemberRole   = errors.New("member role must be tenant_admin, tenant_manager, tenant_user or tenant_viewer")
+	ErrMemberNotFound      = errors.New("tenant member not found")
+	ErrMemberAlreadyExists = errors.New("user is already a member of the tenant")
+	ErrMemberRoleUnchanged = errors.New("member already has this role")
+	ErrMemberLimitReached  = errors.New("tenant has reached its maximum number of users")
+	ErrLastTenantAdmin     = errors.New("tenant must keep at least one admin")
+
+	// Invitation errors
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrInvitationAlreadyExists = errors.New("email already has a pending invitation to the tenant")
+	ErrInvitationNotPending    = errors.New("invitation was already accepted or revoked")
+	ErrInvitationExpired       = errors.New("invitation has expired")
+	ErrInvalidInvitationToken  = errors.New("invalid invitation token")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to another email address")
+	ErrEmailNotVerified        = errors.New("email address has not been verified")
+
+	// Service account errors
+	ErrEmptyServiceAccountName   = errors.New("service account name cannot be empty")
+	ErrInvalidServiceAccountName = errors.New("service account name must contain only lowercase letters, numbers, and hyphens (max 100)")
+	ErrServiceAccountNotFound    = errors.New("service account not found")
+	ErrServiceAccountExists      = errors.New("service account already exists")
+	ErrServiceAccountInactive    = errors.New("service account is inactive")
+	ErrEmptyPermissions          = errors.New("at least one permission is required")
+	ErrInvalidPermission         = errors.New("invalid permission")
+	ErrInvalidKeyExpiry          = errors.New("API key expiry must be in the future")
+	ErrAPIKeyNotFound            = errors.New("API key not found")
+	ErrInvalidAPIKey             = errors.New("invalid API key")
+	ErrAPIKeyExpired             = errors.New("API key is expired")
+	ErrAPIKeyRevoked             = errors.New("API key is revoked")
+
+	// Plan catalog errors
+	ErrPlanNotFound      = errors.New("plan not found")
+	ErrPlanAlreadyExists = errors.New("plan already exists")
+	ErrPlanRetired       = errors.New("plan is retired")
+	ErrEmptyPlanName     = errors.New("plan display name cannot be empty")
+	ErrInvalidPlanQuota  = errors.New("plan quotas must be positive")
+
+	// Quota errors
+	ErrInvalidQuotaName      = errors.New("unknown quota")
+	ErrInvalidQuotaValue     = errors.New("quota value must be positive")
+	ErrEmptyQuotaReason      = errors.New("quota override reason cannot be empty")
+	ErrInvalidQuotaExpiry    = errors.New("quota override expiry must be in the future")
+	ErrQuotaOverrideNotFound = errors.New("quota override not found")
+
+	// Entitlement errors
+	ErrInvalidEntitlementName   = errors.New("entitlement name must be lowercase snake_case (max 50) and not a quota name")
+	ErrInvalidEntitlementLimit  = errors.New("entitlement limit must be positive")
+	ErrInvalidEntitlementWindow = errors.New("invalid entitlement window")
+	ErrInvalidEntitlementAmount = errors.New("entitlement amount must be positive")
+	ErrDuplicateEntitlement     = errors.New("entitlement is defined more than once")
+	ErrEntitlementNotFound      = errors.New("entitlement not found")
+	ErrEntitlementExceeded      = errors.New("entitlement limit exceeded")
+	ErrEntitlementNotCounted    = errors.New("entitlement usage is measured from the tenant and is not consumed or released")
+	ErrTenantNotActive          = errors.New("tenant is not active")
+
+	// Settings errors
+	ErrInvalidSettings            = errors.New("invalid tenant settings")
+	ErrUnsupportedSettingsVersion = errors.New("unsupported settings version")
+	ErrSettingsUnreadable         = errors.New("stored tenant settings cannot be read by this version and cannot be changed")
+
+	// Feature flag errors
+	ErrInvalidFeatureKey       = errors.New("feature flag key must be lowercase snake_case (max 50)")
+	ErrInvalidFeatureType      = errors.New("feature flag type must be boolean, number or string")
+	ErrEmptyFeatureDescription = errors.New("feature flag description cannot be empty")
+	ErrInvalidFeatureRollout   = errors.New("feature flag rollout must be 0-100 percent, and only boolean flags roll out")
+	ErrInvalidFeatureValue     = errors.New("feature flag value does not match the flag type")
+	ErrFeatureNotFound         = errors.New("feature flag not found")
+	ErrFeatureOverrideNotFound = errors.New("feature flag override not found")
+
+	// Custom domain errors
+	ErrInvalidHostname     = errors.New("hostname must be a fully qualified domain name")
+	ErrDomainNotFound      = errors.New("custom domain not found")
+	ErrDomainAlreadyExists = errors.New("custom domain is already registered")
+	ErrDomainRevoked       = errors.New("custom domain is revoked")
+	ErrDomainChanged       = errors.New("custom domain was changed since it was read")
+	ErrDomainLookupFailed  = errors.New("custom domain DNS lookup failed")
+
+	// Restore errors
+	ErrRestoreWindowExpired = errors.New("tenant retention period has elapsed")
+	ErrTenantSchemaMissing  = errors.New("tenant schema no longer exists")
+
+	// Archive errors
+	ErrArchiveNotFound         = errors.New("tenant archive not found")
+	ErrArchiveChecksumMismatch = errors.New("tenant archive checksum mismatch")
+
+	// Storage errors
+	ErrStorageSnapshotNotFound = errors.New("tenant storage has not been measured yet")
+
+	// Audit errors
+	ErrInvalidTimeRange = errors.New("time range start must be before its end")
 
 	// Repository errors
 	ErrDatabaseConnection = errors.New("database connection error")
@@ -57,8 +192,34 @@ func IsValidationError(err error) bool {
 		errors.Is(err, ErrEmptyTenantSlug) ||
 		errors.Is(err, ErrTenantSlugTooLong) ||
 		errors.Is(err, ErrInvalidTenantSlug) ||
+		errors.Is(err, ErrSlugReserved) ||
 		errors.Is(err, ErrEmptyEmail) ||
 		errors.Is(err, ErrEmailTooLong) ||
 		errors.Is(err, ErrInvalidEmail) ||
-		errors.Is(err, ErrInvalidPlanTier)
+		errors.Is(err, ErrInvalidPlanTier) ||
+		errors.Is(err, ErrEmptyPlanName) ||
+		errors.Is(err, ErrInvalidPlanQuota) ||
+		errors.Is(err, ErrInvalidEntitlementName) ||
+		errors.Is(err, ErrInvalidEntitlementLimit) ||
+		errors.Is(err, ErrInvalidEntitlementWindow) ||
+		errors.Is(err, ErrInvalidEntitlementAmount) ||
+		errors.Is(err, ErrDuplicateEntitlement) ||
+		errors.Is(err, ErrInvalidFeatureKey) ||
+		errors.Is(err, ErrInvalidFeatureType) ||
+		errors.Is(err, ErrEmptyFeatureDescription) ||
+		errors.Is(err, ErrInvalidFeatureRollout) ||
+		errors.Is(err, ErrInvalidFeatureValue) ||
+		errors.Is(err, ErrInvalidSettings) ||
+		errors.Is(err, ErrInvalidHostname) ||
+		errors.Is(err, ErrQuotaInheritanceWithoutParent) ||
+		errors.Is(err, ErrInvalidTrialDuration) ||
+		errors.Is(err, ErrInvalidTrialExpiryPolicy) ||
+		errors.Is(err, ErrInvalidTrialFallback) ||
+		errors.Is(err, ErrInvalidOperationAction) ||
+		errors.Is(err, ErrInvalidOperationTime) ||
+		errors.Is(err, ErrInvalidReactivateAfter) ||
+		errors.Is(err, ErrRestrictedReactivation) ||
+		errors.Is(err, ErrInvalidSuspensionReason) ||
+		errors.Is(err, ErrInvalidMemberUser) ||
+		errors.Is(err, ErrInvalidMemberRole)
 }
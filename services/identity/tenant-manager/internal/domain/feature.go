@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// featureKeyRegex matches feature flag keys such as "sso" or "ai_quotation_assistant"
+var featureKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// FeatureType is the type of a feature flag's values
+type FeatureType string
+
+const (
+	FeatureBoolean FeatureType = "boolean"
+	FeatureNumber  FeatureType = "number"
+	FeatureString  FeatureType = "string"
+)
+
+// IsValid checks if the feature type is known
+func (t FeatureType) IsValid() bool {
+	switch t {
+	case FeatureBoolean, FeatureNumber, FeatureString:
+		return true
+	}
+	return false
+}
+
+// FeatureSource tells where the value of a tenant's feature flag comes from
+type FeatureSource string
+
+const (
+	// FeatureSourceOverride is a value set for the tenant alone
+	FeatureSourceOverride FeatureSource = "override"
+	// FeatureSourcePlan is the value granted by the tenant's plan
+	FeatureSourcePlan FeatureSource = "plan"
+	// FeatureSourceRollout is a boolean flag turned on by a percentage rollout
+	FeatureSourceRollout FeatureSource = "rollout"
+	// FeatureSourceDefault is the flag's registered default
+	FeatureSourceDefault FeatureSource = "default"
+)
+
+// FeatureFlag is a known flag of the feature registry
+type FeatureFlag struct {
+	Key         string
+	Type        FeatureType
+	Default     interface{}
+	Description string
+
+	// RolloutPercent turns a boolean flag on for this share of tenants,
+	// picked by their tenant ID, unless their plan or an override sets it
+	RolloutPercent int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewFeatureFlag creates a new feature flag for the registry
+func NewFeatureFlag(key string, featureType FeatureType, defaultValue interface{}, description string, rolloutPercent int) (*FeatureFlag, error) {
+	if !featureKeyRegex.MatchString(key) {
+		return nil, ErrInvalidFeatureKey
+	}
+	if !featureType.IsValid() {
+		return nil, ErrInvalidFeatureType
+	}
+	if strings.TrimSpace(description) == "" {
+		return nil, ErrEmptyFeatureDescription
+	}
+	if rolloutPercent < 0 || rolloutPercent > 100 || (rolloutPercent > 0 && featureType != FeatureBoolean) {
+		return nil, ErrInvalidFeatureRollout
+	}
+
+	now := time.Now()
+	flag := &FeatureFlag{
+		Key:            key,
+		Type:           featureType,
+		Description:    description,
+		RolloutPercent: rolloutPercent,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	value, err := flag.CheckValue(defaultValue)
+	if err != nil {
+		return nil, err
+	}
+	flag.Default = value
+
+	return flag, nil
+}
+
+// CheckValue verifies that value has the flag's type and returns it in the
+// form stored in JSON: numbers become float64
+func (f *FeatureFlag) CheckValue(value interface{}) (interface{}, error) {
+	switch f.Type {
+	case FeatureBoolean:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case FeatureNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		}
+	case FeatureString:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	}
+	return nil, ErrInvalidFeatureValue
+}
+
+// InRollout reports whether the flag's percentage rollout includes the tenant
+func (f *FeatureFlag) InRollout(tenantID uuid.UUID) bool {
+	return f.RolloutPercent > 0 && RolloutBucket(f.Key, tenantID) < f.RolloutPercent
+}
+
+// Snapshot returns the audited state of the flag
+func (f *FeatureFlag) Snapshot() map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+	return normalize(map[string]interface{}{
+		"key":             f.Key,
+		"type":            f.Type,
+		"default":         f.Default,
+		"description":     f.Description,
+		"rollout_percent": f.RolloutPercent,
+	})
+}
+
+// RolloutBucket places a tenant in one of 100 buckets of a flag's rollout.
+// The key salts the hash, so each flag rolls out to a different set of
+// tenants, and raising a rollout's percentage only ever adds tenants.
+func RolloutBucket(key string, tenantID uuid.UUID) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{':'})
+	h.Write(tenantID[:])
+	return int(h.Sum32() % 100)
+}
+
+// CheckFeatures verifies that every feature of the plan is a registered flag
+// with a value of its type
+func (p *Plan) CheckFeatures(flags []*FeatureFlag) error {
+	byKey := make(map[string]*FeatureFlag, len(flags))
+	for _, f := range flags {
+		byKey[f.Key] = f
+	}
+
+	for key, value := range p.Features {
+		flag, ok := byKey[key]
+		if !ok {
+			return ErrFeatureNotFound
+		}
+		v, err := flag.CheckValue(value)
+		if err != nil {
+			return err
+		}
+		p.Features[key] = v
+	}
+
+	return nil
+}
+
+// FeatureValue is the value of a feature flag resolved for a tenant
+type FeatureValue struct {
+	Key    string
+	Type   FeatureType
+	Value  interface{}
+	Source FeatureSource
+}
+
+// EvaluateFeatures resolves every registered flag for the tenant, by key. A
+// flag takes the tenant's override, otherwise the value of its plan, otherwise
+// true when a rollout includes the tenant, otherwise its default. Values of
+// the wrong type, left over from a flag whose type changed, are skipped.
+func (t *Tenant) EvaluateFeatures(flags []*FeatureFlag) []*FeatureValue {
+	values := make([]*FeatureValue, 0, len(flags))
+	for _, f := range flags {
+		values = append(values, t.evaluateFeature(f))
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Key < values[j].Key })
+	return values
+}
+
+// SameFeatures reports whether two evaluations of a tenant's features, as
+// returned by EvaluateFeatures, resolve every flag to the same value from the
+// same source
+func SameFeatures(a, b []*FeatureValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].Type != b[i].Type || a[i].Value != b[i].Value || a[i].Source != b[i].Source {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluateFeature resolves one flag for the tenant
+func (t *Tenant) evaluateFeature(f *FeatureFlag) *FeatureValue {
+	value := &FeatureValue{Key: f.Key, Type: f.Type}
+
+	if v, ok := t.FeatureOverrides[f.Key]; ok {
+		if v, err := f.CheckValue(v); err == nil {
+			value.Value, value.Source = v, FeatureSourceOverride
+			return value
+		}
+	}
+	if v, ok := t.Features[f.Key]; ok {
+		if v, err := f.CheckValue(v); err == nil {
+			value.Value, value.Source = v, FeatureSourcePlan
+			return value
+		}
+	}
+	if f.InRollout(t.TenantID) {
+		value.Value, value.Source = true, FeatureSourceRollout
+		return value
+	}
+
+	value.Value, value.Source = f.Default, FeatureSourceDefault
+	return value
+}
+
+// SetFeatureOverride sets the value of a flag for this tenant alone,
+// whatever its plan. Overrides survive plan changes.
+func (t *Tenant) SetFeatureOverride(flag *FeatureFlag, value interface{}) error {
+	v, err := flag.CheckValue(value)
+	if err != nil {
+		return err
+	}
+
+	if t.FeatureOverrides == nil {
+		t.FeatureOverrides = make(map[string]interface{})
+	}
+	t.FeatureOverrides[flag.Key] = v
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RemoveFeatureOverride returns a flag to the value of the tenant's plan
+func (t *Tenant) RemoveFeatureOverride(key string) error {
+	if _, ok := t.FeatureOverrides[key]; !ok {
+		return ErrFeatureOverrideNotFound
+	}
+
+	delete(t.FeatureOverrides, key)
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeatureFlag(t *testing.T) {
+	flag, err := NewFeatureFlag("max_exports", FeatureNumber, 10, "Exports per day", 0)
+	require.NoError(t, err)
+	assert.Equal(t, float64(10), flag.Default)
+
+	_, err = NewFeatureFlag("Max-Exports", FeatureNumber, 10, "Exports per day", 0)
+	assert.ErrorIs(t, err, ErrInvalidFeatureKey)
+
+	_, err = NewFeatureFlag("max_exports", FeatureNumber, "10", "Exports per day", 0)
+	assert.ErrorIs(t, err, ErrInvalidFeatureValue)
+
+	_, err = NewFeatureFlag("max_exports", FeatureNumber, 10, "Exports per day", 50)
+	assert.ErrorIs(t, err, ErrInvalidFeatureRollout)
+}
+
+func TestTenant_EvaluateFeatures(t *testing.T) {
+	sso, _ := NewFeatureFlag("sso", FeatureBoolean, false, "Single sign-on", 0)
+	reports, _ := NewFeatureFlag("reports", FeatureString, "basic", "Report tier", 0)
+	beta, _ := NewFeatureFlag("beta", FeatureBoolean, false, "Beta program", 100)
+	flags := []*FeatureFlag{sso, reports, beta}
+
+	plan, err := NewPlan("team", "Team", "", 50, 200, map[string]interface{}{"reports": "advanced"}, 0)
+	require.NoError(t, err)
+	require.NoError(t, plan.CheckFeatures(flags))
+	tenant, _ := NewTenant("Test Company", "test-company", plan, "admin@test.com")
+
+	values := tenant.EvaluateFeatures(flags)
+	require.Len(t, values, 3)
+	assert.Equal(t, "beta", values[0].Key, "sorted by key")
+	assert.Equal(t, FeatureSourceRollout, values[0].Source)
+	assert.Equal(t, "advanced", values[1].Value)
+	assert.Equal(t, FeatureSourcePlan, values[1].Source)
+	assert.Equal(t, false, values[2].Value)
+	assert.Equal(t, FeatureSourceDefault, values[2].Source)
+
+	// An override wins over the plan
+	require.NoError(t, tenant.SetFeatureOverride(reports, "custom"))
+	assert.ErrorIs(t, tenant.SetFeatureOverride(sso, "yes"), ErrInvalidFeatureValue)
+	values = tenant.EvaluateFeatures(flags)
+	assert.Equal(t, "custom", values[1].Value)
+	assert.Equal(t, FeatureSourceOverride, values[1].Source)
+
+	require.NoError(t, tenant.RemoveFeatureOverride("reports"))
+	assert.ErrorIs(t, tenant.RemoveFeatureOverride("reports"), ErrFeatureOverrideNotFound)
+	assert.Equal(t, FeatureSourcePlan, tenant.EvaluateFeatures(flags)[1].Source)
+
+	// Plans may only grant registered flags
+	plan.Features["unknown"] = true
+	assert.ErrorIs(t, plan.CheckFeatures(flags), ErrFeatureNotFound)
+}
+
+func TestFeatureFlag_InRollout(t *testing.T) {
+	flag, err := NewFeatureFlag("new_editor", FeatureBoolean, false, "New editor", 30)
+	require.NoError(t, err)
+
+	in := 0
+	for i := 0; i < 1000; i++ {
+		tenantID := uuid.New()
+		assert.Equal(t, flag.InRollout(tenantID), flag.InRollout(tenantID), "stable per tenant")
+		if flag.InRollout(tenantID) {
+			in++
+			// Raising the percentage keeps every tenant already in
+			flag.RolloutPercent = 60
+			assert.True(t, flag.InRollout(tenantID))
+			flag.RolloutPercent = 30
+		}
+	}
+	assert.InDelta(t, 300, in, 60)
+}
+
+func TestSameFeatures(t *testing.T) {
+	sso, _ := NewFeatureFlag("sso", FeatureBoolean, false, "Single sign-on", 0)
+	flags := []*FeatureFlag{sso}
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+
+	before := tenant.EvaluateFeatures(flags)
+	assert.True(t, SameFeatures(before, tenant.EvaluateFeatures(flags)))
+	assert.False(t, SameFeatures(nil, before))
+
+	require.NoError(t, tenant.SetFeatureOverride(sso, true))
+	assert.False(t, SameFeatures(before, tenant.EvaluateFeatures(flags)))
+
+	// The same value from another source is a change too
+	require.NoError(t, tenant.SetFeatureOverride(sso, false))
+	assert.False(t, SameFeatures(before, tenant.EvaluateFeatures(flags)))
+}
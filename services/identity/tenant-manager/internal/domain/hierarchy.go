@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxHierarchyDepth is how many levels a tenant hierarchy may have, the root
+// included, as in holding, company, unit, sub-unit
+const MaxHierarchyDepth = 4
+
+// TenantHierarchy is a tenant with its ancestors and descendants
+type TenantHierarchy struct {
+	Tenant *Tenant
+	// Ancestors lead from the root down to the tenant's parent
+	Ancestors []*Tenant
+	// Descendants are ordered by depth, then by name; each one's parent is
+	// either the tenant or an earlier descendant
+	Descendants []*Tenant
+}
+
+// Root returns the root of the hierarchy the tenant belongs to
+func (h *TenantHierarchy) Root() *Tenant {
+	if len(h.Ancestors) > 0 {
+		return h.Ancestors[0]
+	}
+	return h.Tenant
+}
+
+// HasParent checks if the tenant is the child of another tenant
+func (t *Tenant) HasParent() bool {
+	return t.ParentTenantID != nil
+}
+
+// SetParent places the tenant under parent, or makes it a root when parent is
+// nil. ancestors are the parent's own ancestors, in any order, and height is
+// how many levels of descendants the tenant has, 0 for none. The tenant may
+// not end up among its own ancestors, nor the hierarchy deeper than
+// MaxHierarchyDepth.
+func (t *Tenant) SetParent(parent *Tenant, ancestors []*Tenant, height int, inheritQuotas bool) error {
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+
+	if parent == nil {
+		if inheritQuotas {
+			return ErrQuotaInheritanceWithoutParent
+		}
+		t.ParentTenantID = nil
+		t.InheritQuotas = false
+		t.inheritedQuotas = nil
+		t.UpdatedAt = time.Now()
+		return nil
+	}
+
+	if parent.IsDeleted() {
+		return ErrParentTenantDeleted
+	}
+
+	if parent.TenantID == t.TenantID {
+		return ErrHierarchyCycle
+	}
+	for _, a := range ancestors {
+		if a.TenantID == t.TenantID {
+			return ErrHierarchyCycle
+		}
+	}
+
+	// The parent's ancestors, the parent, the tenant and its descendants
+	if len(ancestors)+2+height > MaxHierarchyDepth {
+		return ErrHierarchyTooDeep
+	}
+
+	parentID := parent.TenantID
+	t.ParentTenantID = &parentID
+	t.InheritQuotas = inheritQuotas
+	if !inheritQuotas {
+		t.inheritedQuotas = nil
+	}
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// InheritQuotasFrom takes the parent's effective quotas as the defaults of a
+// tenant that inherits quotas, in place of its plan quotas. The tenant's own
+// overrides still apply on top. It does nothing for other tenants.
+func (t *Tenant) InheritQuotasFrom(parent *Tenant, now time.Time) {
+	if !t.InheritQuotas || parent == nil || t.ParentTenantID == nil || *t.ParentTenantID != parent.TenantID {
+		return
+	}
+
+	t.inheritedQuotas = make(map[QuotaName]int, len(QuotaNames))
+	for _, q := range parent.EffectiveQuotas(now) {
+		t.inheritedQuotas[q.Name] = q.Effective
+	}
+}
+
+// HierarchyHeight computes how many levels of descendants a tenant has, from
+// its descendants as returned in TenantHierarchy
+func HierarchyHeight(tenantID uuid.UUID, descendants []*Tenant) int {
+	depth := map[uuid.UUID]int{tenantID: 0}
+	height := 0
+	for _, d := range descendants {
+		if d.ParentTenantID == nil {
+			continue
+		}
+		parentDepth, ok := depth[*d.ParentTenantID]
+		if !ok {
+			continue
+		}
+		depth[d.TenantID] = parentDepth + 1
+		if parentDepth+1 > height {
+			height = parentDepth + 1
+		}
+	}
+	return height
+}
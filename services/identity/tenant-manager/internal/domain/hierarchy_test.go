@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_SetParent(t *testing.T) {
+	holding, _ := NewTenant("Holding", "holding", testPlans[PlanEnterprise], "admin@holding.com")
+	company, _ := NewTenant("Company", "company", testPlans[PlanProfessional], "admin@company.com")
+	unit, _ := NewTenant("Unit", "unit", testPlans[PlanBasic], "admin@unit.com")
+
+	require.NoError(t, company.SetParent(holding, nil, 0, false))
+	assert.Equal(t, holding.TenantID, *company.ParentTenantID)
+	require.NoError(t, unit.SetParent(company, []*Tenant{holding}, 0, false))
+
+	// Cycles
+	assert.ErrorIs(t, holding.SetParent(holding, nil, 0, false), ErrHierarchyCycle)
+	assert.ErrorIs(t, holding.SetParent(unit, []*Tenant{holding, company}, 2, false), ErrHierarchyCycle)
+
+	// Depth counts the ancestors, the parent, the tenant and its descendants
+	subUnit, _ := NewTenant("Sub-unit", "sub-unit", testPlans[PlanBasic], "admin@sub.com")
+	require.NoError(t, subUnit.SetParent(unit, []*Tenant{holding, company}, 0, false))
+	other, _ := NewTenant("Other", "other", testPlans[PlanBasic], "admin@other.com")
+	assert.ErrorIs(t, other.SetParent(subUnit, []*Tenant{holding, company, unit}, 0, false), ErrHierarchyTooDeep)
+	assert.ErrorIs(t, other.SetParent(unit, []*Tenant{holding, company}, 1, false), ErrHierarchyTooDeep)
+
+	// Detach
+	assert.ErrorIs(t, unit.SetParent(nil, nil, 0, true), ErrQuotaInheritanceWithoutParent)
+	require.NoError(t, unit.SetParent(nil, nil, 0, false))
+	assert.False(t, unit.HasParent())
+
+	require.NoError(t, company.Delete())
+	assert.ErrorIs(t, unit.SetParent(company, nil, 0, false), ErrParentTenantDeleted)
+}
+
+func TestTenant_InheritQuotasFrom(t *testing.T) {
+	now := time.Now()
+	holding, _ := NewTenant("Holding", "holding", testPlans[PlanEnterprise], "admin@holding.com")
+	require.NoError(t, holding.SetQuotaOverride(QuotaMaxUsers, 1500, "contract 2026-014", nil))
+
+	company, _ := NewTenant("Company", "company", testPlans[PlanBasic], "admin@company.com")
+	require.NoError(t, company.SetParent(holding, nil, 0, true))
+	company.InheritQuotasFrom(holding, now)
+
+	quota := company.EffectiveQuota(QuotaMaxUsers, now)
+	assert.True(t, quota.Inherited)
+	assert.Equal(t, 1500, quota.Default)
+	assert.Equal(t, 1500, quota.Effective)
+
+	// The tenant's own overrides apply on top
+	require.NoError(t, company.SetQuotaOverride(QuotaMaxUsers, 200, "pilot", nil))
+	assert.Equal(t, 200, company.EffectiveQuota(QuotaMaxUsers, now).Effective)
+
+	// Without inheritance the plan default applies again
+	require.NoError(t, company.SetParent(holding, nil, 0, false))
+	quota = company.EffectiveQuota(QuotaMaxStorageGB, now)
+	assert.False(t, quota.Inherited)
+	assert.Equal(t, testPlans[PlanBasic].MaxStorageGB, quota.Default)
+}
+
+func TestHierarchyHeight(t *testing.T) {
+	holding, _ := NewTenant("Holding", "holding", testPlans[PlanEnterprise], "admin@holding.com")
+	company, _ := NewTenant("Company", "company", testPlans[PlanBasic], "admin@company.com")
+	unit, _ := NewTenant("Unit", "unit", testPlans[PlanBasic], "admin@unit.com")
+	require.NoError(t, company.SetParent(holding, nil, 0, false))
+	require.NoError(t, unit.SetParent(company, []*Tenant{holding}, 0, false))
+
+	assert.Equal(t, 0, HierarchyHeight(unit.TenantID, nil))
+	assert.Equal(t, 1, HierarchyHeight(company.TenantID, []*Tenant{unit}))
+	assert.Equal(t, 2, HierarchyHeight(holding.TenantID, []*Tenant{company, unit}))
+}
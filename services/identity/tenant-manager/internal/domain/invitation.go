@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvitationStatus represents the state of a tenant invitation
+type InvitationStatus string
+
+const (
+	// InvitationPending waits for the invitee to accept it
+	InvitationPending InvitationStatus = "pending"
+	// InvitationAccepted made the invitee a member of the tenant
+	InvitationAccepted InvitationStatus = "accepted"
+	// InvitationRevoked was withdrawn before it was accepted
+	InvitationRevoked InvitationStatus = "revoked"
+	// InvitationExpired is a pending invitation past its expiry. It is never
+	// stored: resending the invitation renews it.
+	InvitationExpired InvitationStatus = "expired"
+)
+
+// IsValid checks if the invitation status is a stored one
+func (s InvitationStatus) IsValid() bool {
+	switch s {
+	case InvitationPending, InvitationAccepted, InvitationRevoked:
+		return true
+	}
+	return false
+}
+
+// Invitation invites a person, by email, to join a tenant with a role. The
+// invitee receives a signed link; only the hash of the token it carries is
+// stored, and resending the invitation replaces it.
+type Invitation struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	// Email is the invitee's address, lowercased
+	Email string
+	Role  MemberRole
+
+	Status InvitationStatus
+	// TokenHash is the hex SHA-256 digest of the last token sent
+	TokenHash string
+	ExpiresAt time.Time
+	// SendCount counts the emails sent for the invitation
+	SendCount  int
+	LastSentAt time.Time
+
+	InvitedBy *uuid.UUID
+	// AcceptedBy is the Keycloak user that accepted the invitation
+	AcceptedBy *uuid.UUID
+	AcceptedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewInvitation invites an email address to a tenant with a role, valid for
+// ttl. The token hash is set once the invitation's token is signed.
+func NewInvitation(tenantID uuid.UUID, email string, role MemberRole, ttl time.Duration, now time.Time) (*Invitation, error) {
+	email = normalizeInvitationEmail(email)
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidMemberRole
+	}
+
+	return &Invitation{
+		ID:         uuid.New(),
+		TenantID:   tenantID,
+		Email:      email,
+		Role:       role,
+		Status:     InvitationPending,
+		ExpiresAt:  now.Add(ttl),
+		SendCount:  1,
+		LastSentAt: now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// IsPending checks if the invitation has not been accepted or revoked yet,
+// expired or not
+func (i *Invitation) IsPending() bool {
+	return i.Status == InvitationPending
+}
+
+// IsExpired checks if a pending invitation has passed its expiry
+func (i *Invitation) IsExpired(now time.Time) bool {
+	return i.IsPending() && !now.Before(i.ExpiresAt)
+}
+
+// EffectiveStatus returns the invitation's status, reporting an expired
+// pending invitation as expired
+func (i *Invitation) EffectiveStatus(now time.Time) InvitationStatus {
+	if i.IsExpired(now) {
+		return InvitationExpired
+	}
+	return i.Status
+}
+
+// MatchesEmail checks if an email address is the invitee's
+func (i *Invitation) MatchesEmail(email string) bool {
+	return email != "" && normalizeInvitationEmail(email) == i.Email
+}
+
+// Resend renews a pending invitation, expired or not, for another ttl. The
+// caller replaces the token hash, so links sent before stop working.
+func (i *Invitation) Resend(ttl time.Duration, now time.Time) error {
+	if !i.IsPending() {
+		return ErrInvitationNotPending
+	}
+
+	i.ExpiresAt = now.Add(ttl)
+	i.SendCount++
+	i.LastSentAt = now
+	i.UpdatedAt = now
+
+	return nil
+}
+
+// Revoke withdraws a pending invitation
+func (i *Invitation) Revoke(now time.Time) error {
+	if !i.IsPending() {
+		return ErrInvitationNotPending
+	}
+
+	i.Status = InvitationRevoked
+	i.RevokedAt = &now
+	i.UpdatedAt = now
+
+	return nil
+}
+
+// Accept records that a user accepted the invitation. An invitation can be
+// accepted once, before it expires.
+func (i *Invitation) Accept(userID uuid.UUID, now time.Time) error {
+	if !i.IsPending() {
+		return ErrInvitationNotPending
+	}
+	if i.IsExpired(now) {
+		return ErrInvitationExpired
+	}
+	if userID == uuid.Nil {
+		return ErrInvalidMemberUser
+	}
+
+	i.Status = InvitationAccepted
+	i.AcceptedBy = &userID
+	i.AcceptedAt = &now
+	i.UpdatedAt = now
+
+	return nil
+}
+
+// Snapshot returns the audited state of the invitation
+func (i *Invitation) Snapshot() map[string]interface{} {
+	if i == nil {
+		return nil
+	}
+	return normalize(map[string]interface{}{
+		"email":       i.Email,
+		"role":        i.Role,
+		"status":      i.Status,
+		"expires_at":  i.ExpiresAt,
+		"send_count":  i.SendCount,
+		"accepted_by": i.AcceptedBy,
+	})
+}
+
+// normalizeInvitationEmail trims and lowercases an email address, so an
+// invitee matches however the address was typed
+func normalizeInvitationEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
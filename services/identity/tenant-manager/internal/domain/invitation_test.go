@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInvitation(t *testing.T) {
+	now := time.Now()
+	tenantID := uuid.New()
+
+	_, err := NewInvitation(tenantID, "not-an-email", MemberUser, time.Hour, now)
+	assert.ErrorIs(t, err, ErrInvalidEmail)
+
+	_, err = NewInvitation(tenantID, "ana@example.com", "owner", time.Hour, now)
+	assert.ErrorIs(t, err, ErrInvalidMemberRole)
+
+	inv, err := NewInvitation(tenantID, "  Ana@Example.com ", MemberUser, time.Hour, now)
+	require.NoError(t, err)
+	assert.Equal(t, "ana@example.com", inv.Email)
+	assert.Equal(t, InvitationPending, inv.Status)
+	assert.Equal(t, 1, inv.SendCount)
+	assert.True(t, inv.MatchesEmail("ANA@example.com"))
+	assert.False(t, inv.MatchesEmail("bob@example.com"))
+	assert.False(t, inv.MatchesEmail(""))
+}
+
+func TestInvitation_ExpiryAndResend(t *testing.T) {
+	now := time.Now()
+	inv, err := NewInvitation(uuid.New(), "ana@example.com", MemberUser, time.Hour, now)
+	require.NoError(t, err)
+
+	later := now.Add(2 * time.Hour)
+	assert.Equal(t, InvitationExpired, inv.EffectiveStatus(later))
+	assert.ErrorIs(t, inv.Accept(uuid.New(), later), ErrInvitationExpired)
+
+	// Resending renews an expired invitation
+	require.NoError(t, inv.Resend(time.Hour, later))
+	assert.Equal(t, InvitationPending, inv.EffectiveStatus(later))
+	assert.Equal(t, 2, inv.SendCount)
+}
+
+func TestInvitation_AcceptOnce(t *testing.T) {
+	now := time.Now()
+	inv, err := NewInvitation(uuid.New(), "ana@example.com", MemberManager, time.Hour, now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, inv.Accept(uuid.Nil, now), ErrInvalidMemberUser)
+
+	userID := uuid.New()
+	require.NoError(t, inv.Accept(userID, now))
+	assert.Equal(t, InvitationAccepted, inv.Status)
+	assert.Equal(t, &userID, inv.AcceptedBy)
+
+	assert.ErrorIs(t, inv.Accept(uuid.New(), now), ErrInvitationNotPending)
+	assert.ErrorIs(t, inv.Resend(time.Hour, now), ErrInvitationNotPending)
+	assert.ErrorIs(t, inv.Revoke(now), ErrInvitationNotPending)
+}
+
+func TestInvitation_Revoke(t *testing.T) {
+	now := time.Now()
+	inv, err := NewInvitation(uuid.New(), "ana@example.com", MemberViewer, time.Hour, now)
+	require.NoError(t, err)
+
+	require.NoError(t, inv.Revoke(now))
+	assert.Equal(t, InvitationRevoked, inv.EffectiveStatus(now.Add(2*time.Hour)))
+	assert.ErrorIs(t, inv.Accept(uuid.New(), now), ErrInvitationNotPending)
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LifecycleAction is an operation that moves a tenant between statuses
+type LifecycleAction string
+
+const (
+	ActionCreate               LifecycleAction = "create"
+	ActionCompleteProvisioning LifecycleAction = "complete_provisioning"
+	ActionActivate             LifecycleAction = "activate"
+	ActionSuspend              LifecycleAction = "suspend"
+	ActionChangeSuspension     LifecycleAction = "change_suspension"
+	ActionArchive              LifecycleAction = "archive"
+	ActionUnarchive            LifecycleAction = "unarchive"
+	ActionDelete               LifecycleAction = "delete"
+	ActionRestore              LifecycleAction = "restore"
+)
+
+// lifecycleTransitions is the tenant state machine: for each action, the
+// statuses it may be applied from and the status it leads to. Every
+// lifecycle method goes through this table. Restore has no fixed target: it
+// returns a deleted tenant to one of the statuses in restorableStatuses.
+var lifecycleTransitions = map[LifecycleAction]struct {
+	From []TenantStatus
+	To   TenantStatus
+}{
+	ActionCompleteProvisioning: {From: []TenantStatus{StatusProvisioning}, To: StatusActive},
+	ActionActivate:             {From: []TenantStatus{StatusSuspended}, To: StatusActive},
+	ActionSuspend:              {From: []TenantStatus{StatusActive}, To: StatusSuspended},
+	ActionChangeSuspension:     {From: []TenantStatus{StatusSuspended}, To: StatusSuspended},
+	ActionArchive:              {From: []TenantStatus{StatusSuspended}, To: StatusArchived},
+	ActionUnarchive:            {From: []TenantStatus{StatusArchived}, To: StatusActive},
+	ActionDelete:               {From: []TenantStatus{StatusProvisioning, StatusActive, StatusSuspended, StatusArchived}, To: StatusDeleted},
+	ActionRestore:              {From: []TenantStatus{StatusDeleted}},
+}
+
+// restorableStatuses are the statuses a deleted tenant can be restored to
+var restorableStatuses = []TenantStatus{StatusActive, StatusSuspended, StatusArchived}
+
+// CanTransition reports whether the action may be applied to a tenant in the given status
+func CanTransition(from TenantStatus, action LifecycleAction) bool {
+	rule, ok := lifecycleTransitions[action]
+	if !ok {
+		return false
+	}
+	for _, allowed := range rule.From {
+		if allowed == from {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionError reports a lifecycle action applied from a status that
+// does not allow it. It matches ErrIllegalTransition with errors.Is, and the
+// more specific ErrTenantDeleted, ErrTenantNotDeleted or ErrTenantAlready*
+// errors where one applies.
+type TransitionError struct {
+	Action LifecycleAction
+	From   TenantStatus
+	To     TenantStatus
+}
+
+// Error implements error
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot %s tenant: illegal transition from %s to %s", e.Action, e.From, e.To)
+}
+
+// Unwrap returns ErrIllegalTransition and the specific cause, if any
+func (e *TransitionError) Unwrap() []error {
+	errs := []error{ErrIllegalTransition}
+
+	switch {
+	case e.Action == ActionRestore && e.From != StatusDeleted:
+		errs = append(errs, ErrTenantNotDeleted)
+	case e.From == e.To && e.To == StatusActive:
+		errs = append(errs, ErrTenantAlreadyActive)
+	case e.From == e.To && e.To == StatusSuspended:
+		errs = append(errs, ErrTenantAlreadySuspended)
+	case e.From == e.To && e.To == StatusArchived:
+		errs = append(errs, ErrTenantAlreadyArchived)
+	case e.From == e.To && e.To == StatusDeleted:
+		errs = append(errs, ErrTenantAlreadyDeleted)
+	case e.From == StatusDeleted && e.Action != ActionRestore:
+		errs = append(errs, ErrTenantDeleted)
+	}
+
+	return errs
+}
+
+// IsTransitionError reports whether err is an illegal lifecycle transition
+func IsTransitionError(err error) bool {
+	var transitionErr *TransitionError
+	return errors.As(err, &transitionErr)
+}
+
+// StatusTransition records one change of a tenant's lifecycle status
+type StatusTransition struct {
+	ID         uuid.UUID
+	TenantID   uuid.UUID
+	Action     LifecycleAction
+	FromStatus TenantStatus // empty for the initial provisioning status
+	ToStatus   TenantStatus
+	Reason     string
+	ActorType  ActorType
+	ActorID    string
+	ActorName  string
+	OccurredAt time.Time
+}
+
+// StatusBeforeDeletion returns the status a tenant had when it was last
+// deleted, according to its status history (oldest first), or "" if the
+// history holds no deletion
+func StatusBeforeDeletion(history []*StatusTransition) TenantStatus {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Action == ActionDelete {
+			return history[i].FromStatus
+		}
+	}
+	return ""
+}
+
+// transition applies a lifecycle action through the transition table and
+// records the change for the status history
+func (t *Tenant) transition(action LifecycleAction, reason string) error {
+	return t.transitionTo(action, lifecycleTransitions[action].To, reason)
+}
+
+// transitionTo applies a lifecycle action that leads to the given status.
+// For actions with a fixed target, to must be that target.
+func (t *Tenant) transitionTo(action LifecycleAction, to TenantStatus, reason string) error {
+	rule, ok := lifecycleTransitions[action]
+	if !ok || to == "" || (rule.To != "" && rule.To != to) || !CanTransition(t.Status, action) {
+		return &TransitionError{Action: action, From: t.Status, To: to}
+	}
+
+	now := time.Now()
+	t.recordTransition(action, t.Status, to, reason, now)
+	t.Status = to
+	t.UpdatedAt = now
+
+	return nil
+}
+
+func (t *Tenant) recordTransition(action LifecycleAction, from, to TenantStatus, reason string, at time.Time) {
+	t.transitions = append(t.transitions, &StatusTransition{
+		ID:         uuid.New(),
+		TenantID:   t.TenantID,
+		Action:     action,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		ActorType:  ActorSystem,
+		OccurredAt: at,
+	})
+}
+
+// PendingTransitions returns the status transitions not yet persisted
+func (t *Tenant) PendingTransitions() []*StatusTransition {
+	return t.transitions
+}
+
+// ClearTransitions marks the pending transitions as persisted
+func (t *Tenant) ClearTransitions() {
+	t.transitions = nil
+}
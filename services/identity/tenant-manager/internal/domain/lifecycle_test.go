@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_LifecycleTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    TenantStatus
+		apply   func(*Tenant) error
+		want    TenantStatus
+		wantErr error
+	}{
+		{"suspend provisioning", StatusProvisioning, func(t *Tenant) error { return t.Suspend(SuspensionBilling, "r") }, StatusProvisioning, ErrIllegalTransition},
+		{"activate archived", StatusArchived, (*Tenant).Activate, StatusArchived, ErrIllegalTransition},
+		{"activate deleted", StatusDeleted, (*Tenant).Activate, StatusDeleted, ErrTenantDeleted},
+		{"complete provisioning twice", StatusActive, (*Tenant).CompleteProvisioning, StatusActive, ErrIllegalTransition},
+		{"archive active", StatusActive, func(t *Tenant) error { return t.Archive("") }, StatusActive, ErrIllegalTransition},
+		{"archive suspended", StatusSuspended, func(t *Tenant) error { return t.Archive("") }, StatusArchived, nil},
+		{"archive provisioning", StatusProvisioning, func(t *Tenant) error { return t.Archive("") }, StatusProvisioning, ErrIllegalTransition},
+		{"archive archived", StatusArchived, func(t *Tenant) error { return t.Archive("") }, StatusArchived, ErrTenantAlreadyArchived},
+		{"unarchive archived", StatusArchived, (*Tenant).Unarchive, StatusActive, nil},
+		{"delete archived", StatusArchived, (*Tenant).Delete, StatusDeleted, nil},
+		{"delete provisioning", StatusProvisioning, (*Tenant).Delete, StatusDeleted, nil},
+		{"restore deleted to suspended", StatusDeleted, func(t *Tenant) error { return t.Restore(StatusSuspended, "") }, StatusSuspended, nil},
+		{"restore deleted to provisioning", StatusDeleted, func(t *Tenant) error { return t.Restore(StatusProvisioning, "") }, StatusDeleted, ErrIllegalTransition},
+		{"restore active", StatusActive, func(t *Tenant) error { return t.Restore(StatusActive, "") }, StatusActive, ErrTenantNotDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanBasic], "admin@test.com")
+			tenant.Status = tt.from
+			tenant.ClearTransitions()
+
+			err := tt.apply(tenant)
+			assert.Equal(t, tt.want, tenant.Status)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, IsTransitionError(err))
+				assert.Empty(t, tenant.PendingTransitions())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, tenant.PendingTransitions(), 1)
+			assert.Equal(t, tt.from, tenant.PendingTransitions()[0].FromStatus)
+			assert.Equal(t, tt.want, tenant.PendingTransitions()[0].ToStatus)
+		})
+	}
+}
+
+func TestNewTenant_RecordsInitialTransition(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanBasic], "admin@test.com")
+
+	transitions := tenant.PendingTransitions()
+	require.Len(t, transitions, 1)
+	assert.Equal(t, ActionCreate, transitions[0].Action)
+	assert.Equal(t, TenantStatus(""), transitions[0].FromStatus)
+	assert.Equal(t, StatusProvisioning, transitions[0].ToStatus)
+}
+
+func TestStatusBeforeDeletion(t *testing.T) {
+	history := []*StatusTransition{
+		{Action: ActionCreate, ToStatus: StatusProvisioning},
+		{Action: ActionDelete, FromStatus: StatusActive, ToStatus: StatusDeleted},
+		{Action: ActionRestore, FromStatus: StatusDeleted, ToStatus: StatusActive},
+		{Action: ActionSuspend, FromStatus: StatusActive, ToStatus: StatusSuspended},
+		{Action: ActionDelete, FromStatus: StatusSuspended, ToStatus: StatusDeleted},
+	}
+
+	assert.Equal(t, StatusSuspended, StatusBeforeDeletion(history))
+	assert.Equal(t, TenantStatus(""), StatusBeforeDeletion(history[:1]))
+}
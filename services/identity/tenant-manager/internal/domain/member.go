@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemberRole is a user's role within one tenant
+type MemberRole string
+
+const (
+	// MemberAdmin has full control within the tenant
+	MemberAdmin MemberRole = "tenant_admin"
+	// MemberManager manages the tenant's resources and users
+	MemberManager MemberRole = "tenant_manager"
+	// MemberUser has standard permissions
+	MemberUser MemberRole = "tenant_user"
+	// MemberViewer has read-only access
+	MemberViewer MemberRole = "tenant_viewer"
+)
+
+// IsValid checks if the member role is known
+func (r MemberRole) IsValid() bool {
+	switch r {
+	case MemberAdmin, MemberManager, MemberUser, MemberViewer:
+		return true
+	}
+	return false
+}
+
+// Member is a Keycloak user's membership of a tenant. A removed member is
+// kept inactive, and reactivated when the user is added again.
+type Member struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	// UserID is the Keycloak user ID
+	UserID uuid.UUID
+	Role   MemberRole
+	// IsPrimary marks the tenant selected by default for a user of several
+	// tenants; a user has at most one primary membership
+	IsPrimary bool
+	IsActive  bool
+
+	CreatedBy *uuid.UUID
+	UpdatedBy *uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UserTenant is a tenant a user is an active member of, with the membership
+type UserTenant struct {
+	Member *Member
+	Tenant *Tenant
+}
+
+// NewMember adds a user to a tenant with a role
+func NewMember(tenantID, userID uuid.UUID, role MemberRole, now time.Time) (*Member, error) {
+	if userID == uuid.Nil {
+		return nil, ErrInvalidMemberUser
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidMemberRole
+	}
+
+	return &Member{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		UserID:    userID,
+		Role:      role,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Rejoin reactivates a removed member with a new role
+func (m *Member) Rejoin(role MemberRole, now time.Time) error {
+	if m.IsActive {
+		return ErrMemberAlreadyExists
+	}
+	if !role.IsValid() {
+		return ErrInvalidMemberRole
+	}
+
+	m.Role = role
+	m.IsActive = true
+	m.UpdatedAt = now
+
+	return nil
+}
+
+// ChangeRole gives an active member another role
+func (m *Member) ChangeRole(role MemberRole, now time.Time) error {
+	if !m.IsActive {
+		return ErrMemberNotFound
+	}
+	if !role.IsValid() {
+		return ErrInvalidMemberRole
+	}
+	if role == m.Role {
+		return ErrMemberRoleUnchanged
+	}
+
+	m.Role = role
+	m.UpdatedAt = now
+
+	return nil
+}
+
+// Remove deactivates a member. A removed membership is no longer primary,
+// so the user may pick another tenant as primary.
+func (m *Member) Remove(now time.Time) error {
+	if !m.IsActive {
+		return ErrMemberNotFound
+	}
+
+	m.IsActive = false
+	m.IsPrimary = false
+	m.UpdatedAt = now
+
+	return nil
+}
+
+// MakePrimary makes an active membership the user's primary one
+func (m *Member) MakePrimary(now time.Time) error {
+	if !m.IsActive {
+		return ErrMemberNotFound
+	}
+
+	m.IsPrimary = true
+	m.UpdatedAt = now
+
+	return nil
+}
+
+// IsLastAdmin checks if the member is the tenant's only active admin, given
+// the tenant's count of active admins. The last admin can be neither removed
+// nor demoted.
+func (m *Member) IsLastAdmin(activeAdmins int) bool {
+	return m.IsActive && m.Role == MemberAdmin && activeAdmins <= 1
+}
+
+// Snapshot returns the audited state of the member
+func (m *Member) Snapshot() map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	return normalize(map[string]interface{}{
+		"user_id":    m.UserID,
+		"role":       m.Role,
+		"is_primary": m.IsPrimary,
+		"is_active":  m.IsActive,
+	})
+}
+
+// CheckMemberLimit checks that the tenant has room for one more active
+// member, given its current count, under its effective max users quota
+func (t *Tenant) CheckMemberLimit(activeMembers int, now time.Time) error {
+	quota := t.EffectiveQuota(QuotaMaxUsers, now)
+	if activeMembers >= quota.Effective {
+		return fmt.Errorf("%w: %d of %d users", ErrMemberLimitReached, activeMembers, quota.Effective)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMember(t *testing.T) {
+	now := time.Now()
+	tenantID := uuid.New()
+
+	_, err := NewMember(tenantID, uuid.Nil, MemberUser, now)
+	assert.ErrorIs(t, err, ErrInvalidMemberUser)
+
+	_, err = NewMember(tenantID, uuid.New(), "owner", now)
+	assert.ErrorIs(t, err, ErrInvalidMemberRole)
+
+	m, err := NewMember(tenantID, uuid.New(), MemberUser, now)
+	require.NoError(t, err)
+	assert.True(t, m.IsActive)
+	assert.False(t, m.IsPrimary)
+}
+
+func TestMember_Lifecycle(t *testing.T) {
+	now := time.Now()
+	m, err := NewMember(uuid.New(), uuid.New(), MemberUser, now)
+	require.NoError(t, err)
+	m.IsPrimary = true
+
+	assert.ErrorIs(t, m.ChangeRole(MemberUser, now), ErrMemberRoleUnchanged)
+	require.NoError(t, m.ChangeRole(MemberManager, now))
+	assert.Equal(t, MemberManager, m.Role)
+	assert.ErrorIs(t, m.Rejoin(MemberUser, now), ErrMemberAlreadyExists)
+
+	// A removed member gives up its primary flag and can only rejoin
+	require.NoError(t, m.Remove(now))
+	assert.False(t, m.IsActive)
+	assert.False(t, m.IsPrimary)
+	assert.ErrorIs(t, m.Remove(now), ErrMemberNotFound)
+	assert.ErrorIs(t, m.ChangeRole(MemberAdmin, now), ErrMemberNotFound)
+	assert.ErrorIs(t, m.MakePrimary(now), ErrMemberNotFound)
+
+	require.NoError(t, m.Rejoin(MemberViewer, now))
+	assert.True(t, m.IsActive)
+	assert.Equal(t, MemberViewer, m.Role)
+
+	require.NoError(t, m.MakePrimary(now))
+	assert.True(t, m.IsPrimary)
+}
+
+func TestMember_IsLastAdmin(t *testing.T) {
+	m, err := NewMember(uuid.New(), uuid.New(), MemberAdmin, time.Now())
+	require.NoError(t, err)
+
+	assert.True(t, m.IsLastAdmin(1))
+	assert.False(t, m.IsLastAdmin(2))
+
+	m.Role = MemberManager
+	assert.False(t, m.IsLastAdmin(1))
+}
+
+func TestTenant_CheckMemberLimit(t *testing.T) {
+	now := time.Now()
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanFree], "admin@test.com")
+
+	assert.NoError(t, tenant.CheckMemberLimit(4, now))
+	assert.ErrorIs(t, tenant.CheckMemberLimit(5, now), ErrMemberLimitReached)
+
+	// An override raises the limit
+	require.NoError(t, tenant.SetQuotaOverride(QuotaMaxUsers, 10, "pilot", nil))
+	assert.NoError(t, tenant.CheckMemberLimit(5, now))
+}
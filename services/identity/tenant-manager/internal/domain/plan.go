@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Plan is a subscription plan of the plan catalog. Plans are never edited
+// once created: a commercial change is a new plan, and the old one is retired.
+type Plan struct {
+	Tier        PlanTier
+	DisplayName string
+	Description string
+
+	// Quotas granted to tenants on this plan
+	MaxUsers     int
+	MaxStorageGB int
+
+	// Features granted to tenants on this plan
+	Features map[string]interface{}
+
+	// Entitlements are the named usage limits of the plan, besides its quotas
+	Entitlements []*EntitlementLimit
+
+	// SortOrder orders plans for display, lowest first
+	SortOrder int
+
+	// RetiredAt is set once the plan can no longer be assigned. Tenants
+	// already on a retired plan keep it.
+	RetiredAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPlan creates a new plan for the catalog
+func NewPlan(tier PlanTier, displayName, description string, maxUsers, maxStorageGB int, features map[string]interface{}, sortOrder int) (*Plan, error) {
+	if !tier.IsValid() {
+		return nil, ErrInvalidPlanTier
+	}
+	if strings.TrimSpace(displayName) == "" {
+		return nil, ErrEmptyPlanName
+	}
+	if maxUsers <= 0 || maxStorageGB <= 0 {
+		return nil, ErrInvalidPlanQuota
+	}
+	if features == nil {
+		features = make(map[string]interface{})
+	}
+
+	now := time.Now()
+
+	return &Plan{
+		Tier:         tier,
+		DisplayName:  displayName,
+		Description:  description,
+		MaxUsers:     maxUsers,
+		MaxStorageGB: maxStorageGB,
+		Features:     features,
+		SortOrder:    sortOrder,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Retire stops the plan from being assigned to tenants
+func (p *Plan) Retire() error {
+	if p.IsRetired() {
+		return ErrPlanRetired
+	}
+
+	now := time.Now()
+	p.RetiredAt = &now
+	p.UpdatedAt = now
+
+	return nil
+}
+
+// IsRetired checks if the plan is retired
+func (p *Plan) IsRetired() bool {
+	return p.RetiredAt != nil
+}
+
+// DefaultFeatures returns a copy of the plan's features for a tenant
+func (p *Plan) DefaultFeatures() map[string]interface{} {
+	features := make(map[string]interface{}, len(p.Features))
+	for k, v := range p.Features {
+		features[k] = v
+	}
+	return features
+}
+
+// AddEntitlement adds a named usage limit to the plan
+func (p *Plan) AddEntitlement(limit *EntitlementLimit) error {
+	if p.Entitlement(limit.Name) != nil {
+		return ErrDuplicateEntitlement
+	}
+	p.Entitlements = append(p.Entitlements, limit)
+	return nil
+}
+
+// Entitlement returns the plan's limit of an entitlement, or nil
+func (p *Plan) Entitlement(name string) *EntitlementLimit {
+	for _, l := range p.Entitlements {
+		if l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// checkAssignable verifies that tenants can be put on the plan
+func (p *Plan) checkAssignable() error {
+	if p == nil {
+		return ErrInvalidPlanTier
+	}
+	if p.IsRetired() {
+		return ErrPlanRetired
+	}
+	return nil
+}
+
+// PlanChange records one change of a tenant's subscription plan and quotas
+type PlanChange struct {
+	ID               uuid.UUID
+	TenantID         uuid.UUID
+	FromPlan         PlanTier
+	ToPlan           PlanTier
+	FromMaxUsers     int
+	ToMaxUsers       int
+	FromMaxStorageGB int
+	ToMaxStorageGB   int
+	ActorType        ActorType
+	ActorID          string
+	ActorName        string
+	ChangedAt        time.Time
+}
+
+// IsDowngrade reports whether the change lowers any quota
+func (c *PlanChange) IsDowngrade() bool {
+	return c.ToMaxUsers < c.FromMaxUsers || c.ToMaxStorageGB < c.FromMaxStorageGB
+}
+
+// TenantUsage is the measured resource consumption of a tenant
+type TenantUsage struct {
+	ActiveUsers int
+	// StorageBytes is the schema size at the latest storage snapshot, 0 for
+	// a tenant never measured
+	StorageBytes int64
+}
+
+// PlanLimitError reports an effective quota below the tenant's current usage.
+// It matches ErrPlanLimitExceeded with errors.Is.
+type PlanLimitError struct {
+	Plan     PlanTier
+	Resource string
+	Limit    int
+	Usage    int
+}
+
+// Error implements error
+func (e *PlanLimitError) Error() string {
+	return fmt.Sprintf("quota of %d %s on plan %s is below current usage of %d", e.Limit, e.Resource, e.Plan, e.Usage)
+}
+
+// Unwrap returns ErrPlanLimitExceeded
+func (e *PlanLimitError) Unwrap() error {
+	return ErrPlanLimitExceeded
+}
+
+// PendingPlanChanges returns the plan changes not yet persisted
+func (t *Tenant) PendingPlanChanges() []*PlanChange {
+	return t.planChanges
+}
+
+// ClearPlanChanges marks the pending plan changes as persisted
+func (t *Tenant) ClearPlanChanges() {
+	t.planChanges = nil
+}
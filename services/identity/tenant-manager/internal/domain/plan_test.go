@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPlans mirrors the plans seeded into the catalog
+var testPlans = map[PlanTier]*Plan{
+	PlanFree:         {Tier: PlanFree, DisplayName: "Free", MaxUsers: 5, MaxStorageGB: 5},
+	PlanBasic:        {Tier: PlanBasic, DisplayName: "Basic", MaxUsers: 20, MaxStorageGB: 50},
+	PlanProfessional: {Tier: PlanProfessional, DisplayName: "Professional", MaxUsers: 100, MaxStorageGB: 500},
+	PlanEnterprise:   {Tier: PlanEnterprise, DisplayName: "Enterprise", MaxUsers: 1000, MaxStorageGB: 5000},
+}
+
+func TestNewPlan(t *testing.T) {
+	plan, err := NewPlan("team-2026", "Team", "", 50, 200, map[string]interface{}{"sso": true}, 25)
+	require.NoError(t, err)
+	assert.Equal(t, PlanTier("team-2026"), plan.Tier)
+	assert.False(t, plan.IsRetired())
+
+	_, err = NewPlan("Team 2026", "Team", "", 50, 200, nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidPlanTier)
+
+	_, err = NewPlan("team", " ", "", 50, 200, nil, 0)
+	assert.ErrorIs(t, err, ErrEmptyPlanName)
+
+	_, err = NewPlan("team", "Team", "", 0, 200, nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidPlanQuota)
+}
+
+func TestPlan_Retire(t *testing.T) {
+	plan, err := NewPlan("legacy", "Legacy", "", 10, 10, map[string]interface{}{"sso": true}, 0)
+	require.NoError(t, err)
+
+	tenant, err := NewTenant("Test Company", "test-company", plan, "admin@test.com")
+	require.NoError(t, err)
+	assert.Equal(t, true, tenant.Features["sso"])
+
+	require.NoError(t, plan.Retire())
+	assert.True(t, plan.IsRetired())
+	assert.ErrorIs(t, plan.Retire(), ErrPlanRetired)
+
+	// Retired plans can no longer be assigned
+	_, err = NewTenant("Other Company", "other-company", plan, "admin@test.com")
+	assert.ErrorIs(t, err, ErrPlanRetired)
+
+	other, _ := NewTenant("Other Company", "other-company", testPlans[PlanFree], "admin@test.com")
+	assert.ErrorIs(t, other.ChangePlan(plan), ErrPlanRetired)
+}
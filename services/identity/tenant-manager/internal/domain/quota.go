@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuotaName identifies a tenant quota
+type QuotaName string
+
+const (
+	QuotaMaxUsers     QuotaName = "max_users"
+	QuotaMaxStorageGB QuotaName = "max_storage_gb"
+)
+
+// QuotaNames lists every quota in display order
+var QuotaNames = []QuotaName{QuotaMaxUsers, QuotaMaxStorageGB}
+
+// IsValid checks if the quota name is known
+func (n QuotaName) IsValid() bool {
+	for _, known := range QuotaNames {
+		if n == known {
+			return true
+		}
+	}
+	return false
+}
+
+// QuotaOverride replaces a plan default quota for one tenant, typically as
+// agreed in a contract. Overrides survive plan changes.
+type QuotaOverride struct {
+	TenantID uuid.UUID
+	Name     QuotaName
+	Value    int
+	Reason   string
+
+	// ExpiresAt is when the plan default applies again; nil for no expiry
+	ExpiresAt *time.Time
+
+	ActorType ActorType
+	ActorID   string
+	ActorName string
+	CreatedAt time.Time
+}
+
+// IsActive checks if the override is in force at the given time
+func (o *QuotaOverride) IsActive(now time.Time) bool {
+	return o.ExpiresAt == nil || now.Before(*o.ExpiresAt)
+}
+
+// EffectiveQuota is a quota's default and the value in force
+type EffectiveQuota struct {
+	Name QuotaName
+	// Default is the plan quota, or the parent's effective quota when Inherited
+	Default   int
+	Inherited bool
+	Effective int
+	// Override is the tenant's override, if any, even when it has expired
+	Override *QuotaOverride
+}
+
+// QuotaOverride returns the tenant's override of a quota, or nil
+func (t *Tenant) QuotaOverride(name QuotaName) *QuotaOverride {
+	for _, o := range t.QuotaOverrides {
+		if o.Name == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// EffectiveQuota computes the value of a quota in force at the given time
+func (t *Tenant) EffectiveQuota(name QuotaName, now time.Time) EffectiveQuota {
+	quota := EffectiveQuota{Name: name, Default: t.planQuota(name)}
+	if inherited, ok := t.inheritedQuotas[name]; ok {
+		quota.Default = inherited
+		quota.Inherited = true
+	}
+	quota.Effective = quota.Default
+
+	if o := t.QuotaOverride(name); o != nil {
+		quota.Override = o
+		if o.IsActive(now) {
+			quota.Effective = o.Value
+		}
+	}
+
+	return quota
+}
+
+// EffectiveQuotas computes every quota in force at the given time
+func (t *Tenant) EffectiveQuotas(now time.Time) []EffectiveQuota {
+	quotas := make([]EffectiveQuota, 0, len(QuotaNames))
+	for _, name := range QuotaNames {
+		quotas = append(quotas, t.EffectiveQuota(name, now))
+	}
+	return quotas
+}
+
+// SetQuotaOverride overrides a plan default quota, replacing any earlier
+// override of the same quota
+func (t *Tenant) SetQuotaOverride(name QuotaName, value int, reason string, expiresAt *time.Time) error {
+	if !name.IsValid() {
+		return ErrInvalidQuotaName
+	}
+	if value <= 0 {
+		return ErrInvalidQuotaValue
+	}
+	if strings.TrimSpace(reason) == "" {
+		return ErrEmptyQuotaReason
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return ErrInvalidQuotaExpiry
+	}
+
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+
+	override := &QuotaOverride{
+		TenantID:  t.TenantID,
+		Name:      name,
+		Value:     value,
+		Reason:    reason,
+		ExpiresAt: expiresAt,
+		ActorType: ActorSystem,
+		CreatedAt: now,
+	}
+
+	t.removeQuotaOverride(name)
+	t.QuotaOverrides = append(t.QuotaOverrides, override)
+	t.UpdatedAt = now
+	t.markQuotaChanged(name)
+
+	return nil
+}
+
+// RemoveQuotaOverride drops the override of a quota, so the plan default applies again
+func (t *Tenant) RemoveQuotaOverride(name QuotaName) error {
+	if !name.IsValid() {
+		return ErrInvalidQuotaName
+	}
+
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+
+	if !t.removeQuotaOverride(name) {
+		return ErrQuotaOverrideNotFound
+	}
+
+	t.UpdatedAt = time.Now()
+	t.markQuotaChanged(name)
+
+	return nil
+}
+
+// CheckUsageFits verifies that the tenant's usage fits within its effective
+// quotas. Storage is reported in GB, rounded up.
+func (t *Tenant) CheckUsageFits(usage *TenantUsage) error {
+	now := time.Now()
+
+	quota := t.EffectiveQuota(QuotaMaxUsers, now)
+	if usage.ActiveUsers > quota.Effective {
+		return &PlanLimitError{Plan: t.PlanTier, Resource: "active users", Limit: quota.Effective, Usage: usage.ActiveUsers}
+	}
+
+	quota = t.EffectiveQuota(QuotaMaxStorageGB, now)
+	if usage.StorageBytes > int64(quota.Effective)*BytesPerGB {
+		usedGB := int((usage.StorageBytes + BytesPerGB - 1) / BytesPerGB)
+		return &PlanLimitError{Plan: t.PlanTier, Resource: "storage GB", Limit: quota.Effective, Usage: usedGB}
+	}
+
+	return nil
+}
+
+// PendingQuotaChanges returns the quotas whose override changed since the
+// tenant was loaded
+func (t *Tenant) PendingQuotaChanges() []QuotaName {
+	return t.quotaChanges
+}
+
+// ClearQuotaChanges marks the pending quota override changes as persisted
+func (t *Tenant) ClearQuotaChanges() {
+	t.quotaChanges = nil
+}
+
+// planQuota returns the plan default of a quota
+func (t *Tenant) planQuota(name QuotaName) int {
+	switch name {
+	case QuotaMaxUsers:
+		return t.MaxUsers
+	case QuotaMaxStorageGB:
+		return t.MaxStorageGB
+	default:
+		return 0
+	}
+}
+
+// removeQuotaOverride drops the override of a quota, reporting whether there was one
+func (t *Tenant) removeQuotaOverride(name QuotaName) bool {
+	for i, o := range t.QuotaOverrides {
+		if o.Name == name {
+			t.QuotaOverrides = append(t.QuotaOverrides[:i], t.QuotaOverrides[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// markQuotaChanged records a quota whose override must be persisted
+func (t *Tenant) markQuotaChanged(name QuotaName) {
+	for _, n := range t.quotaChanges {
+		if n == name {
+			return
+		}
+	}
+	t.quotaChanges = append(t.quotaChanges, name)
+}
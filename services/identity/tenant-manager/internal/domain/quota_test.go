@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_QuotaOverrides(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanEnterprise], "admin@test.com")
+	now := time.Now()
+
+	quota := tenant.EffectiveQuota(QuotaMaxUsers, now)
+	assert.Equal(t, 1000, quota.Default)
+	assert.Equal(t, 1000, quota.Effective)
+	assert.Nil(t, quota.Override)
+
+	// Override on top of the plan default
+	require.NoError(t, tenant.SetQuotaOverride(QuotaMaxUsers, 1500, "contract 2026-014", nil))
+	quota = tenant.EffectiveQuota(QuotaMaxUsers, now)
+	assert.Equal(t, 1000, quota.Default)
+	assert.Equal(t, 1500, quota.Effective)
+	assert.Equal(t, []QuotaName{QuotaMaxUsers}, tenant.PendingQuotaChanges())
+
+	// The override survives a plan change
+	require.NoError(t, tenant.ChangePlan(testPlans[PlanProfessional]))
+	quota = tenant.EffectiveQuota(QuotaMaxUsers, now)
+	assert.Equal(t, 100, quota.Default)
+	assert.Equal(t, 1500, quota.Effective)
+
+	// An expired override no longer applies
+	expiresAt := now.Add(time.Hour)
+	require.NoError(t, tenant.SetQuotaOverride(QuotaMaxStorageGB, 800, "pilot", &expiresAt))
+	assert.Equal(t, 800, tenant.EffectiveQuota(QuotaMaxStorageGB, now).Effective)
+	assert.Equal(t, 500, tenant.EffectiveQuota(QuotaMaxStorageGB, now.Add(2*time.Hour)).Effective)
+
+	// Remove
+	require.NoError(t, tenant.RemoveQuotaOverride(QuotaMaxUsers))
+	assert.Equal(t, 100, tenant.EffectiveQuota(QuotaMaxUsers, now).Effective)
+	assert.ErrorIs(t, tenant.RemoveQuotaOverride(QuotaMaxUsers), ErrQuotaOverrideNotFound)
+	assert.ElementsMatch(t, []QuotaName{QuotaMaxUsers, QuotaMaxStorageGB}, tenant.PendingQuotaChanges())
+}
+
+func TestTenant_SetQuotaOverrideValidation(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanBasic], "admin@test.com")
+	past := time.Now().Add(-time.Minute)
+
+	assert.ErrorIs(t, tenant.SetQuotaOverride("max_widgets", 10, "contract", nil), ErrInvalidQuotaName)
+	assert.ErrorIs(t, tenant.SetQuotaOverride(QuotaMaxUsers, 0, "contract", nil), ErrInvalidQuotaValue)
+	assert.ErrorIs(t, tenant.SetQuotaOverride(QuotaMaxUsers, 10, " ", nil), ErrEmptyQuotaReason)
+	assert.ErrorIs(t, tenant.SetQuotaOverride(QuotaMaxUsers, 10, "contract", &past), ErrInvalidQuotaExpiry)
+	assert.Empty(t, tenant.PendingQuotaChanges())
+}
+
+func TestTenant_CheckUsageFits(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanFree], "admin@test.com")
+
+	assert.NoError(t, tenant.CheckUsageFits(&TenantUsage{ActiveUsers: 5}))
+
+	err := tenant.CheckUsageFits(&TenantUsage{ActiveUsers: 6})
+	assert.ErrorIs(t, err, ErrPlanLimitExceeded)
+
+	var limitErr *PlanLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, 5, limitErr.Limit)
+	assert.Equal(t, 6, limitErr.Usage)
+
+	// An override raises the limit
+	require.NoError(t, tenant.SetQuotaOverride(QuotaMaxUsers, 8, "contract", nil))
+	assert.NoError(t, tenant.CheckUsageFits(&TenantUsage{ActiveUsers: 6}))
+
+	// The free plan stores 5 GB
+	assert.NoError(t, tenant.CheckUsageFits(&TenantUsage{StorageBytes: 5 * BytesPerGB}))
+	err = tenant.CheckUsageFits(&TenantUsage{StorageBytes: 5*BytesPerGB + 1})
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "storage GB", limitErr.Resource)
+	assert.Equal(t, 5, limitErr.Limit)
+	assert.Equal(t, 6, limitErr.Usage)
+}
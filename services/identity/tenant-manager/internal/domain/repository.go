@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,29 +12,327 @@ type TenantRepository interface {
 	// Create creates a new tenant
 	Create(ctx context.Context, tenant *Tenant) error
 
-	// GetByID retrieves a tenant by ID
+	// GetByID retrieves a tenant by ID. The Get and List methods load the
+	// tenant's quota overrides and, when it inherits quotas, its parent's
+	// effective quotas.
 	GetByID(ctx context.Context, id uuid.UUID) (*Tenant, error)
 
 	// GetByTenantID retrieves a tenant by tenant_id
 	GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*Tenant, error)
 
-	// GetBySlug retrieves a tenant by slug
+	// GetByTenantIDForUpdate retrieves a tenant by tenant_id and locks its
+	// row until the enclosing transaction ends
+	GetByTenantIDForUpdate(ctx context.Context, tenantID uuid.UUID) (*Tenant, error)
+
+	// GetBySlug retrieves a tenant by its slug or an unreleased alias. The
+	// returned tenant carries its canonical slug.
 	GetBySlug(ctx context.Context, slug string) (*Tenant, error)
 
 	// List retrieves all tenants with pagination
 	List(ctx context.Context, filter ListFilter) ([]*Tenant, int, error)
 
-	// Update updates an existing tenant
+	// Update updates an existing tenant. Create and Update also append the
+	// tenant's pending status transitions and plan changes to their
+	// histories and write its changed quota overrides, and Update writes
+	// its former slugs as aliases, so call them within a transaction when
+	// any of these changed.
 	Update(ctx context.Context, tenant *Tenant) error
 
 	// Delete soft-deletes a tenant
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// ExistsBySlug checks if a tenant with the given slug exists
+	// ExistsBySlug checks if a tenant with the given canonical slug exists
 	ExistsBySlug(ctx context.Context, slug string) (bool, error)
 
 	// CountByStatus counts tenants by status
 	CountByStatus(ctx context.Context, status TenantStatus) (int, error)
+
+	// ListByStatus retrieves every tenant in one of the given statuses,
+	// oldest first
+	ListByStatus(ctx context.Context, statuses ...TenantStatus) ([]*Tenant, error)
+
+	// ListPurgeable retrieves up to limit deleted, unpurged tenants whose
+	// deletion is older than deletedBefore, oldest first
+	ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]*Tenant, error)
+
+	// LockDeletion serializes purging and restoring a deleted tenant until
+	// the enclosing transaction ends
+	LockDeletion(ctx context.Context, tenantID uuid.UUID) error
+
+	// ListTrialsEndingBefore retrieves up to limit tenants, not deleted, whose
+	// trial is still running and ends before endsBefore, soonest first
+	ListTrialsEndingBefore(ctx context.Context, endsBefore time.Time, limit int) ([]*Tenant, error)
+
+	// UpdateTrialReminder writes only the reminder timestamp of the tenant's
+	// trial, leaving the rest of the row as it is now. It fails with
+	// ErrNoActiveTrial once that trial has ended.
+	UpdateTrialReminder(ctx context.Context, tenant *Tenant) error
+
+	// ListStatusHistory retrieves the status transitions of a tenant, oldest first
+	ListStatusHistory(ctx context.Context, tenantID uuid.UUID) ([]*StatusTransition, error)
+
+	// ListPlanHistory retrieves the plan changes of a tenant, oldest first
+	ListPlanHistory(ctx context.Context, tenantID uuid.UUID) ([]*PlanChange, error)
+
+	// GetSlugAlias retrieves a slug alias, released or not
+	GetSlugAlias(ctx context.Context, slug string) (*SlugAlias, error)
+
+	// ListSlugAliases retrieves the slug aliases of a tenant, released or
+	// not, oldest first
+	ListSlugAliases(ctx context.Context, tenantID uuid.UUID) ([]*SlugAlias, error)
+
+	// UpdateSlugAlias writes the release of a slug alias
+	UpdateSlugAlias(ctx context.Context, alias *SlugAlias) error
+
+	// ListAncestors retrieves the ancestors of a tenant, root first
+	ListAncestors(ctx context.Context, tenantID uuid.UUID) ([]*Tenant, error)
+
+	// ListDescendants retrieves the descendants of a tenant, ordered by
+	// depth, then by name
+	ListDescendants(ctx context.Context, tenantID uuid.UUID) ([]*Tenant, error)
+
+	// LockHierarchy serializes changes to the tenant hierarchy until the
+	// enclosing transaction ends
+	LockHierarchy(ctx context.Context) error
+}
+
+// PlanRepository defines the interface for plan catalog persistence
+type PlanRepository interface {
+	// Create adds a plan to the catalog
+	Create(ctx context.Context, plan *Plan) error
+
+	// GetByTier retrieves a plan, retired or not
+	GetByTier(ctx context.Context, tier PlanTier) (*Plan, error)
+
+	// List retrieves every plan, retired or not, in display order
+	List(ctx context.Context) ([]*Plan, error)
+
+	// Update updates an existing plan
+	Update(ctx context.Context, plan *Plan) error
+}
+
+// FeatureFlagRepository defines the interface for the feature flag registry
+type FeatureFlagRepository interface {
+	// List retrieves every registered flag, by key
+	List(ctx context.Context) ([]*FeatureFlag, error)
+
+	// GetByKey retrieves a registered flag
+	GetByKey(ctx context.Context, key string) (*FeatureFlag, error)
+
+	// Save registers a flag, or replaces the registered flag with its key
+	Save(ctx context.Context, flag *FeatureFlag) error
+}
+
+// UsageRepository defines the interface for reading tenant resource usage
+type UsageRepository interface {
+	// GetUsage measures the current usage of a tenant
+	GetUsage(ctx context.Context, tenantID uuid.UUID) (*TenantUsage, error)
+}
+
+// StorageRepository defines the interface for tenant storage snapshots
+type StorageRepository interface {
+	// Record stores a storage snapshot
+	Record(ctx context.Context, snapshot *StorageSnapshot) error
+
+	// GetLatest retrieves the most recent storage snapshot of a tenant
+	GetLatest(ctx context.Context, tenantID uuid.UUID) (*StorageSnapshot, error)
+
+	// List retrieves up to limit storage snapshots of a tenant taken within
+	// the time range, newest first
+	List(ctx context.Context, tenantID uuid.UUID, from, to time.Time, limit int) ([]*StorageSnapshot, error)
+
+	// DeleteBefore removes the snapshots taken before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// EntitlementRepository defines the interface for tenant entitlement limits
+// and usage counters
+type EntitlementRepository interface {
+	// ListTenantLimits retrieves the entitlement limits set for a tenant
+	ListTenantLimits(ctx context.Context, tenantID uuid.UUID) ([]*EntitlementLimit, error)
+
+	// SetTenantLimit creates or replaces an entitlement limit of a tenant
+	SetTenantLimit(ctx context.Context, tenantID uuid.UUID, limit *EntitlementLimit) error
+
+	// DeleteTenantLimit removes an entitlement limit of a tenant
+	DeleteTenantLimit(ctx context.Context, tenantID uuid.UUID, name string) error
+
+	// LockUsage serializes changes to a tenant's usage of an entitlement until
+	// the enclosing transaction ends
+	LockUsage(ctx context.Context, tenantID uuid.UUID, name string) error
+
+	// SumUsage adds up a tenant's usage of an entitlement counted in buckets
+	// starting at or after since
+	SumUsage(ctx context.Context, tenantID uuid.UUID, name string, since time.Time) (int64, error)
+
+	// ListUsage retrieves a tenant's usage buckets of an entitlement starting
+	// at or after since, newest first
+	ListUsage(ctx context.Context, tenantID uuid.UUID, name string, since time.Time) ([]UsageBucket, error)
+
+	// AddUsage adds delta, which may be negative, to the usage bucket starting at bucket
+	AddUsage(ctx context.Context, tenantID uuid.UUID, name string, bucket time.Time, delta int64) error
+}
+
+// ServiceAccountRepository defines the interface for service account and API key persistence
+type ServiceAccountRepository interface {
+	// Create creates a new service account
+	Create(ctx context.Context, account *ServiceAccount) error
+
+	// GetByID retrieves a service account by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ServiceAccount, error)
+
+	// List retrieves all service accounts
+	List(ctx context.Context) ([]*ServiceAccount, error)
+
+	// Update updates an existing service account
+	Update(ctx context.Context, account *ServiceAccount) error
+
+	// CreateAPIKey stores a new API key
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+
+	// GetAPIKey retrieves an API key of a service account
+	GetAPIKey(ctx context.Context, serviceAccountID, keyID uuid.UUID) (*APIKey, error)
+
+	// GetAPIKeyByPrefix retrieves an API key by its lookup prefix
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+
+	// ListAPIKeys retrieves the API keys of a service account
+	ListAPIKeys(ctx context.Context, serviceAccountID uuid.UUID) ([]*APIKey, error)
+
+	// UpdateAPIKey updates revocation of an API key
+	UpdateAPIKey(ctx context.Context, key *APIKey) error
+
+	// TouchAPIKey records the last time an API key was used
+	TouchAPIKey(ctx context.Context, keyID uuid.UUID, usedAt time.Time) error
+}
+
+// ArchiveRepository defines the interface for tenant archive records
+type ArchiveRepository interface {
+	// Create stores a new archive record
+	Create(ctx context.Context, archive *TenantArchive) error
+
+	// GetLatest retrieves the most recent archive of a tenant
+	GetLatest(ctx context.Context, tenantID uuid.UUID) (*TenantArchive, error)
+
+	// ListByTenant retrieves every archive of a tenant, newest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*TenantArchive, error)
+
+	// Update updates an existing archive record
+	Update(ctx context.Context, archive *TenantArchive) error
+}
+
+// CustomDomainRepository defines the interface for tenant custom domains
+type CustomDomainRepository interface {
+	// Create registers a custom domain. Create and Update fail with
+	// ErrDomainAlreadyExists when the tenant has the hostname unrevoked, or
+	// another tenant has it verified.
+	Create(ctx context.Context, domain *CustomDomain) error
+
+	// GetByID retrieves a custom domain
+	GetByID(ctx context.Context, id uuid.UUID) (*CustomDomain, error)
+
+	// GetVerified retrieves the verified domain of a hostname
+	GetVerified(ctx context.Context, hostname string) (*CustomDomain, error)
+
+	// ListByTenant retrieves every domain of a tenant, revoked or not, newest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*CustomDomain, error)
+
+	// ListDue retrieves up to limit pending or verified domains not checked
+	// since checkedBefore, least recently checked first
+	ListDue(ctx context.Context, checkedBefore time.Time, limit int) ([]*CustomDomain, error)
+
+	// Update updates an existing custom domain; readStatus is the status it
+	// was read with. It fails with ErrDomainChanged when the stored status
+	// is no longer readStatus, such as a domain revoked during a DNS check.
+	Update(ctx context.Context, domain *CustomDomain, readStatus DomainStatus) error
+}
+
+// ScheduledOperationRepository defines the interface for scheduled lifecycle
+// operations
+type ScheduledOperationRepository interface {
+	// Create schedules an operation
+	Create(ctx context.Context, op *ScheduledOperation) error
+
+	// GetByID retrieves a scheduled operation
+	GetByID(ctx context.Context, id uuid.UUID) (*ScheduledOperation, error)
+
+	// ListByTenant retrieves the operations of a tenant, optionally of one
+	// status, by run time, latest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID, status OperationStatus) ([]*ScheduledOperation, error)
+
+	// ListDue retrieves up to limit pending operations whose run time is not
+	// after now, earliest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*ScheduledOperation, error)
+
+	// Update saves a pending operation that completed, failed or was
+	// canceled. It fails with ErrOperationNotPending when the stored
+	// operation is no longer pending, so that a cancellation and a run racing
+	// each other cannot both win.
+	Update(ctx context.Context, op *ScheduledOperation) error
+}
+
+// MemberRepository defines the interface for tenant memberships
+type MemberRepository interface {
+	// Create adds a member. It fails with ErrMemberAlreadyExists when the
+	// user has a membership of the tenant, active or not.
+	Create(ctx context.Context, member *Member) error
+
+	// Get retrieves the membership of a user in a tenant, active or not
+	Get(ctx context.Context, tenantID, userID uuid.UUID) (*Member, error)
+
+	// ListByTenant retrieves the active members of a tenant, oldest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*Member, error)
+
+	// ListByUser retrieves the active memberships of a user, primary first
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Member, error)
+
+	// CountActive counts the active members of a tenant, optionally of one role
+	CountActive(ctx context.Context, tenantID uuid.UUID, role MemberRole) (int, error)
+
+	// Lock serializes changes to a tenant's members until the enclosing
+	// transaction ends
+	Lock(ctx context.Context, tenantID uuid.UUID) error
+
+	// LockUser serializes changes to a user's memberships, across tenants,
+	// until the enclosing transaction ends. Take it after Lock.
+	LockUser(ctx context.Context, userID uuid.UUID) error
+
+	// Update updates an existing membership
+	Update(ctx context.Context, member *Member) error
+}
+
+// InvitationRepository defines the interface for tenant invitations
+type InvitationRepository interface {
+	// Create stores an invitation. It fails with ErrInvitationAlreadyExists
+	// when the email has a pending invitation to the tenant, expired or not.
+	Create(ctx context.Context, inv *Invitation) error
+
+	// GetByID retrieves an invitation
+	GetByID(ctx context.Context, id uuid.UUID) (*Invitation, error)
+
+	// ListByTenant retrieves the invitations of a tenant, optionally of one
+	// stored status, newest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID, status InvitationStatus) ([]*Invitation, error)
+
+	// Update saves a pending invitation that was resent, revoked or
+	// accepted; readHash is the token hash it was read with. It fails with
+	// ErrInvitationNotPending when the stored invitation is no longer
+	// pending, or was resent under another token since, so that a token is
+	// accepted once and never after it was replaced.
+	Update(ctx context.Context, inv *Invitation, readHash string) error
+
+	// DeleteByTenant removes every invitation of a tenant, whatever its
+	// status, and returns how many were removed
+	DeleteByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error)
+}
+
+// AuditRepository defines the interface for audit log persistence
+type AuditRepository interface {
+	// Record appends an audit event
+	Record(ctx context.Context, event *AuditEvent) error
+
+	// List retrieves audit events matching the filter, newest first
+	List(ctx context.Context, filter AuditFilter) ([]*AuditEvent, int, error)
 }
 
 // ListFilter defines filters for listing tenants
@@ -43,6 +342,10 @@ type ListFilter struct {
 	Status   TenantStatus
 	PlanTier PlanTier
 	Search   string
+	// SuspensionReason restricts the list to tenants suspended for a reason
+	SuspensionReason SuspensionReason
+	// AncestorID restricts the list to the descendants of a tenant
+	AncestorID *uuid.UUID
 }
 
 // Offset calculates the offset for pagination
@@ -63,3 +366,24 @@ func (f ListFilter) Limit() int {
 	}
 	return f.PerPage
 }
+
+// AuditFilter defines filters for listing audit events
+type AuditFilter struct {
+	Page     int
+	PerPage  int
+	TenantID *uuid.UUID
+	ActorID  string
+	Action   AuditAction
+	From     *time.Time
+	To       *time.Time
+}
+
+// Offset calculates the offset for pagination
+func (f AuditFilter) Offset() int {
+	return ListFilter{Page: f.Page, PerPage: f.PerPage}.Offset()
+}
+
+// Limit returns the page size
+func (f AuditFilter) Limit() int {
+	return ListFilter{Page: f.Page, PerPage: f.PerPage}.Limit()
+}
@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationStatus represents the state of a scheduled operation
+type OperationStatus string
+
+const (
+	// OperationPending waits for its run time
+	OperationPending OperationStatus = "pending"
+	// OperationCompleted ran successfully
+	OperationCompleted OperationStatus = "completed"
+	// OperationFailed could not be applied when it ran, such as a suspension
+	// of a tenant that was deleted in the meantime
+	OperationFailed OperationStatus = "failed"
+	// OperationCanceled was canceled before it ran
+	OperationCanceled OperationStatus = "canceled"
+)
+
+// IsValid checks if the operation status is known
+func (s OperationStatus) IsValid() bool {
+	switch s {
+	case OperationPending, OperationCompleted, OperationFailed, OperationCanceled:
+		return true
+	}
+	return false
+}
+
+// MaxOperationLeadTime is how far ahead an operation can be scheduled, and
+// how long a scheduled suspension can last
+const MaxOperationLeadTime = 365 * 24 * time.Hour
+
+// ScheduledReactivationNotes are the notes of the reactivation that ends a
+// time-boxed suspension
+const ScheduledReactivationNotes = "scheduled reactivation"
+
+// schedulableActions are the lifecycle actions that can be scheduled
+var schedulableActions = map[LifecycleAction]bool{
+	ActionSuspend:  true,
+	ActionActivate: true,
+}
+
+// ScheduledOperation is a lifecycle action to apply to a tenant at a later
+// time. Whether the action is legal is only known when it runs.
+type ScheduledOperation struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Action   LifecycleAction
+	// SuspensionReason is why a scheduled suspension suspends the tenant
+	SuspensionReason SuspensionReason
+	Notes            string
+	RunAt            time.Time
+
+	// ReactivateAfter, on a suspension, schedules the tenant's reactivation
+	// that long after it was suspended
+	ReactivateAfter time.Duration
+	// FollowsID is the suspension that scheduled a reactivation
+	FollowsID *uuid.UUID
+
+	Status OperationStatus
+	// Error explains why a failed operation could not be applied
+	Error string
+
+	CreatedBy  *uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ExecutedAt *time.Time
+	CanceledAt *time.Time
+}
+
+// NewScheduledOperation schedules a lifecycle action on a tenant at runAt.
+// Only suspensions, which need a suspension reason, and activations can be
+// scheduled. A restricted suspension cannot be time-boxed: the scheduled
+// reactivation would run without the authority that lifts it.
+func NewScheduledOperation(tenantID uuid.UUID, action LifecycleAction, reason SuspensionReason, notes string, runAt time.Time, reactivateAfter time.Duration, now time.Time) (*ScheduledOperation, error) {
+	if !schedulableActions[action] {
+		return nil, ErrInvalidOperationAction
+	}
+
+	if !runAt.After(now) || runAt.Sub(now) > MaxOperationLeadTime {
+		return nil, ErrInvalidOperationTime
+	}
+
+	if action == ActionSuspend && !reason.IsValid() {
+		return nil, ErrInvalidSuspensionReason
+	}
+	if action != ActionSuspend {
+		reason = ""
+	}
+
+	if reactivateAfter < 0 || reactivateAfter > MaxOperationLeadTime ||
+		(reactivateAfter > 0 && action != ActionSuspend) {
+		return nil, ErrInvalidReactivateAfter
+	}
+	if reactivateAfter > 0 && reason.IsRestricted() {
+		return nil, ErrRestrictedReactivation
+	}
+
+	return &ScheduledOperation{
+		ID:               uuid.New(),
+		TenantID:         tenantID,
+		Action:           action,
+		SuspensionReason: reason,
+		Notes:            notes,
+		RunAt:            runAt,
+		ReactivateAfter:  reactivateAfter,
+		Status:           OperationPending,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
+}
+
+// IsPending checks if the operation has not run or been canceled yet
+func (o *ScheduledOperation) IsPending() bool {
+	return o.Status == OperationPending
+}
+
+// IsDue checks if a pending operation has reached its run time
+func (o *ScheduledOperation) IsDue(now time.Time) bool {
+	return o.IsPending() && !now.Before(o.RunAt)
+}
+
+// Complete records that the operation ran successfully
+func (o *ScheduledOperation) Complete(now time.Time) error {
+	if !o.IsPending() {
+		return ErrOperationNotPending
+	}
+
+	o.Status = OperationCompleted
+	o.ExecutedAt = &now
+	o.UpdatedAt = now
+
+	return nil
+}
+
+// Fail records that the operation could not be applied and why
+func (o *ScheduledOperation) Fail(cause error, now time.Time) error {
+	if !o.IsPending() {
+		return ErrOperationNotPending
+	}
+
+	o.Status = OperationFailed
+	o.Error = cause.Error()
+	o.ExecutedAt = &now
+	o.UpdatedAt = now
+
+	return nil
+}
+
+// Cancel withdraws a pending operation
+func (o *ScheduledOperation) Cancel(now time.Time) error {
+	if !o.IsPending() {
+		return ErrOperationNotPending
+	}
+
+	o.Status = OperationCanceled
+	o.CanceledAt = &now
+	o.UpdatedAt = now
+
+	return nil
+}
+
+// FollowUp returns the reactivation a completed time-boxed suspension
+// schedules, or nil
+func (o *ScheduledOperation) FollowUp() *ScheduledOperation {
+	if o.Status != OperationCompleted || o.Action != ActionSuspend || o.ReactivateAfter <= 0 {
+		return nil
+	}
+
+	at := *o.ExecutedAt
+	follows := o.ID
+	return &ScheduledOperation{
+		ID:        uuid.New(),
+		TenantID:  o.TenantID,
+		Action:    ActionActivate,
+		Notes:     ScheduledReactivationNotes,
+		RunAt:     at.Add(o.ReactivateAfter),
+		FollowsID: &follows,
+		Status:    OperationPending,
+		CreatedBy: o.CreatedBy,
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+}
+
+// StillSuspends checks that the tenant is still under the suspension the
+// completed operation applied, so that its scheduled reactivation lifts that
+// suspension and no other: the tenant is suspended, for the same reason,
+// since no later than the operation ran. Otherwise it returns
+// ErrSuspensionSuperseded.
+func (o *ScheduledOperation) StillSuspends(t *Tenant) error {
+	if o.Action != ActionSuspend || o.ExecutedAt == nil ||
+		!t.IsSuspended() || t.SuspendedAt == nil ||
+		t.SuspensionReason != o.SuspensionReason ||
+		t.SuspendedAt.After(*o.ExecutedAt) {
+		return ErrSuspensionSuperseded
+	}
+	return nil
+}
+
+// OperationFailure returns the cause that makes an operation fail for good
+// when err is returned while applying it: an illegal transition, a
+// suspension the worker may not lift, a reactivation whose suspension was
+// superseded, a suspension less restrictive than the one in force, a
+// missing tenant or an action that cannot be scheduled. Any other error,
+// such as a lost database connection, yields nil and the operation is
+// retried.
+func OperationFailure(err error) error {
+	var transitionErr *TransitionError
+	var reinstatementErr *ReinstatementError
+	switch {
+	case errors.As(err, &transitionErr):
+		return transitionErr
+	case errors.As(err, &reinstatementErr):
+		return reinstatementErr
+	case errors.Is(err, ErrSuspensionSuperseded):
+		return ErrSuspensionSuperseded
+	case errors.Is(err, ErrSuspensionDowngrade):
+		return ErrSuspensionDowngrade
+	case errors.Is(err, ErrTenantNotFound):
+		return ErrTenantNotFound
+	case errors.Is(err, ErrInvalidOperationAction):
+		return ErrInvalidOperationAction
+	}
+	return nil
+}
+
+// Snapshot returns the audited state of the operation
+func (o *ScheduledOperation) Snapshot() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return normalize(map[string]interface{}{
+		"action":            o.Action,
+		"suspension_reason": o.SuspensionReason,
+		"notes":             o.Notes,
+		"run_at":            o.RunAt,
+		"reactivate_after":  o.ReactivateAfter.String(),
+		"status":            o.Status,
+		"error":             o.Error,
+	})
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduledOperation(t *testing.T) {
+	now := time.Now()
+	tenantID := uuid.New()
+	later := now.Add(time.Hour)
+
+	_, err := NewScheduledOperation(tenantID, ActionDelete, "", "", later, 0, now)
+	assert.ErrorIs(t, err, ErrInvalidOperationAction)
+
+	_, err = NewScheduledOperation(tenantID, ActionActivate, "", "", now, 0, now)
+	assert.ErrorIs(t, err, ErrInvalidOperationTime)
+	_, err = NewScheduledOperation(tenantID, ActionActivate, "", "", now.Add(MaxOperationLeadTime+time.Hour), 0, now)
+	assert.ErrorIs(t, err, ErrInvalidOperationTime)
+
+	_, err = NewScheduledOperation(tenantID, ActionSuspend, "", "Contract ended", later, 0, now)
+	assert.ErrorIs(t, err, ErrInvalidSuspensionReason)
+
+	// Only a suspension can be time-boxed
+	_, err = NewScheduledOperation(tenantID, ActionActivate, "", "", later, time.Hour, now)
+	assert.ErrorIs(t, err, ErrInvalidReactivateAfter)
+	// nor lifted by the worker when restricted
+	_, err = NewScheduledOperation(tenantID, ActionSuspend, SuspensionLegalHold, "", later, time.Hour, now)
+	assert.ErrorIs(t, err, ErrRestrictedReactivation)
+
+	op, err := NewScheduledOperation(tenantID, ActionSuspend, SuspensionBilling, "Contract ended", later, 24*time.Hour, now)
+	require.NoError(t, err)
+	assert.True(t, op.IsPending())
+	assert.False(t, op.IsDue(now))
+	assert.True(t, op.IsDue(later))
+}
+
+func TestScheduledOperation_OnlyPendingChanges(t *testing.T) {
+	now := time.Now()
+	op, err := NewScheduledOperation(uuid.New(), ActionActivate, "", "", now.Add(time.Hour), 0, now)
+	require.NoError(t, err)
+
+	require.NoError(t, op.Cancel(now))
+	assert.Equal(t, OperationCanceled, op.Status)
+	assert.ErrorIs(t, op.Complete(now), ErrOperationNotPending)
+	assert.ErrorIs(t, op.Fail(errors.New("boom"), now), ErrOperationNotPending)
+	assert.ErrorIs(t, op.Cancel(now), ErrOperationNotPending)
+}
+
+func TestScheduledOperation_FollowUp(t *testing.T) {
+	now := time.Now()
+	op, err := NewScheduledOperation(uuid.New(), ActionSuspend, SuspensionCustomerRequest, "", now.Add(time.Hour), 24*time.Hour, now)
+	require.NoError(t, err)
+	assert.Nil(t, op.FollowUp())
+
+	// The reactivation counts from when the suspension actually ran
+	ranAt := now.Add(2 * time.Hour)
+	require.NoError(t, op.Complete(ranAt))
+	next := op.FollowUp()
+	require.NotNil(t, next)
+	assert.Equal(t, ActionActivate, next.Action)
+	assert.Equal(t, ranAt.Add(24*time.Hour), next.RunAt)
+	assert.Equal(t, op.ID, *next.FollowsID)
+	assert.True(t, next.IsPending())
+	assert.Nil(t, next.FollowUp())
+}
+
+func TestScheduledOperation_StillSuspends(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.CompleteProvisioning())
+
+	op, err := NewScheduledOperation(tenant.TenantID, ActionSuspend, SuspensionCustomerRequest, "", time.Now().Add(time.Hour), 7*24*time.Hour, time.Now())
+	require.NoError(t, err)
+	assert.ErrorIs(t, op.StillSuspends(tenant), ErrSuspensionSuperseded)
+
+	require.NoError(t, tenant.Suspend(SuspensionCustomerRequest, ""))
+	require.NoError(t, op.Complete(time.Now()))
+	assert.NoError(t, op.StillSuspends(tenant))
+
+	// Lifted early, then suspended again for another reason
+	require.NoError(t, tenant.Reinstate())
+	assert.ErrorIs(t, op.StillSuspends(tenant), ErrSuspensionSuperseded)
+	require.NoError(t, tenant.Suspend(SuspensionBilling, ""))
+	assert.ErrorIs(t, op.StillSuspends(tenant), ErrSuspensionSuperseded)
+
+	// or for the same reason, later
+	later := tenant.SuspendedAt.Add(time.Minute)
+	tenant.SuspensionReason = SuspensionCustomerRequest
+	tenant.SuspendedAt = &later
+	assert.ErrorIs(t, op.StillSuspends(tenant), ErrSuspensionSuperseded)
+}
+
+func TestOperationFailure(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.CompleteProvisioning())
+	require.NoError(t, tenant.Delete())
+
+	transitionErr := tenant.Suspend(SuspensionBilling, "Contract ended")
+	require.Error(t, transitionErr)
+	cause := OperationFailure(fmt.Errorf("failed to suspend tenant: %w", transitionErr))
+	assert.True(t, IsTransitionError(cause))
+
+	assert.ErrorIs(t, OperationFailure(fmt.Errorf("failed to get tenant: %w", ErrTenantNotFound)), ErrTenantNotFound)
+	assert.ErrorIs(t, OperationFailure(ErrSuspensionSuperseded), ErrSuspensionSuperseded)
+	assert.ErrorIs(t, OperationFailure(ErrSuspensionDowngrade), ErrSuspensionDowngrade)
+	assert.Nil(t, OperationFailure(errors.New("connection refused")))
+}
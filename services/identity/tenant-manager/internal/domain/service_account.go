@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ServiceAccount represents a machine client (billing jobs, CI scripts)
+// authenticating with API keys instead of a human JWT
+type ServiceAccount struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+
+	// TenantID scopes the account to a single tenant; nil for platform-wide accounts
+	TenantID *uuid.UUID
+
+	IsActive bool
+
+	// Audit fields
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	CreatedBy *uuid.UUID
+}
+
+// APIKey is a hashed credential belonging to a service account.
+// The plaintext key is only returned once, when the key is issued.
+type APIKey struct {
+	ID               uuid.UUID
+	ServiceAccountID uuid.UUID
+	Name             string
+
+	// KeyPrefix is the non-secret lookup part of the key
+	KeyPrefix string
+	// KeyHash is the hex SHA-256 digest of the full key
+	KeyHash string
+
+	// Permissions granted to the key
+	Permissions []string
+
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+
+	CreatedAt time.Time
+	CreatedBy *uuid.UUID
+}
+
+// NewServiceAccount creates a new active service account
+func NewServiceAccount(name, description string, tenantID *uuid.UUID) (*ServiceAccount, error) {
+	if err := validateServiceAccountName(name); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &ServiceAccount{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: description,
+		TenantID:    tenantID,
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Deactivate disables the service account and all of its keys
+func (s *ServiceAccount) Deactivate() error {
+	if !s.IsActive {
+		return ErrServiceAccountInactive
+	}
+
+	s.IsActive = false
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// NewAPIKey creates a key record from an already generated prefix and hash
+func NewAPIKey(serviceAccountID uuid.UUID, name, keyPrefix, keyHash string, permissions []string, expiresAt *time.Time) (*APIKey, error) {
+	if len(permissions) == 0 {
+		return nil, ErrEmptyPermissions
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrInvalidKeyExpiry
+	}
+
+	return &APIKey{
+		ID:               uuid.New(),
+		ServiceAccountID: serviceAccountID,
+		Name:             name,
+		KeyPrefix:        keyPrefix,
+		KeyHash:          keyHash,
+		Permissions:      permissions,
+		ExpiresAt:        expiresAt,
+		CreatedAt:        now,
+	}, nil
+}
+
+// CheckUsable returns an error if the key is revoked or expired at the given time
+func (k *APIKey) CheckUsable(now time.Time) error {
+	if k.RevokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+
+	if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+		return ErrAPIKeyExpired
+	}
+
+	return nil
+}
+
+// Revoke revokes the key
+func (k *APIKey) Revoke() error {
+	if k.RevokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+
+	now := time.Now()
+	k.RevokedAt = &now
+
+	return nil
+}
+
+func validateServiceAccountName(name string) error {
+	if len(name) == 0 {
+		return ErrEmptyServiceAccountName
+	}
+
+	// Names become part of the "service-account-<name>" username
+	if len(name) > 100 {
+		return ErrInvalidServiceAccountName
+	}
+	for _, ch := range name {
+		if !((ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '-') {
+			return ErrInvalidServiceAccountName
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,535 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	// Timezones are validated against the embedded database, so that the
+	// result does not depend on the zoneinfo files of the host
+	_ "time/tzdata"
+)
+
+// SettingsVersion is the version of the settings document written by this
+// code. Documents of older versions are upgraded by settingsMigrations when
+// read.
+const SettingsVersion = 2
+
+// Settings defaults, used while a tenant has not set its own
+const (
+	DefaultLanguage = "pt-BR"
+	DefaultTimezone = "America/Sao_Paulo"
+	DefaultCurrency = "BRL"
+)
+
+var (
+	languageRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Settings is the settings document of a tenant. It is made of namespaces,
+// each a typed struct validated on write; a nil namespace holds the defaults.
+type Settings struct {
+	Version       int                   `json:"version"`
+	Locale        *LocaleSettings       `json:"locale,omitempty"`
+	Notifications *NotificationSettings `json:"notifications,omitempty"`
+	Procurement   *ProcurementSettings  `json:"procurement,omitempty"`
+
+	// Legacy holds the keys of free-form documents written before settings
+	// were typed. It is kept for reference and cannot be written.
+	Legacy map[string]interface{} `json:"legacy,omitempty"`
+
+	// unread is a stored document ParseSettings refused, such as one of a
+	// newer version, and unreadErr why. It is encoded as it was stored.
+	unread    json.RawMessage
+	unreadErr error
+}
+
+// LocaleSettings holds the language, timezone and currency of a tenant
+type LocaleSettings struct {
+	// Language is a BCP 47 tag such as "pt-BR"
+	Language string `json:"language,omitempty"`
+	// Timezone is an IANA timezone such as "America/Sao_Paulo"
+	Timezone string `json:"timezone,omitempty"`
+	// Currency is an ISO 4217 code such as "BRL"
+	Currency string `json:"currency,omitempty"`
+}
+
+// NotificationSettings holds how a tenant is notified of procurement events
+type NotificationSettings struct {
+	// Channels are any of email, sms, webhook and in_app
+	Channels []string `json:"channels,omitempty"`
+	// WebhookURL is an HTTPS URL, required with the webhook channel
+	WebhookURL string `json:"webhookUrl,omitempty"`
+	// DigestFrequency is off, daily or weekly
+	DigestFrequency string `json:"digestFrequency,omitempty"`
+	// Recipients are email addresses notified besides the tenant's contacts
+	Recipients []string `json:"recipients,omitempty"`
+}
+
+// ProcurementSettings holds the defaults of a tenant's purchasing processes
+type ProcurementSettings struct {
+	// ApprovalThreshold is the amount above which a purchase needs approval
+	ApprovalThreshold *float64 `json:"approvalThreshold,omitempty"`
+	// MinQuotations is the number of supplier quotations a purchase needs
+	MinQuotations int `json:"minQuotations,omitempty"`
+	// QuotationValidityDays is how long a quotation is valid by default
+	QuotationValidityDays int `json:"quotationValidityDays,omitempty"`
+	// PaymentTermsDays is the default payment term; 0 means upfront
+	PaymentTermsDays *int `json:"paymentTermsDays,omitempty"`
+}
+
+// settingsNamespace is a namespace of the settings document
+type settingsNamespace interface {
+	validate(v *settingsValidation)
+}
+
+// settingsNamespaces is the registry of settings namespaces. Each entry
+// returns the namespace of a document, creating it if missing.
+var settingsNamespaces = map[string]func(s *Settings) settingsNamespace{
+	"locale": func(s *Settings) settingsNamespace {
+		if s.Locale == nil {
+			s.Locale = &LocaleSettings{}
+		}
+		return s.Locale
+	},
+	"notifications": func(s *Settings) settingsNamespace {
+		if s.Notifications == nil {
+			s.Notifications = &NotificationSettings{}
+		}
+		return s.Notifications
+	},
+	"procurement": func(s *Settings) settingsNamespace {
+		if s.Procurement == nil {
+			s.Procurement = &ProcurementSettings{}
+		}
+		return s.Procurement
+	},
+}
+
+// settingsMigrations upgrade a settings document from the version of their
+// key to the next one
+var settingsMigrations = map[int]func(doc map[string]interface{}) map[string]interface{}{
+	1: migrateSettingsV1,
+}
+
+// NewSettings returns an empty settings document of the current version
+func NewSettings() Settings {
+	return Settings{Version: SettingsVersion}
+}
+
+// UnreadSettings keeps a stored document that ParseSettings refused with
+// err. The document is written back unchanged when the tenant is saved, and
+// cannot be patched, so that a replica that cannot read it never replaces it.
+func UnreadSettings(data []byte, err error) Settings {
+	return Settings{unread: append(json.RawMessage(nil), data...), unreadErr: err}
+}
+
+// Unread returns why the stored document could not be read, or nil
+func (s Settings) Unread() error {
+	if s.unread == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrSettingsUnreadable, s.unreadErr)
+}
+
+// MarshalJSON encodes the settings, or the stored document they could not
+// be read from
+func (s Settings) MarshalJSON() ([]byte, error) {
+	if s.unread != nil {
+		return s.unread, nil
+	}
+	type document Settings
+	return json.Marshal(document(s))
+}
+
+// ParseSettings reads a stored settings document, upgrading it to the
+// current version. Documents without a version are the free-form documents
+// of version 1; a version below 1 or above the current one is refused.
+func ParseSettings(data []byte) (Settings, error) {
+	doc := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return Settings{}, fmt.Errorf("failed to decode settings: %w", err)
+		}
+	}
+	if len(doc) == 0 {
+		return NewSettings(), nil
+	}
+
+	version := 1
+	if v, ok := doc["version"].(float64); ok {
+		version = int(v)
+	}
+	if version < 1 || version > SettingsVersion {
+		return Settings{}, fmt.Errorf("%w: %d", ErrUnsupportedSettingsVersion, version)
+	}
+
+	for ; version < SettingsVersion; version++ {
+		migrate, ok := settingsMigrations[version]
+		if !ok {
+			return Settings{}, fmt.Errorf("%w: no migration from version %d", ErrUnsupportedSettingsVersion, version)
+		}
+		doc = migrate(doc)
+	}
+	doc["version"] = SettingsVersion
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return Settings{}, fmt.Errorf("failed to encode settings: %w", err)
+	}
+
+	var settings Settings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return Settings{}, fmt.Errorf("failed to decode settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// migrateSettingsV1 types a free-form document. Known keys move to their
+// namespace, keys the domain used to write are dropped (the suspension
+// reason and plan changes live in their history tables), and the rest is
+// kept under legacy.
+func migrateSettingsV1(doc map[string]interface{}) map[string]interface{} {
+	locale := make(map[string]interface{})
+	legacy := make(map[string]interface{})
+
+	for key, value := range doc {
+		switch key {
+		case "suspension_reason", "plan_changed_at", "plan_changed_from":
+		case "locale", "language":
+			locale["language"] = value
+		case "timezone":
+			locale["timezone"] = value
+		case "currency":
+			locale["currency"] = value
+		default:
+			legacy[key] = value
+		}
+	}
+
+	migrated := make(map[string]interface{})
+	if len(locale) > 0 {
+		migrated["locale"] = locale
+	}
+	if len(legacy) > 0 {
+		migrated["legacy"] = legacy
+	}
+	return migrated
+}
+
+// Document returns the settings as a JSON-compatible map
+func (s Settings) Document() map[string]interface{} {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil
+	}
+
+	return doc
+}
+
+// Timezone returns the tenant's timezone, or the default
+func (s Settings) Timezone() string {
+	if s.Locale != nil && s.Locale.Timezone != "" {
+		return s.Locale.Timezone
+	}
+	return DefaultTimezone
+}
+
+// Language returns the tenant's language, or the default
+func (s Settings) Language() string {
+	if s.Locale != nil && s.Locale.Language != "" {
+		return s.Locale.Language
+	}
+	return DefaultLanguage
+}
+
+// Currency returns the tenant's currency, or the default
+func (s Settings) Currency() string {
+	if s.Locale != nil && s.Locale.Currency != "" {
+		return s.Locale.Currency
+	}
+	return DefaultCurrency
+}
+
+// Patch applies a JSON merge patch (RFC 7386) to the settings and returns
+// the result, validated. A null removes a setting or a whole namespace,
+// returning it to its default. Every invalid setting is reported in a
+// SettingsError; settings that could not be read are not patched.
+func (s Settings) Patch(patch map[string]interface{}) (Settings, error) {
+	if err := s.Unread(); err != nil {
+		return Settings{}, err
+	}
+
+	doc := s.Document()
+	delete(doc, "version")
+	delete(doc, "legacy")
+
+	v := &settingsValidation{fields: &[]SettingsFieldError{}}
+	for _, key := range []string{"version", "legacy"} {
+		if _, ok := patch[key]; ok {
+			v.add(key, "is read-only")
+		}
+	}
+	mergePatch(doc, patch)
+
+	result := Settings{Version: SettingsVersion, Legacy: s.Legacy}
+	for _, name := range sortedKeys(doc) {
+		if name == "version" || name == "legacy" {
+			continue
+		}
+		namespace, ok := settingsNamespaces[name]
+		if !ok {
+			v.add(name, "unknown settings namespace")
+			continue
+		}
+		if m, ok := doc[name].(map[string]interface{}); ok && len(m) == 0 {
+			// Every setting of the namespace was removed
+			continue
+		}
+		target := namespace(&result)
+		if err := decodeStrict(doc[name], target); err != nil {
+			v.addDecodeError(name, err)
+			continue
+		}
+		target.validate(v.in(name))
+	}
+
+	if err := v.err(); err != nil {
+		return Settings{}, err
+	}
+	return result, nil
+}
+
+// UpdateSettings applies a JSON merge patch to the tenant's settings
+func (t *Tenant) UpdateSettings(patch map[string]interface{}) error {
+	settings, err := t.Settings.Patch(patch)
+	if err != nil {
+		return err
+	}
+
+	t.Settings = settings
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// validate checks the locale settings
+func (l *LocaleSettings) validate(v *settingsValidation) {
+	if l.Language != "" && !languageRegex.MatchString(l.Language) {
+		v.add("language", "must be a language tag such as pt-BR")
+	}
+	if l.Timezone != "" {
+		if _, err := time.LoadLocation(l.Timezone); err != nil || l.Timezone == "Local" {
+			v.add("timezone", "must be an IANA timezone such as America/Sao_Paulo")
+		}
+	}
+	if l.Currency != "" && !currencyRegex.MatchString(l.Currency) {
+		v.add("currency", "must be an ISO 4217 currency code such as BRL")
+	}
+}
+
+// validate checks the notification settings
+func (n *NotificationSettings) validate(v *settingsValidation) {
+	seen := make(map[string]bool, len(n.Channels))
+	for i, channel := range n.Channels {
+		switch channel {
+		case "email", "sms", "webhook", "in_app":
+		default:
+			v.add(fmt.Sprintf("channels[%d]", i), "must be one of: email sms webhook in_app")
+		}
+		if seen[channel] {
+			v.add(fmt.Sprintf("channels[%d]", i), "is a duplicate")
+		}
+		seen[channel] = true
+	}
+
+	if n.WebhookURL != "" {
+		u, err := url.Parse(n.WebhookURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			v.add("webhookUrl", "must be an https URL")
+		}
+	} else if seen["webhook"] {
+		v.add("webhookUrl", "is required with the webhook channel")
+	}
+
+	switch n.DigestFrequency {
+	case "", "off", "daily", "weekly":
+	default:
+		v.add("digestFrequency", "must be one of: off daily weekly")
+	}
+
+	if len(n.Recipients) > 20 {
+		v.add("recipients", "must have at most 20 addresses")
+	}
+	for i, recipient := range n.Recipients {
+		if err := validateEmail(recipient); err != nil {
+			v.add(fmt.Sprintf("recipients[%d]", i), "must be a valid email address")
+		}
+	}
+}
+
+// validate checks the procurement settings
+func (p *ProcurementSettings) validate(v *settingsValidation) {
+	if p.ApprovalThreshold != nil && *p.ApprovalThreshold < 0 {
+		v.add("approvalThreshold", "must be at least 0")
+	}
+	if p.MinQuotations != 0 && (p.MinQuotations < 1 || p.MinQuotations > 10) {
+		v.add("minQuotations", "must be between 1 and 10")
+	}
+	if p.QuotationValidityDays != 0 && (p.QuotationValidityDays < 1 || p.QuotationValidityDays > 365) {
+		v.add("quotationValidityDays", "must be between 1 and 365")
+	}
+	if p.PaymentTermsDays != nil && (*p.PaymentTermsDays < 0 || *p.PaymentTermsDays > 365) {
+		v.add("paymentTermsDays", "must be between 0 and 365")
+	}
+}
+
+// SettingsFieldError is an invalid setting, by its path in the tenant
+// resource (e.g. "settings.locale.timezone")
+type SettingsFieldError struct {
+	Field   string
+	Message string
+}
+
+// SettingsError reports every invalid setting of a write.
+// It matches ErrInvalidSettings with errors.Is.
+type SettingsError struct {
+	Fields []SettingsFieldError
+}
+
+// Error implements error
+func (e *SettingsError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+" "+f.Message)
+	}
+	return ErrInvalidSettings.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns ErrInvalidSettings
+func (e *SettingsError) Unwrap() error {
+	return ErrInvalidSettings
+}
+
+// settingsValidation collects the invalid settings of a write
+type settingsValidation struct {
+	prefix string
+	fields *[]SettingsFieldError
+}
+
+// in returns a validation of the settings under a namespace
+func (v *settingsValidation) in(namespace string) *settingsValidation {
+	return &settingsValidation{prefix: v.path(namespace), fields: v.fields}
+}
+
+// path returns the path of a setting
+func (v *settingsValidation) path(field string) string {
+	if v.prefix == "" {
+		return "settings." + field
+	}
+	return v.prefix + "." + field
+}
+
+// add records an invalid setting
+func (v *settingsValidation) add(field, message string) {
+	*v.fields = append(*v.fields, SettingsFieldError{Field: v.path(field), Message: message})
+}
+
+// addDecodeError records a namespace that does not match its schema
+func (v *settingsValidation) addDecodeError(namespace string, err error) {
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		v.add(namespace+"."+typeErr.Field, "must be of type "+jsonTypeName(typeErr.Type.String()))
+	case errors.As(err, &typeErr):
+		v.add(namespace, "must be an object")
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		v.add(namespace+"."+field, "unknown setting")
+	default:
+		v.add(namespace, "is invalid")
+	}
+}
+
+// err returns the collected invalid settings as an error, or nil
+func (v *settingsValidation) err() error {
+	if len(*v.fields) == 0 {
+		return nil
+	}
+	return &SettingsError{Fields: *v.fields}
+}
+
+// jsonTypeName names a Go type the way a JSON document would
+func jsonTypeName(goType string) string {
+	goType = strings.TrimPrefix(goType, "*")
+	switch {
+	case goType == "string":
+		return "string"
+	case goType == "bool":
+		return "boolean"
+	case strings.HasPrefix(goType, "[]"):
+		return "array"
+	case strings.HasPrefix(goType, "int"):
+		return "integer"
+	case strings.HasPrefix(goType, "float"):
+		return "number"
+	}
+	return "object"
+}
+
+// mergePatch applies a JSON merge patch to doc in place
+func mergePatch(doc, patch map[string]interface{}) {
+	for key, value := range patch {
+		if value == nil {
+			delete(doc, key)
+			continue
+		}
+		if sub, ok := value.(map[string]interface{}); ok {
+			target, ok := doc[key].(map[string]interface{})
+			if !ok {
+				target = make(map[string]interface{})
+			}
+			mergePatch(target, sub)
+			doc[key] = target
+			continue
+		}
+		doc[key] = value
+	}
+}
+
+// decodeStrict decodes a JSON-compatible value into target, refusing
+// unknown fields
+func decodeStrict(value interface{}, target interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(target)
+}
+
+// sortedKeys returns the keys of a map in order, so that errors are
+// reported in a stable order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
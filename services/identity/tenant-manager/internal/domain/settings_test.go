@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings_Patch(t *testing.T) {
+	settings, err := NewSettings().Patch(map[string]interface{}{
+		"locale":      map[string]interface{}{"timezone": "America/Manaus"},
+		"procurement": map[string]interface{}{"minQuotations": 3, "paymentTermsDays": 0},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "America/Manaus", settings.Timezone())
+	assert.Equal(t, DefaultLanguage, settings.Language())
+	assert.Equal(t, 3, settings.Procurement.MinQuotations)
+	require.NotNil(t, settings.Procurement.PaymentTermsDays)
+	assert.Equal(t, 0, *settings.Procurement.PaymentTermsDays)
+
+	// Patches merge within a namespace, and null returns a setting to its default
+	settings, err = settings.Patch(map[string]interface{}{
+		"locale":      map[string]interface{}{"currency": "USD", "timezone": nil},
+		"procurement": nil,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", settings.Currency())
+	assert.Equal(t, DefaultTimezone, settings.Timezone())
+	assert.Nil(t, settings.Procurement)
+}
+
+func TestSettings_Patch_FieldErrors(t *testing.T) {
+	_, err := NewSettings().Patch(map[string]interface{}{
+		"locale":        map[string]interface{}{"timezone": "Mars/Olympus", "currency": "real"},
+		"notifications": map[string]interface{}{"channels": []interface{}{"webhook", "fax"}, "digest": "daily"},
+		"procurement":   map[string]interface{}{"minQuotations": "three"},
+		"theme":         map[string]interface{}{"color": "blue"},
+		"version":       1,
+	})
+	require.ErrorIs(t, err, ErrInvalidSettings)
+
+	var settingsErr *SettingsError
+	require.ErrorAs(t, err, &settingsErr)
+	fields := make(map[string]string)
+	for _, f := range settingsErr.Fields {
+		fields[f.Field] = f.Message
+	}
+	assert.Equal(t, map[string]string{
+		"settings.version":                   "is read-only",
+		"settings.locale.timezone":           "must be an IANA timezone such as America/Sao_Paulo",
+		"settings.locale.currency":           "must be an ISO 4217 currency code such as BRL",
+		"settings.notifications.digest":      "unknown setting",
+		"settings.procurement.minQuotations": "must be of type integer",
+		"settings.theme":                     "unknown settings namespace",
+	}, fields)
+
+	_, err = NewSettings().Patch(map[string]interface{}{
+		"notifications": map[string]interface{}{"channels": []interface{}{"webhook", "fax"}},
+	})
+	require.ErrorAs(t, err, &settingsErr)
+	assert.Equal(t, []SettingsFieldError{
+		{Field: "settings.notifications.channels[1]", Message: "must be one of: email sms webhook in_app"},
+		{Field: "settings.notifications.webhookUrl", Message: "is required with the webhook channel"},
+	}, settingsErr.Fields)
+}
+
+func TestParseSettings_MigratesFreeFormDocuments(t *testing.T) {
+	settings, err := ParseSettings([]byte(`{
+		"locale": "pt-BR",
+		"timezone": "America/Recife",
+		"suspension_reason": "Payment overdue",
+		"plan_changed_at": "2025-12-16T10:30:00Z",
+		"environment": "development"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, SettingsVersion, settings.Version)
+	assert.Equal(t, &LocaleSettings{Language: "pt-BR", Timezone: "America/Recife"}, settings.Locale)
+	assert.Equal(t, map[string]interface{}{"environment": "development"}, settings.Legacy)
+
+	// Current documents are read as they are
+	again, err := ParseSettings([]byte(`{"version": 2, "locale": {"language": "pt-BR"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, &LocaleSettings{Language: "pt-BR"}, again.Locale)
+	assert.Nil(t, again.Legacy)
+
+	empty, err := ParseSettings(nil)
+	require.NoError(t, err)
+	assert.Equal(t, NewSettings(), empty)
+
+	_, err = ParseSettings([]byte(`{"version": 99}`))
+	assert.ErrorIs(t, err, ErrUnsupportedSettingsVersion)
+}
+
+func TestParseSettings_RejectsVersionsBelowOne(t *testing.T) {
+	for _, doc := range []string{`{"version": 0}`, `{"version": -3, "locale": {"language": "pt-BR"}}`} {
+		assert.NotPanics(t, func() {
+			_, err := ParseSettings([]byte(doc))
+			assert.ErrorIs(t, err, ErrUnsupportedSettingsVersion)
+		}, doc)
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// reservedSlugs are slugs no tenant may take: they collide with product
+// hostnames and paths of the tenant URLs
+var reservedSlugs = map[string]bool{
+	"admin":     true,
+	"api":       true,
+	"app":       true,
+	"assets":    true,
+	"auth":      true,
+	"billing":   true,
+	"blog":      true,
+	"cdn":       true,
+	"cotai":     true,
+	"dashboard": true,
+	"docs":      true,
+	"help":      true,
+	"internal":  true,
+	"login":     true,
+	"mail":      true,
+	"static":    true,
+	"status":    true,
+	"support":   true,
+	"system":    true,
+	"www":       true,
+}
+
+// IsReservedSlug reports whether the slug is reserved for the platform
+func IsReservedSlug(slug string) bool {
+	return reservedSlugs[slug]
+}
+
+// SlugAlias is a former slug of a tenant. An alias keeps resolving to its
+// tenant until it is released; a released alias can be claimed by another
+// tenant once the alias cooldown has passed.
+type SlugAlias struct {
+	Slug       string
+	TenantID   uuid.UUID
+	CreatedAt  time.Time
+	ReleasedAt *time.Time
+}
+
+// IsReleased checks if the alias no longer resolves to its tenant
+func (a *SlugAlias) IsReleased() bool {
+	return a.ReleasedAt != nil
+}
+
+// Release stops the alias from resolving to its tenant
+func (a *SlugAlias) Release() error {
+	if a.IsReleased() {
+		return ErrSlugAliasReleased
+	}
+
+	now := time.Now()
+	a.ReleasedAt = &now
+
+	return nil
+}
+
+// CheckClaimable checks whether a tenant may take the alias's slug. The
+// tenant owning the alias may always take it back; other tenants must wait
+// for the alias to be released and the cooldown to pass. Pass uuid.Nil for
+// a tenant not created yet.
+func (a *SlugAlias) CheckClaimable(tenantID uuid.UUID, cooldown time.Duration, now time.Time) error {
+	if a.TenantID == tenantID {
+		return nil
+	}
+
+	if !a.IsReleased() {
+		return ErrSlugAlreadyExists
+	}
+
+	if until := a.ReleasedAt.Add(cooldown); now.Before(until) {
+		return &SlugCooldownError{Slug: a.Slug, Until: until}
+	}
+
+	return nil
+}
+
+// SlugCooldownError reports a released alias still in its cooldown. It
+// matches ErrSlugInCooldown with errors.Is.
+type SlugCooldownError struct {
+	Slug  string
+	Until time.Time
+}
+
+// Error implements error
+func (e *SlugCooldownError) Error() string {
+	return fmt.Sprintf("slug %s was released recently and can be claimed after %s", e.Slug, e.Until.Format(time.RFC3339))
+}
+
+// Unwrap returns ErrSlugInCooldown
+func (e *SlugCooldownError) Unwrap() error {
+	return ErrSlugInCooldown
+}
+
+// RenameSlug changes the tenant slug. The former slug is kept as an alias
+// that resolves to the tenant. Whether the new slug is free is checked by
+// the caller against the registry and the aliases.
+func (t *Tenant) RenameSlug(slug string) error {
+	if err := validateTenantSlug(slug); err != nil {
+		return err
+	}
+
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+
+	if t.TenantSlug == slug {
+		return ErrSlugUnchanged
+	}
+
+	now := time.Now()
+	t.slugAliases = append(t.slugAliases, &SlugAlias{
+		Slug:      t.TenantSlug,
+		TenantID:  t.TenantID,
+		CreatedAt: now,
+	})
+	t.TenantSlug = slug
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// PendingSlugAliases returns the former slugs not yet persisted as aliases
+func (t *Tenant) PendingSlugAliases() []*SlugAlias {
+	return t.slugAliases
+}
+
+// ClearSlugAliases marks the pending aliases as persisted
+func (t *Tenant) ClearSlugAliases() {
+	t.slugAliases = nil
+}
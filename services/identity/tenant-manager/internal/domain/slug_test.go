@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_RenameSlug(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanBasic], "admin@test.com")
+
+	require.NoError(t, tenant.RenameSlug("test-group"))
+	assert.Equal(t, "test-group", tenant.TenantSlug)
+	require.Len(t, tenant.PendingSlugAliases(), 1)
+	assert.Equal(t, "test-company", tenant.PendingSlugAliases()[0].Slug)
+	assert.Equal(t, tenant.TenantID, tenant.PendingSlugAliases()[0].TenantID)
+
+	assert.ErrorIs(t, tenant.RenameSlug("test-group"), ErrSlugUnchanged)
+	assert.ErrorIs(t, tenant.RenameSlug("Test Group"), ErrInvalidTenantSlug)
+	assert.ErrorIs(t, tenant.RenameSlug("admin"), ErrSlugReserved)
+
+	_, err := NewTenant("Test Company", "api", testPlans[PlanBasic], "admin@test.com")
+	assert.ErrorIs(t, err, ErrSlugReserved)
+}
+
+func TestSlugAlias_CheckClaimable(t *testing.T) {
+	owner := uuid.New()
+	other := uuid.New()
+	alias := &SlugAlias{Slug: "test-company", TenantID: owner, CreatedAt: time.Now()}
+
+	// Unreleased aliases belong to their tenant alone
+	assert.NoError(t, alias.CheckClaimable(owner, time.Hour, time.Now()))
+	assert.ErrorIs(t, alias.CheckClaimable(other, time.Hour, time.Now()), ErrSlugAlreadyExists)
+
+	require.NoError(t, alias.Release())
+	assert.ErrorIs(t, alias.Release(), ErrSlugAliasReleased)
+
+	// Released aliases are claimable by others after the cooldown
+	err := alias.CheckClaimable(uuid.Nil, time.Hour, time.Now())
+	assert.ErrorIs(t, err, ErrSlugInCooldown)
+	var cooldownErr *SlugCooldownError
+	require.ErrorAs(t, err, &cooldownErr)
+	assert.Equal(t, alias.ReleasedAt.Add(time.Hour), cooldownErr.Until)
+
+	assert.NoError(t, alias.CheckClaimable(other, time.Hour, time.Now().Add(2*time.Hour)))
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BytesPerGB converts the max_storage_gb quota to bytes
+const BytesPerGB int64 = 1 << 30
+
+// SchemaSize is the exact disk usage of a tenant schema
+type SchemaSize struct {
+	// TableBytes is the heap of the schema's tables, with their free space and visibility maps
+	TableBytes int64
+	// IndexBytes is the indexes of the schema's tables
+	IndexBytes int64
+	// ToastBytes is the TOAST tables holding out-of-line values, with their indexes
+	ToastBytes int64
+}
+
+// TotalBytes returns the disk usage of the whole schema
+func (s SchemaSize) TotalBytes() int64 {
+	return s.TableBytes + s.IndexBytes + s.ToastBytes
+}
+
+// StorageSnapshot is one measurement of a tenant's schema size
+type StorageSnapshot struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Schema   string
+	SchemaSize
+	// QuotaBytes is the tenant's effective storage quota when measured
+	QuotaBytes int64
+	MeasuredAt time.Time
+}
+
+// NewStorageSnapshot records the size of a tenant's schema against its
+// effective storage quota at measuredAt
+func NewStorageSnapshot(tenant *Tenant, size SchemaSize, measuredAt time.Time) *StorageSnapshot {
+	quota := tenant.EffectiveQuota(QuotaMaxStorageGB, measuredAt)
+
+	return &StorageSnapshot{
+		ID:         uuid.New(),
+		TenantID:   tenant.TenantID,
+		Schema:     tenant.DatabaseSchema,
+		SchemaSize: size,
+		QuotaBytes: int64(quota.Effective) * BytesPerGB,
+		MeasuredAt: measuredAt,
+	}
+}
+
+// OverQuota reports whether the schema is larger than the storage quota
+func (s *StorageSnapshot) OverQuota() bool {
+	return s.TotalBytes() > s.QuotaBytes
+}
+
+// WentOverQuota reports whether the tenant exceeds its storage quota and did
+// not at the previous measurement, which may be nil
+func (s *StorageSnapshot) WentOverQuota(previous *StorageSnapshot) bool {
+	return s.OverQuota() && (previous == nil || !previous.OverQuota())
+}
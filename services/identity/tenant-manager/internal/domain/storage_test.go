@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageSnapshot_OverQuota(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	now := time.Now()
+
+	size := SchemaSize{TableBytes: 300 * BytesPerGB, IndexBytes: 150 * BytesPerGB, ToastBytes: 40 * BytesPerGB}
+	first := NewStorageSnapshot(tenant, size, now)
+	assert.Equal(t, tenant.TenantID, first.TenantID)
+	assert.Equal(t, tenant.DatabaseSchema, first.Schema)
+	assert.Equal(t, 490*BytesPerGB, first.TotalBytes())
+	assert.Equal(t, 500*BytesPerGB, first.QuotaBytes)
+	assert.False(t, first.OverQuota())
+	assert.False(t, first.WentOverQuota(nil))
+
+	// Growing past the quota raises once
+	size.IndexBytes += 20 * BytesPerGB
+	second := NewStorageSnapshot(tenant, size, now.Add(time.Hour))
+	assert.True(t, second.OverQuota())
+	assert.True(t, second.WentOverQuota(first))
+
+	third := NewStorageSnapshot(tenant, size, now.Add(2*time.Hour))
+	assert.False(t, third.WentOverQuota(second))
+
+	// The quota in force at measurement time applies
+	require.NoError(t, tenant.SetQuotaOverride(QuotaMaxStorageGB, 800, "pilot", nil))
+	fourth := NewStorageSnapshot(tenant, size, now.Add(3*time.Hour))
+	assert.Equal(t, 800*BytesPerGB, fourth.QuotaBytes)
+	assert.False(t, fourth.OverQuota())
+
+	// A tenant measured for the first time over its quota raises too
+	tenant.RemoveQuotaOverride(QuotaMaxStorageGB)
+	assert.True(t, NewStorageSnapshot(tenant, size, now).WentOverQuota(nil))
+}
@@ -0,0 +1,201 @@
+package domain
+
+import "fmt"
+
+// SuspensionReason is why a tenant was suspended
+type SuspensionReason string
+
+const (
+	// SuspensionBilling is a suspension for non-payment
+	SuspensionBilling SuspensionReason = "billing"
+	// SuspensionAbuse is a suspension for a breach of the terms of use
+	SuspensionAbuse SuspensionReason = "abuse"
+	// SuspensionLegalHold is a suspension ordered by a court or regulator
+	SuspensionLegalHold SuspensionReason = "legal_hold"
+	// SuspensionCustomerRequest is a suspension the customer asked for
+	SuspensionCustomerRequest SuspensionReason = "customer_request"
+	// SuspensionSecurity is a suspension after a security incident
+	SuspensionSecurity SuspensionReason = "security"
+)
+
+// IsValid checks if the suspension reason is known
+func (r SuspensionReason) IsValid() bool {
+	switch r {
+	case SuspensionBilling, SuspensionAbuse, SuspensionLegalHold, SuspensionCustomerRequest, SuspensionSecurity:
+		return true
+	}
+	return false
+}
+
+// ReinstatementAuthority is a right to lift suspensions beyond the right to
+// suspend the tenant
+type ReinstatementAuthority string
+
+const (
+	// AuthorityPlatform lifts abuse and security suspensions; platform
+	// operators hold it
+	AuthorityPlatform ReinstatementAuthority = "platform"
+	// AuthorityCompliance lifts legal holds; only compliance officers hold it
+	AuthorityCompliance ReinstatementAuthority = "compliance"
+)
+
+// restrictedReasons maps the suspension reasons that block self-service
+// reactivation to the authority that lifts them. Any other suspension is
+// lifted by whoever may suspend the tenant.
+var restrictedReasons = map[SuspensionReason]ReinstatementAuthority{
+	SuspensionAbuse:     AuthorityPlatform,
+	SuspensionSecurity:  AuthorityPlatform,
+	SuspensionLegalHold: AuthorityCompliance,
+}
+
+// RequiredAuthority returns the authority needed to lift a suspension for
+// the reason, or "" when the right to suspend is enough
+func (r SuspensionReason) RequiredAuthority() ReinstatementAuthority {
+	return restrictedReasons[r]
+}
+
+// IsRestricted checks if a suspension for the reason blocks self-service
+// reactivation
+func (r SuspensionReason) IsRestricted() bool {
+	return r.RequiredAuthority() != ""
+}
+
+// AtLeastAsRestrictiveAs checks if a suspension for the reason is as hard to
+// lift as one for other: the right to suspend lifts the fewest, then
+// platform authority, then compliance authority
+func (r SuspensionReason) AtLeastAsRestrictiveAs(other SuspensionReason) bool {
+	return r.restrictiveness() >= other.restrictiveness()
+}
+
+// restrictiveness ranks the authority lifting a suspension for the reason
+func (r SuspensionReason) restrictiveness() int {
+	switch r.RequiredAuthority() {
+	case AuthorityCompliance:
+		return 2
+	case AuthorityPlatform:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ReinstatementError reports a reactivation refused because the suspension
+// reason needs an authority the caller does not hold. It matches
+// ErrReinstatementRestricted with errors.Is.
+type ReinstatementError struct {
+	Reason   SuspensionReason
+	Required ReinstatementAuthority
+}
+
+// Error implements error
+func (e *ReinstatementError) Error() string {
+	return fmt.Sprintf("a %s suspension can only be lifted with %s authority", e.Reason, e.Required)
+}
+
+// Unwrap returns ErrReinstatementRestricted
+func (e *ReinstatementError) Unwrap() error {
+	return ErrReinstatementRestricted
+}
+
+// Suspend suspends an active tenant for a reason, with optional free-form
+// notes. A tenant suspended for another reason has its suspension replaced
+// instead, when the new reason is at least as restrictive; the suspension
+// then counts from now, so the reactivation scheduled for the one it
+// replaces no longer lifts it.
+func (t *Tenant) Suspend(reason SuspensionReason, notes string) error {
+	if !reason.IsValid() {
+		return ErrInvalidSuspensionReason
+	}
+
+	action := ActionSuspend
+	if t.Status == StatusSuspended && reason != t.SuspensionReason {
+		if !reason.AtLeastAsRestrictiveAs(t.SuspensionReason) {
+			return ErrSuspensionDowngrade
+		}
+		action = ActionChangeSuspension
+	}
+
+	historyReason := string(reason)
+	if notes != "" {
+		historyReason += ": " + notes
+	}
+	if err := t.transition(action, historyReason); err != nil {
+		return err
+	}
+
+	now := t.UpdatedAt
+	t.SuspendedAt = &now
+	t.SuspensionReason = reason
+	t.SuspensionNotes = notes
+
+	return nil
+}
+
+// Activate reactivates a suspended tenant on behalf of a caller that may
+// only suspend it; restricted suspensions are refused
+func (t *Tenant) Activate() error {
+	return t.Reinstate()
+}
+
+// Reinstate reactivates a suspended tenant. A suspension whose reason is
+// restricted is only lifted when authorities include the one it requires.
+// The suspension reason is cleared and ReinstatedAt recorded.
+func (t *Tenant) Reinstate(authorities ...ReinstatementAuthority) error {
+	if t.Status == StatusSuspended {
+		if err := t.checkReinstatement(authorities); err != nil {
+			return err
+		}
+	}
+
+	if err := t.transition(ActionActivate, ""); err != nil {
+		return err
+	}
+
+	now := t.UpdatedAt
+	t.ActivatedAt = &now
+	t.ReinstatedAt = &now
+	t.clearSuspension()
+
+	return nil
+}
+
+// checkReinstatement checks that the authorities lift the tenant's suspension
+func (t *Tenant) checkReinstatement(authorities []ReinstatementAuthority) error {
+	required := t.SuspensionReason.RequiredAuthority()
+	if required == "" {
+		return nil
+	}
+	for _, a := range authorities {
+		if a == required {
+			return nil
+		}
+	}
+	return &ReinstatementError{Reason: t.SuspensionReason, Required: required}
+}
+
+// checkUnrestricted refuses an operation that would end or outlive a
+// restricted suspension
+func (t *Tenant) checkUnrestricted() error {
+	if t.Status == StatusSuspended && t.SuspensionReason.IsRestricted() {
+		return ErrRestrictedSuspension
+	}
+	return nil
+}
+
+// clearSuspension forgets the reason of a suspension that ended; it stays in
+// the status history
+func (t *Tenant) clearSuspension() {
+	t.SuspensionReason = ""
+	t.SuspensionNotes = ""
+}
+
+// suspensionSnapshot returns the audited state of the tenant's suspension
+func (t *Tenant) suspensionSnapshot() map[string]interface{} {
+	if t.SuspensionReason == "" {
+		return nil
+	}
+	return map[string]interface{}{
+		"reason": t.SuspensionReason,
+		"notes":  t.SuspensionNotes,
+	}
+}
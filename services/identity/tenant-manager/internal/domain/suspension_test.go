@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_Reinstate(t *testing.T) {
+	tests := []struct {
+		name        string
+		reason      SuspensionReason
+		authorities []ReinstatementAuthority
+		wantErr     bool
+	}{
+		{"billing by suspender", SuspensionBilling, nil, false},
+		{"customer request by suspender", SuspensionCustomerRequest, nil, false},
+		{"abuse by suspender", SuspensionAbuse, nil, true},
+		{"abuse by platform", SuspensionAbuse, []ReinstatementAuthority{AuthorityPlatform}, false},
+		{"security by compliance", SuspensionSecurity, []ReinstatementAuthority{AuthorityCompliance}, true},
+		{"legal hold by platform", SuspensionLegalHold, []ReinstatementAuthority{AuthorityPlatform}, true},
+		{"legal hold by compliance", SuspensionLegalHold, []ReinstatementAuthority{AuthorityPlatform, AuthorityCompliance}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+			require.NoError(t, tenant.CompleteProvisioning())
+			require.NoError(t, tenant.Suspend(tt.reason, "notes"))
+
+			err := tenant.Reinstate(tt.authorities...)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrReinstatementRestricted)
+				assert.Equal(t, StatusSuspended, tenant.Status)
+				assert.Equal(t, tt.reason, tenant.SuspensionReason)
+				assert.Nil(t, tenant.ReinstatedAt)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, StatusActive, tenant.Status)
+			assert.Empty(t, tenant.SuspensionReason)
+			assert.Empty(t, tenant.SuspensionNotes)
+			assert.NotNil(t, tenant.ReinstatedAt)
+		})
+	}
+}
+
+func TestTenant_ActivateRefusesRestrictedSuspension(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.CompleteProvisioning())
+	require.NoError(t, tenant.Suspend(SuspensionLegalHold, "Court order 123"))
+
+	err := tenant.Activate()
+	var reinstatementErr *ReinstatementError
+	require.ErrorAs(t, err, &reinstatementErr)
+	assert.Equal(t, AuthorityCompliance, reinstatementErr.Required)
+
+	// The scheduled operations worker gives up on it rather than retrying
+	assert.NotNil(t, OperationFailure(err))
+}
+
+func TestTenant_ArchiveAndDeleteRefuseRestrictedSuspension(t *testing.T) {
+	tests := []struct {
+		name    string
+		reason  SuspensionReason
+		wantErr bool
+	}{
+		{"billing", SuspensionBilling, false},
+		{"customer request", SuspensionCustomerRequest, false},
+		{"abuse", SuspensionAbuse, true},
+		{"security", SuspensionSecurity, true},
+		{"legal hold", SuspensionLegalHold, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+			require.NoError(t, tenant.CompleteProvisioning())
+			require.NoError(t, tenant.Suspend(tt.reason, "notes"))
+
+			archiveErr := tenant.Archive("cleanup")
+			if tt.wantErr {
+				assert.ErrorIs(t, archiveErr, ErrRestrictedSuspension)
+				assert.ErrorIs(t, tenant.Delete(), ErrRestrictedSuspension)
+				assert.Equal(t, StatusSuspended, tenant.Status)
+				assert.Equal(t, tt.reason, tenant.SuspensionReason)
+				assert.Nil(t, tenant.DeletedAt)
+				return
+			}
+
+			require.NoError(t, archiveErr)
+			assert.Equal(t, StatusArchived, tenant.Status)
+		})
+	}
+}
+
+func TestTenant_RestrictedSuspensionCannotBeLiftedThroughArchive(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.CompleteProvisioning())
+	require.NoError(t, tenant.Suspend(SuspensionAbuse, "Spam campaign"))
+
+	require.ErrorIs(t, tenant.Archive("cleanup"), ErrRestrictedSuspension)
+	assert.Error(t, tenant.Unarchive())
+
+	// Once lifted with the required authority, and suspended for another
+	// reason, the tenant can be archived
+	require.NoError(t, tenant.Reinstate(AuthorityPlatform))
+	require.NoError(t, tenant.Suspend(SuspensionCustomerRequest, "Closing account"))
+	require.NoError(t, tenant.Archive("cleanup"))
+	require.NoError(t, tenant.Unarchive())
+	assert.Equal(t, StatusActive, tenant.Status)
+}
+
+func TestTenant_SuspendChangesReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    SuspensionReason
+		to      SuspensionReason
+		wantErr error
+	}{
+		{"billing to customer request", SuspensionBilling, SuspensionCustomerRequest, nil},
+		{"billing to abuse", SuspensionBilling, SuspensionAbuse, nil},
+		{"abuse to security", SuspensionAbuse, SuspensionSecurity, nil},
+		{"security to legal hold", SuspensionSecurity, SuspensionLegalHold, nil},
+		{"abuse to billing", SuspensionAbuse, SuspensionBilling, ErrSuspensionDowngrade},
+		{"legal hold to security", SuspensionLegalHold, SuspensionSecurity, ErrSuspensionDowngrade},
+		{"same reason", SuspensionAbuse, SuspensionAbuse, ErrTenantAlreadySuspended},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+			require.NoError(t, tenant.CompleteProvisioning())
+			require.NoError(t, tenant.Suspend(tt.from, "first"))
+			suspendedAt := *tenant.SuspendedAt
+			tenant.ClearTransitions()
+
+			err := tenant.Suspend(tt.to, "second")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.from, tenant.SuspensionReason)
+				assert.Equal(t, "first", tenant.SuspensionNotes)
+				assert.Equal(t, suspendedAt, *tenant.SuspendedAt)
+				assert.Empty(t, tenant.PendingTransitions())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, StatusSuspended, tenant.Status)
+			assert.Equal(t, tt.to, tenant.SuspensionReason)
+			assert.Equal(t, "second", tenant.SuspensionNotes)
+			assert.False(t, tenant.SuspendedAt.Before(suspendedAt))
+
+			// The change is recorded in the status history
+			require.Len(t, tenant.PendingTransitions(), 1)
+			transition := tenant.PendingTransitions()[0]
+			assert.Equal(t, ActionChangeSuspension, transition.Action)
+			assert.Equal(t, StatusSuspended, transition.FromStatus)
+			assert.Equal(t, StatusSuspended, transition.ToStatus)
+			assert.Equal(t, string(tt.to)+": second", transition.Reason)
+		})
+	}
+}
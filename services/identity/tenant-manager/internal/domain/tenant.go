@@ -29,9 +29,10 @@ func (s TenantStatus) IsValid() bool {
 	}
 }
 
-// PlanTier represents the subscription plan
+// PlanTier identifies a subscription plan of the plan catalog
 type PlanTier string
 
+// Plans seeded into the catalog. Other plans can be added at runtime.
 const (
 	PlanFree         PlanTier = "free"
 	PlanBasic        PlanTier = "basic"
@@ -39,14 +40,18 @@ const (
 	PlanEnterprise   PlanTier = "enterprise"
 )
 
-// IsValid checks if the plan tier is valid
+// IsValid checks if the plan tier is a well-formed plan identifier:
+// lowercase letters, numbers and hyphens, at most 50 characters
 func (p PlanTier) IsValid() bool {
-	switch p {
-	case PlanFree, PlanBasic, PlanProfessional, PlanEnterprise:
-		return true
-	default:
+	if len(p) == 0 || len(p) > 50 {
 		return false
 	}
+	for _, ch := range p {
+		if !((ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '-') {
+			return false
+		}
+	}
+	return true
 }
 
 // Tenant represents the tenant aggregate root (DDD)
@@ -67,31 +72,69 @@ type Tenant struct {
 	Status   TenantStatus `db:"status"`
 	PlanTier PlanTier     `db:"plan_tier"`
 
-	// Quotas
+	// Hierarchy: the parent of a unit of a larger organization, nil for a root
+	ParentTenantID *uuid.UUID `db:"parent_tenant_id"`
+	// InheritQuotas takes the parent's effective quotas in place of the plan quotas
+	InheritQuotas bool `db:"inherit_quotas"`
+
+	// Quotas granted by the plan
 	MaxUsers     int `db:"max_users"`
 	MaxStorageGB int `db:"max_storage_gb"`
 
+	// Per-tenant overrides of the plan quotas
+	QuotaOverrides []*QuotaOverride `db:"-"`
+
+	// Effective quotas of the parent, for a tenant that inherits quotas
+	inheritedQuotas map[QuotaName]int
+
+	// Trial of the current plan, running or ended; nil for a tenant that
+	// never had one
+	Trial *Trial `db:"-"`
+
+	// Why a suspended tenant was suspended, see suspension.go
+	SuspensionReason SuspensionReason `db:"suspension_reason"`
+	SuspensionNotes  string           `db:"suspension_notes"`
+
 	// Contact information
 	PrimaryContactEmail string `db:"primary_contact_email"`
 	PrimaryContactName  string `db:"primary_contact_name"`
 	BillingEmail        string `db:"billing_email"`
 
 	// JSONB fields
-	Settings map[string]interface{} `db:"settings"`
+	// Settings is the typed settings document, see settings.go
+	Settings Settings `db:"settings"`
+	// Features are the feature flag values granted by the plan
 	Features map[string]interface{} `db:"features"`
+	// FeatureOverrides are flag values set for this tenant alone
+	FeatureOverrides map[string]interface{} `db:"feature_overrides"`
 
 	// Audit fields
 	CreatedAt   time.Time  `db:"created_at"`
 	UpdatedAt   time.Time  `db:"updated_at"`
 	ActivatedAt *time.Time `db:"activated_at"`
 	SuspendedAt *time.Time `db:"suspended_at"`
-	DeletedAt   *time.Time `db:"deleted_at"`
-	CreatedBy   *uuid.UUID `db:"created_by"`
-	UpdatedBy   *uuid.UUID `db:"updated_by"`
+	// ReinstatedAt is when a suspension was last lifted
+	ReinstatedAt *time.Time `db:"reinstated_at"`
+	DeletedAt    *time.Time `db:"deleted_at"`
+	PurgedAt     *time.Time `db:"purged_at"`
+	CreatedBy    *uuid.UUID `db:"created_by"`
+	UpdatedBy    *uuid.UUID `db:"updated_by"`
+
+	// Status transitions not yet written to the status history
+	transitions []*StatusTransition
+
+	// Plan changes not yet written to the plan history
+	planChanges []*PlanChange
+
+	// Quotas whose override changed and is not yet written
+	quotaChanges []QuotaName
+
+	// Former slugs not yet written as aliases
+	slugAliases []*SlugAlias
 }
 
-// NewTenant creates a new tenant with default values
-func NewTenant(name, slug string, plan PlanTier, email string) (*Tenant, error) {
+// NewTenant creates a new tenant with the quotas and default features of its plan
+func NewTenant(name, slug string, plan *Plan, email string) (*Tenant, error) {
 	if err := validateTenantName(name); err != nil {
 		return nil, err
 	}
@@ -100,8 +143,8 @@ func NewTenant(name, slug string, plan PlanTier, email string) (*Tenant, error)
 		return nil, err
 	}
 
-	if !plan.IsValid() {
-		return nil, ErrInvalidPlanTier
+	if err := plan.checkAssignable(); err != nil {
+		return nil, err
 	}
 
 	if err := validateEmail(email); err != nil {
@@ -111,7 +154,7 @@ func NewTenant(name, slug string, plan PlanTier, email string) (*Tenant, error)
 	tenantID := uuid.New()
 	now := time.Now()
 
-	return &Tenant{
+	tenant := &Tenant{
 		ID:                  uuid.New(),
 		TenantID:            tenantID,
 		TenantName:          name,
@@ -119,16 +162,20 @@ func NewTenant(name, slug string, plan PlanTier, email string) (*Tenant, error)
 		DatabaseSchema:      FormatSchemaName(tenantID),
 		SchemaVersion:       "1.0.0",
 		Status:              StatusProvisioning,
-		PlanTier:            plan,
-		MaxUsers:            getDefaultMaxUsers(plan),
-		MaxStorageGB:        getDefaultMaxStorage(plan),
+		PlanTier:            plan.Tier,
+		MaxUsers:            plan.MaxUsers,
+		MaxStorageGB:        plan.MaxStorageGB,
 		PrimaryContactEmail: email,
 		BillingEmail:        email,
-		Settings:            make(map[string]interface{}),
-		Features:            make(map[string]interface{}),
+		Settings:            NewSettings(),
+		Features:            plan.DefaultFeatures(),
+		FeatureOverrides:    make(map[string]interface{}),
 		CreatedAt:           now,
 		UpdatedAt:           now,
-	}, nil
+	}
+	tenant.recordTransition(ActionCreate, "", StatusProvisioning, "", now)
+
+	return tenant, nil
 }
 
 // FormatSchemaName formats tenant ID into PostgreSQL schema name
@@ -140,57 +187,104 @@ func FormatSchemaName(tenantID uuid.UUID) string {
 
 // Business Methods
 
-// Activate activates a tenant
-func (t *Tenant) Activate() error {
-	if t.Status == StatusDeleted {
-		return ErrTenantDeleted
+// CompleteProvisioning activates a newly provisioned tenant
+func (t *Tenant) CompleteProvisioning() error {
+	if err := t.transition(ActionCompleteProvisioning, ""); err != nil {
+		return err
 	}
 
-	if t.Status == StatusActive {
-		return ErrTenantAlreadyActive
+	now := t.UpdatedAt
+	t.ActivatedAt = &now
+
+	return nil
+}
+
+// Archive moves a suspended tenant to the archived status. An active tenant
+// is refused, since writes made after the export would be lost with the
+// schema. A tenant under a restricted suspension is refused too:
+// unarchiving reactivates it, which would lift the suspension without the
+// authority it requires.
+func (t *Tenant) Archive(reason string) error {
+	if err := t.checkUnrestricted(); err != nil {
+		return err
 	}
+	return t.transition(ActionArchive, reason)
+}
 
-	t.Status = StatusActive
-	now := time.Now()
+// Unarchive returns an archived tenant to the active status
+func (t *Tenant) Unarchive() error {
+	if err := t.transition(ActionUnarchive, ""); err != nil {
+		return err
+	}
+
+	now := t.UpdatedAt
 	t.ActivatedAt = &now
-	t.UpdatedAt = now
+	t.clearSuspension()
 
 	return nil
 }
 
-// Suspend suspends a tenant
-func (t *Tenant) Suspend(reason string) error {
-	if t.Status == StatusDeleted {
-		return ErrTenantDeleted
+// Delete soft-deletes a tenant. A tenant under a restricted suspension is
+// refused, so that the purge never drops the data of a tenant on legal hold.
+func (t *Tenant) Delete() error {
+	if err := t.checkUnrestricted(); err != nil {
+		return err
 	}
+	if err := t.transition(ActionDelete, ""); err != nil {
+		return err
+	}
+
+	now := t.UpdatedAt
+	t.DeletedAt = &now
 
-	if t.Status == StatusSuspended {
-		return ErrTenantAlreadySuspended
+	return nil
+}
+
+// Restore returns a deleted tenant to the status it had before deletion
+func (t *Tenant) Restore(previous TenantStatus, reason string) error {
+	restorable := false
+	for _, status := range restorableStatuses {
+		if status == previous {
+			restorable = true
+			break
+		}
+	}
+	if !restorable {
+		return &TransitionError{Action: ActionRestore, From: t.Status, To: previous}
 	}
 
-	t.Status = StatusSuspended
-	now := time.Now()
-	t.SuspendedAt = &now
-	t.UpdatedAt = now
+	if t.IsPurged() {
+		return ErrTenantAlreadyPurged
+	}
 
-	// Store suspension reason in settings
-	if t.Settings == nil {
-		t.Settings = make(map[string]interface{})
+	if err := t.transitionTo(ActionRestore, previous, reason); err != nil {
+		return err
 	}
-	t.Settings["suspension_reason"] = reason
+
+	t.DeletedAt = nil
 
 	return nil
 }
 
-// Delete soft-deletes a tenant
-func (t *Tenant) Delete() error {
-	if t.Status == StatusDeleted {
-		return ErrTenantAlreadyDeleted
+// Purge scrubs the personal data of a deleted tenant. The registry row is
+// kept, with a placeholder name, so that references from the audit log and
+// status history still resolve.
+func (t *Tenant) Purge() error {
+	if !t.IsDeleted() {
+		return ErrTenantNotDeleted
+	}
+
+	if t.IsPurged() {
+		return ErrTenantAlreadyPurged
 	}
 
-	t.Status = StatusDeleted
 	now := time.Now()
-	t.DeletedAt = &now
+	t.TenantName = "purged-" + t.TenantID.String()
+	t.PrimaryContactEmail = ""
+	t.PrimaryContactName = ""
+	t.BillingEmail = ""
+	t.Settings = NewSettings()
+	t.PurgedAt = &now
 	t.UpdatedAt = now
 
 	return nil
@@ -220,28 +314,58 @@ func (t *Tenant) UpdateContactEmail(email string) error {
 	return nil
 }
 
-// ChangePlan changes the subscription plan
-func (t *Tenant) ChangePlan(newPlan PlanTier) error {
-	if !newPlan.IsValid() {
-		return ErrInvalidPlanTier
+// ChangePlan changes the subscription plan and resets the quotas and features
+// to the plan defaults. The change is recorded for the plan history. A
+// running trial ends as converted: the customer has chosen a plan, which the
+// trial expiry must not take away.
+func (t *Tenant) ChangePlan(newPlan *Plan) error {
+	if err := t.changePlan(newPlan); err != nil {
+		return err
 	}
 
-	if t.PlanTier == newPlan {
-		return ErrPlanAlreadySet
+	if t.HasActiveTrial() {
+		now := t.UpdatedAt
+		t.Trial.EndedAt = &now
+		t.Trial.Outcome = TrialConverted
 	}
 
-	oldPlan := t.PlanTier
-	t.PlanTier = newPlan
-	t.MaxUsers = getDefaultMaxUsers(newPlan)
-	t.MaxStorageGB = getDefaultMaxStorage(newPlan)
-	t.UpdatedAt = time.Now()
+	return nil
+}
 
-	// Store plan change history in settings
-	if t.Settings == nil {
-		t.Settings = make(map[string]interface{})
+// changePlan changes the subscription plan, leaving a running trial alone
+func (t *Tenant) changePlan(newPlan *Plan) error {
+	if err := newPlan.checkAssignable(); err != nil {
+		return err
+	}
+
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+
+	if t.PlanTier == newPlan.Tier {
+		return ErrPlanAlreadySet
+	}
+
+	now := time.Now()
+	change := &PlanChange{
+		ID:               uuid.New(),
+		TenantID:         t.TenantID,
+		FromPlan:         t.PlanTier,
+		ToPlan:           newPlan.Tier,
+		FromMaxUsers:     t.MaxUsers,
+		ToMaxUsers:       newPlan.MaxUsers,
+		FromMaxStorageGB: t.MaxStorageGB,
+		ToMaxStorageGB:   newPlan.MaxStorageGB,
+		ActorType:        ActorSystem,
+		ChangedAt:        now,
 	}
-	t.Settings["plan_changed_from"] = oldPlan
-	t.Settings["plan_changed_at"] = time.Now().Format(time.RFC3339)
+
+	t.PlanTier = newPlan.Tier
+	t.MaxUsers = change.ToMaxUsers
+	t.MaxStorageGB = change.ToMaxStorageGB
+	t.Features = newPlan.DefaultFeatures()
+	t.UpdatedAt = now
+	t.planChanges = append(t.planChanges, change)
 
 	return nil
 }
@@ -268,36 +392,14 @@ func (t *Tenant) IsDeleted() bool {
 	return t.Status == StatusDeleted
 }
 
-// Helper functions
-
-func getDefaultMaxUsers(plan PlanTier) int {
-	switch plan {
-	case PlanFree:
-		return 5
-	case PlanBasic:
-		return 20
-	case PlanProfessional:
-		return 100
-	case PlanEnterprise:
-		return 1000
-	default:
-		return 5
-	}
+// IsPurged checks if a deleted tenant's data has been purged
+func (t *Tenant) IsPurged() bool {
+	return t.PurgedAt != nil
 }
 
-func getDefaultMaxStorage(plan PlanTier) int {
-	switch plan {
-	case PlanFree:
-		return 5 // GB
-	case PlanBasic:
-		return 50
-	case PlanProfessional:
-		return 500
-	case PlanEnterprise:
-		return 5000
-	default:
-		return 5
-	}
+// IsArchived checks if tenant is archived
+func (t *Tenant) IsArchived() bool {
+	return t.Status == StatusArchived
 }
 
 // Validation functions
@@ -330,6 +432,10 @@ func validateTenantSlug(slug string) error {
 		}
 	}
 
+	if IsReservedSlug(slug) {
+		return ErrSlugReserved
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewTenant(t *testing.T) {
@@ -56,11 +57,19 @@ func TestNewTenant(t *testing.T) {
 			email:      "invalid-email",
 			wantErr:    ErrInvalidEmail,
 		},
+		{
+			name:       "unknown plan",
+			tenantName: "Test Company",
+			slug:       "test-company",
+			plan:       PlanTier("gold"),
+			email:      "admin@test.com",
+			wantErr:    ErrInvalidPlanTier,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tenant, err := NewTenant(tt.tenantName, tt.slug, tt.plan, tt.email)
+			tenant, err := NewTenant(tt.tenantName, tt.slug, testPlans[tt.plan], tt.email)
 
 			if tt.wantErr != nil {
 				assert.Error(t, err)
@@ -82,10 +91,15 @@ func TestNewTenant(t *testing.T) {
 }
 
 func TestTenant_Activate(t *testing.T) {
-	tenant, _ := NewTenant("Test Company", "test-company", PlanProfessional, "admin@test.com")
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
 
-	// First activation should succeed
+	// Activate is not part of the create flow
 	err := tenant.Activate()
+	assert.ErrorIs(t, err, ErrIllegalTransition)
+	assert.Equal(t, StatusProvisioning, tenant.Status)
+
+	// Completing provisioning activates the tenant
+	err = tenant.CompleteProvisioning()
 	assert.NoError(t, err)
 	assert.Equal(t, StatusActive, tenant.Status)
 	assert.NotNil(t, tenant.ActivatedAt)
@@ -93,28 +107,42 @@ func TestTenant_Activate(t *testing.T) {
 	// Second activation should fail
 	err = tenant.Activate()
 	assert.ErrorIs(t, err, ErrTenantAlreadyActive)
+
+	// Reactivating a suspended tenant should succeed
+	tenant.Suspend(SuspensionBilling, "Payment overdue")
+	err = tenant.Activate()
+	assert.NoError(t, err)
+	assert.Equal(t, StatusActive, tenant.Status)
 }
 
 func TestTenant_Suspend(t *testing.T) {
-	tenant, _ := NewTenant("Test Company", "test-company", PlanProfessional, "admin@test.com")
-	tenant.Activate()
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	tenant.CompleteProvisioning()
+
+	// An unknown reason is refused
+	err := tenant.Suspend("Payment overdue", "")
+	assert.ErrorIs(t, err, ErrInvalidSuspensionReason)
 
 	// Suspend tenant
-	reason := "Payment overdue"
-	err := tenant.Suspend(reason)
+	err = tenant.Suspend(SuspensionBilling, "Payment overdue")
 	assert.NoError(t, err)
 	assert.Equal(t, StatusSuspended, tenant.Status)
 	assert.NotNil(t, tenant.SuspendedAt)
-	assert.Equal(t, reason, tenant.Settings["suspension_reason"])
+	assert.Equal(t, SuspensionBilling, tenant.SuspensionReason)
+	assert.Equal(t, "Payment overdue", tenant.SuspensionNotes)
+	// The reason is kept in its columns and the status history, not in settings
+	transitions := tenant.PendingTransitions()
+	assert.Equal(t, "billing: Payment overdue", transitions[len(transitions)-1].Reason)
+	assert.Equal(t, NewSettings(), tenant.Settings)
 
-	// Second suspension should fail
-	err = tenant.Suspend("Another reason")
+	// Second suspension for the same reason should fail
+	err = tenant.Suspend(SuspensionBilling, "Another reason")
 	assert.ErrorIs(t, err, ErrTenantAlreadySuspended)
 }
 
 func TestTenant_Delete(t *testing.T) {
-	tenant, _ := NewTenant("Test Company", "test-company", PlanProfessional, "admin@test.com")
-	tenant.Activate()
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	tenant.CompleteProvisioning()
 
 	// Delete tenant
 	err := tenant.Delete()
@@ -127,19 +155,60 @@ func TestTenant_Delete(t *testing.T) {
 	assert.ErrorIs(t, err, ErrTenantAlreadyDeleted)
 }
 
+func TestTenant_Purge(t *testing.T) {
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	tenant.CompleteProvisioning()
+	tenant.BillingEmail = "billing@test.com"
+	tenant.UpdateSettings(map[string]interface{}{"locale": map[string]interface{}{"language": "en-US"}})
+
+	// Only deleted tenants can be purged
+	err := tenant.Purge()
+	assert.ErrorIs(t, err, ErrTenantNotDeleted)
+
+	tenant.Delete()
+	err = tenant.Purge()
+	assert.NoError(t, err)
+	assert.True(t, tenant.IsPurged())
+	assert.Equal(t, StatusDeleted, tenant.Status)
+	assert.Equal(t, "purged-"+tenant.TenantID.String(), tenant.TenantName)
+	assert.Empty(t, tenant.PrimaryContactEmail)
+	assert.Empty(t, tenant.BillingEmail)
+	assert.Equal(t, NewSettings(), tenant.Settings)
+
+	// Second purge should fail
+	err = tenant.Purge()
+	assert.ErrorIs(t, err, ErrTenantAlreadyPurged)
+}
+
 func TestTenant_ChangePlan(t *testing.T) {
-	tenant, _ := NewTenant("Test Company", "test-company", PlanBasic, "admin@test.com")
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanBasic], "admin@test.com")
 
 	// Change to professional plan
-	err := tenant.ChangePlan(PlanProfessional)
+	err := tenant.ChangePlan(testPlans[PlanProfessional])
 	assert.NoError(t, err)
 	assert.Equal(t, PlanProfessional, tenant.PlanTier)
 	assert.Equal(t, 100, tenant.MaxUsers)
 	assert.Equal(t, 500, tenant.MaxStorageGB)
+	assert.Equal(t, NewSettings(), tenant.Settings)
+
+	// The change is recorded with the quotas before and after
+	changes := tenant.PendingPlanChanges()
+	require.Len(t, changes, 1)
+	assert.Equal(t, PlanBasic, changes[0].FromPlan)
+	assert.Equal(t, PlanProfessional, changes[0].ToPlan)
+	assert.Equal(t, 20, changes[0].FromMaxUsers)
+	assert.Equal(t, 100, changes[0].ToMaxUsers)
+	assert.False(t, changes[0].IsDowngrade())
 
 	// Change to same plan should fail
-	err = tenant.ChangePlan(PlanProfessional)
+	err = tenant.ChangePlan(testPlans[PlanProfessional])
 	assert.ErrorIs(t, err, ErrPlanAlreadySet)
+	assert.Len(t, tenant.PendingPlanChanges(), 1)
+
+	// Downgrade
+	err = tenant.ChangePlan(testPlans[PlanFree])
+	assert.NoError(t, err)
+	assert.True(t, tenant.PendingPlanChanges()[1].IsDowngrade())
 }
 
 func TestFormatSchemaName(t *testing.T) {
@@ -160,7 +229,9 @@ func TestPlanTier_IsValid(t *testing.T) {
 		{PlanBasic, true},
 		{PlanProfessional, true},
 		{PlanEnterprise, true},
-		{PlanTier("invalid"), false},
+		{PlanTier("team-2026"), true},
+		{PlanTier("Invalid Plan"), false},
+		{PlanTier(""), false},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,215 @@
+package domain
+
+import "time"
+
+// MaxTrialDuration is the longest trial that can be handed out
+const MaxTrialDuration = 90 * 24 * time.Hour
+
+// TrialSuspendNotes are the suspension notes of a tenant suspended for
+// billing because its trial ended unconverted
+const TrialSuspendNotes = "trial expired"
+
+// TrialExpiryPolicy decides what happens to a tenant whose trial ends
+// without being converted
+type TrialExpiryPolicy string
+
+const (
+	// TrialExpiryDowngrade moves the tenant to the fallback plan
+	TrialExpiryDowngrade TrialExpiryPolicy = "downgrade"
+	// TrialExpirySuspend suspends the tenant, keeping the trial plan
+	TrialExpirySuspend TrialExpiryPolicy = "suspend"
+)
+
+// IsValid checks if the expiry policy is known
+func (p TrialExpiryPolicy) IsValid() bool {
+	return p == TrialExpiryDowngrade || p == TrialExpirySuspend
+}
+
+// TrialOutcome records how a trial ended
+type TrialOutcome string
+
+const (
+	TrialConverted  TrialOutcome = "converted"
+	TrialDowngraded TrialOutcome = "downgraded"
+	TrialSuspended  TrialOutcome = "suspended"
+)
+
+// Trial is a time-limited plan given to a tenant. A tenant gets one trial;
+// the ended trial stays on the tenant with its outcome.
+type Trial struct {
+	// Plan is the plan on trial, the tenant's plan while the trial runs
+	Plan         PlanTier
+	FallbackPlan PlanTier
+	ExpiryPolicy TrialExpiryPolicy
+	StartedAt    time.Time
+	EndsAt       time.Time
+	// RemindedAt is when the last expiry reminder was sent
+	RemindedAt *time.Time
+	EndedAt    *time.Time
+	Outcome    TrialOutcome
+}
+
+// IsActive checks if the trial has not ended yet
+func (tr *Trial) IsActive() bool {
+	return tr.EndedAt == nil
+}
+
+// Remaining returns how long the trial still runs at now, 0 once it is over
+func (tr *Trial) Remaining(now time.Time) time.Duration {
+	if !tr.IsActive() || !now.Before(tr.EndsAt) {
+		return 0
+	}
+	return tr.EndsAt.Sub(now)
+}
+
+// HasActiveTrial checks if the tenant is on a running trial
+func (t *Tenant) HasActiveTrial() bool {
+	return t.Trial != nil && t.Trial.IsActive()
+}
+
+// StartTrial puts the tenant on a trial of its current plan for duration.
+// An empty policy defaults to TrialExpiryDowngrade.
+func (t *Tenant) StartTrial(fallback *Plan, duration time.Duration, policy TrialExpiryPolicy, now time.Time) error {
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+
+	if t.Trial != nil {
+		return ErrTrialAlreadyUsed
+	}
+
+	if duration <= 0 || duration > MaxTrialDuration {
+		return ErrInvalidTrialDuration
+	}
+
+	if policy == "" {
+		policy = TrialExpiryDowngrade
+	}
+	if !policy.IsValid() {
+		return ErrInvalidTrialExpiryPolicy
+	}
+
+	if err := fallback.checkAssignable(); err != nil {
+		return err
+	}
+	if fallback.Tier == t.PlanTier {
+		return ErrInvalidTrialFallback
+	}
+
+	t.Trial = &Trial{
+		Plan:         t.PlanTier,
+		FallbackPlan: fallback.Tier,
+		ExpiryPolicy: policy,
+		StartedAt:    now,
+		EndsAt:       now.Add(duration),
+	}
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// TrialReminderDue returns the reminder offset due at now, if any. offsets
+// are durations before the trial ends; an offset is due once its time has
+// passed and no reminder was sent since. When several are due, as after an
+// outage, only the latest, the smallest offset, is returned.
+func (t *Tenant) TrialReminderDue(offsets []time.Duration, now time.Time) (time.Duration, bool) {
+	if !t.HasActiveTrial() || !now.Before(t.Trial.EndsAt) {
+		return 0, false
+	}
+
+	due := time.Duration(-1)
+	for _, offset := range offsets {
+		at := t.Trial.EndsAt.Add(-offset)
+		if now.Before(at) {
+			continue
+		}
+		if t.Trial.RemindedAt != nil && !t.Trial.RemindedAt.Before(at) {
+			continue
+		}
+		if due < 0 || offset < due {
+			due = offset
+		}
+	}
+
+	return due, due >= 0
+}
+
+// MarkTrialReminded records that an expiry reminder was sent at now
+func (t *Tenant) MarkTrialReminded(now time.Time) {
+	if t.HasActiveTrial() {
+		t.Trial.RemindedAt = &now
+	}
+}
+
+// TrialExpired checks if the tenant's trial is still running past its end
+func (t *Tenant) TrialExpired(now time.Time) bool {
+	return t.HasActiveTrial() && !now.Before(t.Trial.EndsAt)
+}
+
+// ExpireTrial ends an expired trial according to its expiry policy. A
+// downgrade moves a tenant still on the trial plan to fallback, the trial's
+// fallback plan, and is recorded in the plan history; a suspension only
+// applies to an active tenant.
+func (t *Tenant) ExpireTrial(fallback *Plan, now time.Time) error {
+	if !t.HasActiveTrial() {
+		return ErrNoActiveTrial
+	}
+	if !t.TrialExpired(now) {
+		return ErrTrialNotExpired
+	}
+
+	switch t.Trial.ExpiryPolicy {
+	case TrialExpirySuspend:
+		if t.IsActive() {
+			if err := t.Suspend(SuspensionBilling, TrialSuspendNotes); err != nil {
+				return err
+			}
+		}
+		t.Trial.Outcome = TrialSuspended
+	default:
+		// Only the plan on trial is taken away; a plan chosen since is kept
+		if t.PlanTier == t.Trial.Plan && t.PlanTier != fallback.Tier {
+			if err := t.changePlan(fallback); err != nil {
+				return err
+			}
+		}
+		t.Trial.Outcome = TrialDowngraded
+	}
+
+	t.Trial.EndedAt = &now
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// ConvertTrial ends a running trial early because the customer paid. The
+// tenant keeps its plan.
+func (t *Tenant) ConvertTrial(now time.Time) error {
+	if t.IsDeleted() {
+		return ErrTenantDeleted
+	}
+	if !t.HasActiveTrial() {
+		return ErrNoActiveTrial
+	}
+
+	t.Trial.EndedAt = &now
+	t.Trial.Outcome = TrialConverted
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// trialSnapshot returns the audited state of the trial
+func (t *Tenant) trialSnapshot() map[string]interface{} {
+	if t.Trial == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"plan":          t.Trial.Plan,
+		"fallback_plan": t.Trial.FallbackPlan,
+		"expiry_policy": t.Trial.ExpiryPolicy,
+		"ends_at":       t.Trial.EndsAt,
+		"ended_at":      t.Trial.EndedAt,
+		"outcome":       t.Trial.Outcome,
+	}
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_StartTrial(t *testing.T) {
+	now := time.Now()
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+
+	assert.ErrorIs(t, tenant.StartTrial(testPlans[PlanFree], 0, "", now), ErrInvalidTrialDuration)
+	assert.ErrorIs(t, tenant.StartTrial(testPlans[PlanFree], MaxTrialDuration+time.Hour, "", now), ErrInvalidTrialDuration)
+	assert.ErrorIs(t, tenant.StartTrial(testPlans[PlanFree], 24*time.Hour, "cancel", now), ErrInvalidTrialExpiryPolicy)
+	assert.ErrorIs(t, tenant.StartTrial(testPlans[PlanProfessional], 24*time.Hour, "", now), ErrInvalidTrialFallback)
+
+	require.NoError(t, tenant.StartTrial(testPlans[PlanFree], 14*24*time.Hour, "", now))
+	assert.True(t, tenant.HasActiveTrial())
+	assert.Equal(t, PlanProfessional, tenant.Trial.Plan)
+	assert.Equal(t, TrialExpiryDowngrade, tenant.Trial.ExpiryPolicy)
+	assert.Equal(t, now.Add(14*24*time.Hour), tenant.Trial.EndsAt)
+
+	// One trial per tenant
+	assert.ErrorIs(t, tenant.StartTrial(testPlans[PlanFree], 24*time.Hour, "", now), ErrTrialAlreadyUsed)
+}
+
+func TestTenant_TrialReminderDue(t *testing.T) {
+	start := time.Now()
+	offsets := []time.Duration{7 * 24 * time.Hour, 3 * 24 * time.Hour, 24 * time.Hour}
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.StartTrial(testPlans[PlanFree], 14*24*time.Hour, "", start))
+
+	_, due := tenant.TrialReminderDue(offsets, start.Add(24*time.Hour))
+	assert.False(t, due)
+
+	now := start.Add(8 * 24 * time.Hour)
+	offset, due := tenant.TrialReminderDue(offsets, now)
+	assert.True(t, due)
+	assert.Equal(t, 7*24*time.Hour, offset)
+	tenant.MarkTrialReminded(now)
+
+	_, due = tenant.TrialReminderDue(offsets, now.Add(time.Hour))
+	assert.False(t, due)
+
+	// After an outage only the latest missed reminder is due
+	offset, due = tenant.TrialReminderDue(offsets, start.Add(13*24*time.Hour+time.Hour))
+	assert.True(t, due)
+	assert.Equal(t, 24*time.Hour, offset)
+}
+
+func TestTenant_ExpireTrial(t *testing.T) {
+	start := time.Now()
+	end := start.Add(14 * 24 * time.Hour)
+
+	downgraded, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, downgraded.StartTrial(testPlans[PlanFree], 14*24*time.Hour, TrialExpiryDowngrade, start))
+	assert.ErrorIs(t, downgraded.ExpireTrial(testPlans[PlanFree], start.Add(time.Hour)), ErrTrialNotExpired)
+
+	require.NoError(t, downgraded.ExpireTrial(testPlans[PlanFree], end))
+	assert.Equal(t, PlanFree, downgraded.PlanTier)
+	assert.Equal(t, TrialDowngraded, downgraded.Trial.Outcome)
+	assert.False(t, downgraded.HasActiveTrial())
+	changes := downgraded.PendingPlanChanges()
+	assert.True(t, changes[len(changes)-1].IsDowngrade())
+	assert.ErrorIs(t, downgraded.ExpireTrial(testPlans[PlanFree], end), ErrNoActiveTrial)
+
+	suspended, _ := NewTenant("Other Company", "other-company", testPlans[PlanProfessional], "admin@other.com")
+	require.NoError(t, suspended.CompleteProvisioning())
+	require.NoError(t, suspended.StartTrial(testPlans[PlanFree], 14*24*time.Hour, TrialExpirySuspend, start))
+
+	require.NoError(t, suspended.ExpireTrial(testPlans[PlanFree], end))
+	assert.True(t, suspended.IsSuspended())
+	assert.Equal(t, PlanProfessional, suspended.PlanTier)
+	assert.Equal(t, TrialSuspended, suspended.Trial.Outcome)
+}
+
+func TestTenant_ConvertTrial(t *testing.T) {
+	now := time.Now()
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	assert.ErrorIs(t, tenant.ConvertTrial(now), ErrNoActiveTrial)
+
+	require.NoError(t, tenant.StartTrial(testPlans[PlanFree], 14*24*time.Hour, "", now))
+	require.NoError(t, tenant.ConvertTrial(now.Add(24*time.Hour)))
+	assert.Equal(t, TrialConverted, tenant.Trial.Outcome)
+	assert.Equal(t, PlanProfessional, tenant.PlanTier)
+	assert.False(t, tenant.HasActiveTrial())
+}
+
+func TestTenant_ChangePlanDuringTrial(t *testing.T) {
+	start := time.Now()
+	end := start.Add(14 * 24 * time.Hour)
+
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.CompleteProvisioning())
+	require.NoError(t, tenant.StartTrial(testPlans[PlanFree], 14*24*time.Hour, TrialExpiryDowngrade, start))
+
+	// Upgrading during the trial converts it
+	require.NoError(t, tenant.ChangePlan(testPlans[PlanEnterprise]))
+	assert.False(t, tenant.HasActiveTrial())
+	assert.Equal(t, TrialConverted, tenant.Trial.Outcome)
+
+	// so the expiry no longer downgrades the tenant
+	assert.False(t, tenant.TrialExpired(end))
+	assert.ErrorIs(t, tenant.ExpireTrial(testPlans[PlanFree], end), ErrNoActiveTrial)
+	assert.Equal(t, PlanEnterprise, tenant.PlanTier)
+	assert.Equal(t, 1000, tenant.MaxUsers)
+}
+
+func TestTenant_ExpireTrialKeepsPlanChosenSince(t *testing.T) {
+	start := time.Now()
+	end := start.Add(14 * 24 * time.Hour)
+
+	tenant, _ := NewTenant("Test Company", "test-company", testPlans[PlanProfessional], "admin@test.com")
+	require.NoError(t, tenant.StartTrial(testPlans[PlanFree], 14*24*time.Hour, TrialExpiryDowngrade, start))
+
+	// A plan set without ending the trial, as by a write from before the fix
+	tenant.PlanTier = PlanEnterprise
+
+	require.NoError(t, tenant.ExpireTrial(testPlans[PlanFree], end))
+	assert.Equal(t, PlanEnterprise, tenant.PlanTier)
+	assert.Equal(t, TrialDowngraded, tenant.Trial.Outcome)
+	assert.Empty(t, tenant.PendingPlanChanges())
+}
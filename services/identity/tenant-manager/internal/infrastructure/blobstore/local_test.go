@@ -0,0 +1,44 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLocalStore_RoundTrip(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "tenants/abc/archive.tar.gz", strings.NewReader("payload")))
+
+	rc, err := store.Get(ctx, "tenants/abc/archive.tar.gz")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	rc.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "payload", string(data))
+
+	require.NoError(t, store.Delete(ctx, "tenants/abc/archive.tar.gz"))
+	_, err = store.Get(ctx, "tenants/abc/archive.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Deleting again is a no-op
+	assert.NoError(t, store.Delete(ctx, "tenants/abc/archive.tar.gz"))
+}
+
+func TestLocalStore_RejectsKeysOutsideRoot(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), zap.NewNop())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "..", "../escape", "/etc/passwd", "a/../../escape"} {
+		err := store.Put(context.Background(), key, strings.NewReader("x"))
+		assert.ErrorIs(t, err, errInvalidKey, key)
+	}
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// AdvisoryLocker serializes work across service replicas with PostgreSQL
+// session-level advisory locks
+type AdvisoryLocker struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewAdvisoryLocker creates a new advisory locker
+func NewAdvisoryLocker(db *sqlx.DB, logger *zap.Logger) *AdvisoryLocker {
+	return &AdvisoryLocker{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// TryWithLock runs fn while holding the advisory lock identified by key.
+// It returns false without running fn if another session holds the lock.
+func (l *AdvisoryLocker) TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	// The lock belongs to a session, so lock and unlock on the same connection
+	c, err := l.db.Connx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer c.Close()
+
+	var acquired bool
+	if err := c.GetContext(ctx, &acquired, `SELECT pg_try_advisory_lock($1)`, key); err != nil {
+		return false, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+
+	defer func() {
+		if _, err := c.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+			l.logger.Error("Failed to release advisory lock",
+				zap.Int64("key", key),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return true, fn(ctx)
+}
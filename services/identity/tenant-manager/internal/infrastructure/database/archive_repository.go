@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// ArchiveRepository implements domain.ArchiveRepository
+type ArchiveRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewArchiveRepository creates a new archive repository
+func NewArchiveRepository(db *sqlx.DB, logger *zap.Logger) *ArchiveRepository {
+	return &ArchiveRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// archiveRow represents a database row from the tenant_archives table
+type archiveRow struct {
+	ID         uuid.UUID     `db:"id"`
+	TenantID   uuid.UUID     `db:"tenant_id"`
+	Location   string        `db:"location"`
+	Checksum   string        `db:"checksum"`
+	SizeBytes  int64         `db:"size_bytes"`
+	SchemaName string        `db:"schema_name"`
+	CreatedAt  time.Time     `db:"created_at"`
+	CreatedBy  uuid.NullUUID `db:"created_by"`
+	RestoredAt sql.NullTime  `db:"restored_at"`
+}
+
+// Create stores a new archive record
+func (r *ArchiveRepository) Create(ctx context.Context, archive *domain.TenantArchive) error {
+	query := `
+		INSERT INTO public.tenant_archives (
+			id, tenant_id, location, checksum, size_bytes, schema_name,
+			created_at, created_by, restored_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		archive.ID,
+		archive.TenantID,
+		archive.Location,
+		archive.Checksum,
+		archive.SizeBytes,
+		archive.SchemaName,
+		archive.CreatedAt,
+		archive.CreatedBy,
+		archive.RestoredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create archive record: %w", err)
+	}
+
+	r.logger.Info("Tenant archive recorded",
+		zap.String("tenant_id", archive.TenantID.String()),
+		zap.String("location", archive.Location),
+	)
+
+	return nil
+}
+
+// GetLatest retrieves the most recent archive of a tenant
+func (r *ArchiveRepository) GetLatest(ctx context.Context, tenantID uuid.UUID) (*domain.TenantArchive, error) {
+	query := `
+		SELECT * FROM public.tenant_archives
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	var row archiveRow
+	if err := conn(ctx, r.db).GetContext(ctx, &row, query, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrArchiveNotFound
+		}
+		return nil, fmt.Errorf("failed to get archive: %w", err)
+	}
+
+	return rowToArchive(&row), nil
+}
+
+// ListByTenant retrieves every archive of a tenant, newest first
+func (r *ArchiveRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantArchive, error) {
+	query := `
+		SELECT * FROM public.tenant_archives
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var rows []archiveRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list archives: %w", err)
+	}
+
+	archives := make([]*domain.TenantArchive, 0, len(rows))
+	for i := range rows {
+		archives = append(archives, rowToArchive(&rows[i]))
+	}
+
+	return archives, nil
+}
+
+// Update updates an existing archive record
+func (r *ArchiveRepository) Update(ctx context.Context, archive *domain.TenantArchive) error {
+	query := `UPDATE public.tenant_archives SET restored_at = $1 WHERE id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, archive.RestoredAt, archive.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update archive record: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrArchiveNotFound
+	}
+
+	return nil
+}
+
+// rowToArchive converts a database row to a domain archive record
+func rowToArchive(row *archiveRow) *domain.TenantArchive {
+	archive := &domain.TenantArchive{
+		ID:         row.ID,
+		TenantID:   row.TenantID,
+		Location:   row.Location,
+		Checksum:   row.Checksum,
+		SizeBytes:  row.SizeBytes,
+		SchemaName: row.SchemaName,
+		CreatedAt:  row.CreatedAt,
+	}
+
+	if row.CreatedBy.Valid {
+		createdBy := row.CreatedBy.UUID
+		archive.CreatedBy = &createdBy
+	}
+
+	if row.RestoredAt.Valid {
+		restoredAt := row.RestoredAt.Time
+		archive.RestoredAt = &restoredAt
+	}
+
+	return archive
+}
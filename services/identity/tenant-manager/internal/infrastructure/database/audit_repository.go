@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// AuditRepository implements domain.AuditRepository
+type AuditRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewAuditRepository creates a new audit repository
+func NewAuditRepository(db *sqlx.DB, logger *zap.Logger) *AuditRepository {
+	return &AuditRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// auditEventRow represents a database row from the audit_events table
+type auditEventRow struct {
+	ID         uuid.UUID      `db:"id"`
+	OccurredAt time.Time      `db:"occurred_at"`
+	ActorType  string         `db:"actor_type"`
+	ActorID    string         `db:"actor_id"`
+	ActorName  sql.NullString `db:"actor_name"`
+	Action     string         `db:"action"`
+	TenantID   uuid.NullUUID  `db:"tenant_id"`
+	RequestID  sql.NullString `db:"request_id"`
+	SourceIP   sql.NullString `db:"source_ip"`
+	Changes    []byte         `db:"changes"` // JSONB
+}
+
+// Record appends an audit event. Called within a transaction, the event
+// is committed or rolled back together with the audited change.
+func (r *AuditRepository) Record(ctx context.Context, event *domain.AuditEvent) error {
+	query := `
+		INSERT INTO public.audit_events (
+			id, occurred_at, actor_type, actor_id, actor_name, action,
+			tenant_id, request_id, source_ip, changes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit changes: %w", err)
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, query,
+		event.ID,
+		event.OccurredAt,
+		string(event.ActorType),
+		event.ActorID,
+		event.ActorName,
+		string(event.Action),
+		event.TenantID,
+		event.RequestID,
+		event.SourceIP,
+		changes,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves audit events matching the filter, newest first
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditEvent, int, error) {
+	where := " WHERE 1=1"
+	args := []interface{}{}
+	argPos := 1
+
+	if filter.TenantID != nil {
+		where += fmt.Sprintf(" AND tenant_id = $%d", argPos)
+		args = append(args, *filter.TenantID)
+		argPos++
+	}
+
+	if filter.ActorID != "" {
+		where += fmt.Sprintf(" AND actor_id = $%d", argPos)
+		args = append(args, filter.ActorID)
+		argPos++
+	}
+
+	if filter.Action != "" {
+		where += fmt.Sprintf(" AND action = $%d", argPos)
+		args = append(args, string(filter.Action))
+		argPos++
+	}
+
+	if filter.From != nil {
+		where += fmt.Sprintf(" AND occurred_at >= $%d", argPos)
+		args = append(args, *filter.From)
+		argPos++
+	}
+
+	if filter.To != nil {
+		where += fmt.Sprintf(" AND occurred_at < $%d", argPos)
+		args = append(args, *filter.To)
+		argPos++
+	}
+
+	var total int
+	if err := conn(ctx, r.db).GetContext(ctx, &total, "SELECT COUNT(*) FROM public.audit_events"+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	query := "SELECT * FROM public.audit_events" + where +
+		fmt.Sprintf(" ORDER BY occurred_at DESC LIMIT $%d OFFSET $%d", argPos, argPos+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	var rows []auditEventRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	events := make([]*domain.AuditEvent, 0, len(rows))
+	for i := range rows {
+		events = append(events, r.rowToAuditEvent(&rows[i]))
+	}
+
+	return events, total, nil
+}
+
+func (r *AuditRepository) rowToAuditEvent(row *auditEventRow) *domain.AuditEvent {
+	event := &domain.AuditEvent{
+		ID:         row.ID,
+		OccurredAt: row.OccurredAt,
+		ActorType:  domain.ActorType(row.ActorType),
+		ActorID:    row.ActorID,
+		ActorName:  row.ActorName.String,
+		Action:     domain.AuditAction(row.Action),
+		RequestID:  row.RequestID.String,
+		SourceIP:   row.SourceIP.String,
+	}
+
+	if row.TenantID.Valid {
+		event.TenantID = &row.TenantID.UUID
+	}
+
+	if len(row.Changes) > 0 {
+		if err := json.Unmarshal(row.Changes, &event.Changes); err != nil {
+			r.logger.Warn("Failed to unmarshal audit changes",
+				zap.String("audit_event_id", row.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return event
+}
@@ -0,0 +1,232 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// CustomDomainRepository implements domain.CustomDomainRepository
+type CustomDomainRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewCustomDomainRepository creates a new custom domain repository
+func NewCustomDomainRepository(db *sqlx.DB, logger *zap.Logger) *CustomDomainRepository {
+	return &CustomDomainRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// customDomainRow represents a database row from the tenant_domains table
+type customDomainRow struct {
+	ID                  uuid.UUID      `db:"id"`
+	TenantID            uuid.UUID      `db:"tenant_id"`
+	Hostname            string         `db:"hostname"`
+	Status              string         `db:"status"`
+	VerificationToken   string         `db:"verification_token"`
+	LastCheckedAt       sql.NullTime   `db:"last_checked_at"`
+	ConsecutiveFailures int            `db:"consecutive_failures"`
+	LastError           sql.NullString `db:"last_error"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+	VerifiedAt          sql.NullTime   `db:"verified_at"`
+	RevokedAt           sql.NullTime   `db:"revoked_at"`
+}
+
+// Create registers a custom domain
+func (r *CustomDomainRepository) Create(ctx context.Context, d *domain.CustomDomain) error {
+	query := `
+		INSERT INTO public.tenant_domains (
+			id, tenant_id, hostname, status, verification_token, last_checked_at,
+			consecutive_failures, last_error, created_at, updated_at, verified_at, revoked_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		d.ID,
+		d.TenantID,
+		d.Hostname,
+		string(d.Status),
+		d.VerificationToken,
+		d.LastCheckedAt,
+		d.ConsecutiveFailures,
+		sql.NullString{String: d.LastError, Valid: d.LastError != ""},
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.VerifiedAt,
+		d.RevokedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrDomainAlreadyExists
+		}
+		return fmt.Errorf("failed to create custom domain: %w", err)
+	}
+
+	r.logger.Info("Custom domain registered",
+		zap.String("tenant_id", d.TenantID.String()),
+		zap.String("hostname", d.Hostname),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a custom domain
+func (r *CustomDomainRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CustomDomain, error) {
+	query := `SELECT * FROM public.tenant_domains WHERE id = $1`
+
+	var row customDomainRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDomainNotFound
+		}
+		return nil, fmt.Errorf("failed to get custom domain: %w", err)
+	}
+
+	return rowToCustomDomain(&row), nil
+}
+
+// GetVerified retrieves the verified domain of a hostname
+func (r *CustomDomainRepository) GetVerified(ctx context.Context, hostname string) (*domain.CustomDomain, error) {
+	query := `
+		SELECT * FROM public.tenant_domains
+		WHERE hostname = $1 AND status = $2
+	`
+
+	var row customDomainRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, hostname, string(domain.DomainVerified))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDomainNotFound
+		}
+		return nil, fmt.Errorf("failed to get verified custom domain: %w", err)
+	}
+
+	return rowToCustomDomain(&row), nil
+}
+
+// ListByTenant retrieves every domain of a tenant, revoked or not, newest first
+func (r *CustomDomainRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*domain.CustomDomain, error) {
+	query := `
+		SELECT * FROM public.tenant_domains
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var rows []customDomainRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list custom domains: %w", err)
+	}
+
+	return rowsToCustomDomains(rows), nil
+}
+
+// ListDue retrieves up to limit pending or verified domains not checked
+// since checkedBefore, least recently checked first
+func (r *CustomDomainRepository) ListDue(ctx context.Context, checkedBefore time.Time, limit int) ([]*domain.CustomDomain, error) {
+	query := `
+		SELECT * FROM public.tenant_domains
+		WHERE status IN ($1, $2)
+		  AND (last_checked_at IS NULL OR last_checked_at < $3)
+		ORDER BY last_checked_at ASC NULLS FIRST
+		LIMIT $4
+	`
+
+	var rows []customDomainRow
+	err := conn(ctx, r.db).SelectContext(ctx, &rows, query,
+		string(domain.DomainPending),
+		string(domain.DomainVerified),
+		checkedBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom domains due for verification: %w", err)
+	}
+
+	return rowsToCustomDomains(rows), nil
+}
+
+// Update updates an existing custom domain
+func (r *CustomDomainRepository) Update(ctx context.Context, d *domain.CustomDomain, readStatus domain.DomainStatus) error {
+	query := `
+		UPDATE public.tenant_domains SET
+			status = $1,
+			last_checked_at = $2,
+			consecutive_failures = $3,
+			last_error = $4,
+			updated_at = $5,
+			verified_at = $6,
+			revoked_at = $7
+		WHERE id = $8 AND status = $9
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		string(d.Status),
+		d.LastCheckedAt,
+		d.ConsecutiveFailures,
+		sql.NullString{String: d.LastError, Valid: d.LastError != ""},
+		d.UpdatedAt,
+		d.VerifiedAt,
+		d.RevokedAt,
+		d.ID,
+		string(readStatus),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrDomainAlreadyExists
+		}
+		return fmt.Errorf("failed to update custom domain: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrDomainChanged
+	}
+
+	return nil
+}
+
+// rowsToCustomDomains converts database rows to domain CustomDomains
+func rowsToCustomDomains(rows []customDomainRow) []*domain.CustomDomain {
+	domains := make([]*domain.CustomDomain, 0, len(rows))
+	for i := range rows {
+		domains = append(domains, rowToCustomDomain(&rows[i]))
+	}
+	return domains
+}
+
+// rowToCustomDomain converts a database row to a domain CustomDomain
+func rowToCustomDomain(row *customDomainRow) *domain.CustomDomain {
+	d := &domain.CustomDomain{
+		ID:                  row.ID,
+		TenantID:            row.TenantID,
+		Hostname:            row.Hostname,
+		Status:              domain.DomainStatus(row.Status),
+		VerificationToken:   row.VerificationToken,
+		ConsecutiveFailures: row.ConsecutiveFailures,
+		LastError:           row.LastError.String,
+		CreatedAt:           row.CreatedAt,
+		UpdatedAt:           row.UpdatedAt,
+	}
+	if row.LastCheckedAt.Valid {
+		d.LastCheckedAt = &row.LastCheckedAt.Time
+	}
+	if row.VerifiedAt.Valid {
+		d.VerifiedAt = &row.VerifiedAt.Time
+	}
+	if row.RevokedAt.Valid {
+		d.RevokedAt = &row.RevokedAt.Time
+	}
+	return d
+}
@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// EntitlementRepository implements domain.EntitlementRepository
+type EntitlementRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewEntitlementRepository creates a new entitlement repository
+func NewEntitlementRepository(db *sqlx.DB, logger *zap.Logger) *EntitlementRepository {
+	return &EntitlementRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// entitlementLimitRow represents a database row from the plan_entitlements
+// or tenant_entitlement_limits table
+type entitlementLimitRow struct {
+	Name          string `db:"name"`
+	LimitValue    int64  `db:"limit_value"`
+	WindowType    string `db:"window_type"`
+	PeriodSeconds int64  `db:"period_seconds"`
+}
+
+// ListTenantLimits retrieves the entitlement limits set for a tenant
+func (r *EntitlementRepository) ListTenantLimits(ctx context.Context, tenantID uuid.UUID) ([]*domain.EntitlementLimit, error) {
+	query := `
+		SELECT name, limit_value, window_type, period_seconds
+		FROM public.tenant_entitlement_limits
+		WHERE tenant_id = $1
+		ORDER BY name ASC
+	`
+
+	var rows []entitlementLimitRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list tenant entitlement limits: %w", err)
+	}
+
+	limits := make([]*domain.EntitlementLimit, 0, len(rows))
+	for i := range rows {
+		limits = append(limits, rowToEntitlementLimit(&rows[i]))
+	}
+
+	return limits, nil
+}
+
+// SetTenantLimit creates or replaces an entitlement limit of a tenant
+func (r *EntitlementRepository) SetTenantLimit(ctx context.Context, tenantID uuid.UUID, limit *domain.EntitlementLimit) error {
+	query := `
+		INSERT INTO public.tenant_entitlement_limits (
+			tenant_id, name, limit_value, window_type, period_seconds
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, name) DO UPDATE SET
+			limit_value = EXCLUDED.limit_value,
+			window_type = EXCLUDED.window_type,
+			period_seconds = EXCLUDED.period_seconds,
+			updated_at = NOW()
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		tenantID,
+		limit.Name,
+		limit.Limit,
+		string(limit.Window),
+		int64(limit.Period/time.Second),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set tenant entitlement limit: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTenantLimit removes an entitlement limit of a tenant
+func (r *EntitlementRepository) DeleteTenantLimit(ctx context.Context, tenantID uuid.UUID, name string) error {
+	query := `DELETE FROM public.tenant_entitlement_limits WHERE tenant_id = $1 AND name = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant entitlement limit: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrEntitlementNotFound
+	}
+
+	return nil
+}
+
+// LockUsage takes a transaction-level advisory lock on the tenant's usage of
+// an entitlement, so concurrent consumers are checked one at a time
+func (r *EntitlementRepository) LockUsage(ctx context.Context, tenantID uuid.UUID, name string) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID.String()+":"+name); err != nil {
+		return fmt.Errorf("failed to lock entitlement usage: %w", err)
+	}
+
+	return nil
+}
+
+// SumUsage adds up a tenant's usage of an entitlement counted in buckets
+// starting at or after since. The sum never drops below zero.
+func (r *EntitlementRepository) SumUsage(ctx context.Context, tenantID uuid.UUID, name string, since time.Time) (int64, error) {
+	query := `
+		SELECT GREATEST(COALESCE(SUM(used), 0), 0) FROM public.entitlement_usage
+		WHERE tenant_id = $1 AND name = $2 AND bucket_start >= $3
+	`
+
+	var used int64
+	if err := conn(ctx, r.db).GetContext(ctx, &used, query, tenantID, name, since); err != nil {
+		return 0, fmt.Errorf("failed to sum entitlement usage: %w", err)
+	}
+
+	return used, nil
+}
+
+// usageBucketRow represents a database row from the entitlement_usage table
+type usageBucketRow struct {
+	BucketStart time.Time `db:"bucket_start"`
+	Used        int64     `db:"used"`
+}
+
+// ListUsage retrieves a tenant's usage buckets of an entitlement starting at
+// or after since, newest first
+func (r *EntitlementRepository) ListUsage(ctx context.Context, tenantID uuid.UUID, name string, since time.Time) ([]domain.UsageBucket, error) {
+	query := `
+		SELECT bucket_start, used FROM public.entitlement_usage
+		WHERE tenant_id = $1 AND name = $2 AND bucket_start >= $3
+		ORDER BY bucket_start DESC
+	`
+
+	var rows []usageBucketRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID, name, since); err != nil {
+		return nil, fmt.Errorf("failed to list entitlement usage: %w", err)
+	}
+
+	buckets := make([]domain.UsageBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, domain.UsageBucket{Start: row.BucketStart, Used: row.Used})
+	}
+
+	return buckets, nil
+}
+
+// AddUsage adds delta, which may be negative, to the usage bucket starting at bucket
+func (r *EntitlementRepository) AddUsage(ctx context.Context, tenantID uuid.UUID, name string, bucket time.Time, delta int64) error {
+	query := `
+		INSERT INTO public.entitlement_usage (tenant_id, name, bucket_start, used)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id, name, bucket_start) DO UPDATE SET
+			used = public.entitlement_usage.used + EXCLUDED.used,
+			updated_at = NOW()
+	`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID, name, bucket, delta); err != nil {
+		return fmt.Errorf("failed to add entitlement usage: %w", err)
+	}
+
+	return nil
+}
+
+// rowToEntitlementLimit converts a database row to a domain entitlement limit
+func rowToEntitlementLimit(row *entitlementLimitRow) *domain.EntitlementLimit {
+	return &domain.EntitlementLimit{
+		Name:   row.Name,
+		Limit:  row.LimitValue,
+		Window: domain.EntitlementWindow(row.WindowType),
+		Period: time.Duration(row.PeriodSeconds) * time.Second,
+	}
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// FeatureFlagRepository implements domain.FeatureFlagRepository
+type FeatureFlagRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewFeatureFlagRepository creates a new feature flag repository
+func NewFeatureFlagRepository(db *sqlx.DB, logger *zap.Logger) *FeatureFlagRepository {
+	return &FeatureFlagRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// featureFlagRow represents a database row from the feature_flags table
+type featureFlagRow struct {
+	Key            string    `db:"key"`
+	ValueType      string    `db:"value_type"`
+	DefaultValue   []byte    `db:"default_value"` // JSONB
+	Description    string    `db:"description"`
+	RolloutPercent int       `db:"rollout_percent"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+// List retrieves every registered flag, by key
+func (r *FeatureFlagRepository) List(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	query := `SELECT * FROM public.feature_flags ORDER BY key ASC`
+
+	var rows []featureFlagRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	flags := make([]*domain.FeatureFlag, 0, len(rows))
+	for i := range rows {
+		flag, err := rowToFeatureFlag(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags, nil
+}
+
+// GetByKey retrieves a registered flag
+func (r *FeatureFlagRepository) GetByKey(ctx context.Context, key string) (*domain.FeatureFlag, error) {
+	query := `SELECT * FROM public.feature_flags WHERE key = $1`
+
+	var row featureFlagRow
+	if err := conn(ctx, r.db).GetContext(ctx, &row, query, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrFeatureNotFound
+		}
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+
+	return rowToFeatureFlag(&row)
+}
+
+// Save registers a flag, or replaces the registered flag with its key
+func (r *FeatureFlagRepository) Save(ctx context.Context, flag *domain.FeatureFlag) error {
+	query := `
+		INSERT INTO public.feature_flags (
+			key, value_type, default_value, description, rollout_percent, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (key) DO UPDATE SET
+			value_type = EXCLUDED.value_type,
+			default_value = EXCLUDED.default_value,
+			description = EXCLUDED.description,
+			rollout_percent = EXCLUDED.rollout_percent,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	defaultValue, err := json.Marshal(flag.Default)
+	if err != nil {
+		return fmt.Errorf("failed to encode feature flag default: %w", err)
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, query,
+		flag.Key,
+		string(flag.Type),
+		defaultValue,
+		flag.Description,
+		flag.RolloutPercent,
+		flag.CreatedAt,
+		flag.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save feature flag: %w", err)
+	}
+
+	return nil
+}
+
+// rowToFeatureFlag converts a database row to a domain feature flag
+func rowToFeatureFlag(row *featureFlagRow) (*domain.FeatureFlag, error) {
+	flag := &domain.FeatureFlag{
+		Key:            row.Key,
+		Type:           domain.FeatureType(row.ValueType),
+		Description:    row.Description,
+		RolloutPercent: row.RolloutPercent,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(row.DefaultValue, &flag.Default); err != nil {
+		return nil, fmt.Errorf("failed to decode default of feature flag %s: %w", row.Key, err)
+	}
+
+	return flag, nil
+}
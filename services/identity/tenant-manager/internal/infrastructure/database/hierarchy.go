@@ -0,0 +1,165 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// hierarchyRow is a tenant row with its distance from the tenant a
+// hierarchy query started at
+type hierarchyRow struct {
+	tenantRow
+	Depth int `db:"depth"`
+}
+
+// descendantIDsQuery returns a subquery selecting the tenant_id of every
+// descendant of the tenant in parameter argPos. Recursion stops at
+// domain.MaxHierarchyDepth, so a corrupt hierarchy cannot loop.
+func descendantIDsQuery(argPos int) string {
+	return fmt.Sprintf(`
+		WITH RECURSIVE descendants AS (
+			SELECT tenant_id, 1 AS depth FROM public.tenant_registry WHERE parent_tenant_id = $%d
+			UNION ALL
+			SELECT t.tenant_id, d.depth + 1 FROM public.tenant_registry t
+			JOIN descendants d ON t.parent_tenant_id = d.tenant_id
+			WHERE d.depth < %d
+		)
+		SELECT tenant_id FROM descendants
+	`, argPos, domain.MaxHierarchyDepth)
+}
+
+// ListAncestors retrieves the ancestors of a tenant, root first
+func (r *TenantRepository) ListAncestors(ctx context.Context, tenantID uuid.UUID) ([]*domain.Tenant, error) {
+	ancestors, err := r.listAncestors(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadQuotaOverrides(ctx, ancestors...); err != nil {
+		return nil, err
+	}
+	inheritDownwards(ancestors, time.Now())
+
+	return ancestors, nil
+}
+
+// ListDescendants retrieves the descendants of a tenant, ordered by depth,
+// then by name
+func (r *TenantRepository) ListDescendants(ctx context.Context, tenantID uuid.UUID) ([]*domain.Tenant, error) {
+	query := fmt.Sprintf(`
+		WITH RECURSIVE descendants AS (
+			SELECT t.*, 1 AS depth FROM public.tenant_registry t WHERE t.parent_tenant_id = $1
+			UNION ALL
+			SELECT t.*, d.depth + 1 FROM public.tenant_registry t
+			JOIN descendants d ON t.parent_tenant_id = d.tenant_id
+			WHERE d.depth < %d
+		)
+		SELECT * FROM descendants
+		ORDER BY depth ASC, tenant_name ASC
+	`, domain.MaxHierarchyDepth)
+
+	var rows []hierarchyRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list tenant descendants: %w", err)
+	}
+
+	descendants, err := r.hierarchyRowsToTenants(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadQuotaOverrides(ctx, descendants...); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadInheritedQuotas(ctx, descendants...); err != nil {
+		return nil, err
+	}
+
+	return descendants, nil
+}
+
+// listAncestors retrieves the ancestors of a tenant, root first, without
+// their quotas
+func (r *TenantRepository) listAncestors(ctx context.Context, tenantID uuid.UUID) ([]*domain.Tenant, error) {
+	query := fmt.Sprintf(`
+		WITH RECURSIVE ancestors AS (
+			SELECT p.*, 1 AS depth FROM public.tenant_registry c
+			JOIN public.tenant_registry p ON p.tenant_id = c.parent_tenant_id
+			WHERE c.tenant_id = $1
+			UNION ALL
+			SELECT p.*, a.depth + 1 FROM public.tenant_registry p
+			JOIN ancestors a ON p.tenant_id = a.parent_tenant_id
+			WHERE a.depth < %d
+		)
+		SELECT * FROM ancestors
+		ORDER BY depth DESC
+	`, domain.MaxHierarchyDepth)
+
+	var rows []hierarchyRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list tenant ancestors: %w", err)
+	}
+
+	return r.hierarchyRowsToTenants(rows)
+}
+
+// loadInheritedQuotas gives the tenants that inherit quotas the effective
+// quotas of their parent. The parent's own quotas may be inherited in turn,
+// so the whole chain of ancestors is loaded.
+func (r *TenantRepository) loadInheritedQuotas(ctx context.Context, tenants ...*domain.Tenant) error {
+	now := time.Now()
+	for _, t := range tenants {
+		if !t.InheritQuotas || !t.HasParent() {
+			continue
+		}
+
+		ancestors, err := r.listAncestors(ctx, t.TenantID)
+		if err != nil {
+			return err
+		}
+		if err := r.loadQuotaOverrides(ctx, ancestors...); err != nil {
+			return err
+		}
+
+		inheritDownwards(append(ancestors, t), now)
+	}
+	return nil
+}
+
+// inheritDownwards passes effective quotas down a chain of tenants, root first
+func inheritDownwards(chain []*domain.Tenant, now time.Time) {
+	for i := 1; i < len(chain); i++ {
+		chain[i].InheritQuotasFrom(chain[i-1], now)
+	}
+}
+
+// hierarchyRowsToTenants converts hierarchy rows to domain Tenants
+func (r *TenantRepository) hierarchyRowsToTenants(rows []hierarchyRow) ([]*domain.Tenant, error) {
+	tenants := make([]*domain.Tenant, 0, len(rows))
+	for i := range rows {
+		tenant, err := r.rowToTenant(&rows[i].tenantRow)
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, nil
+}
+
+// LockHierarchy takes a transaction-level advisory lock on the tenant
+// hierarchy, so concurrent moves are checked for cycles and depth one at a
+// time
+func (r *TenantRepository) LockHierarchy(ctx context.Context) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended('tenant_hierarchy', 0))`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to lock tenant hierarchy: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// InvitationRepository implements domain.InvitationRepository
+type InvitationRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewInvitationRepository creates a new invitation repository
+func NewInvitationRepository(db *sqlx.DB, logger *zap.Logger) *InvitationRepository {
+	return &InvitationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// invitationRow represents a database row from the tenant_invitations table
+type invitationRow struct {
+	ID         uuid.UUID    `db:"id"`
+	TenantID   uuid.UUID    `db:"tenant_id"`
+	Email      string       `db:"email"`
+	Role       string       `db:"tenant_role"`
+	Status     string       `db:"status"`
+	TokenHash  string       `db:"token_hash"`
+	ExpiresAt  time.Time    `db:"expires_at"`
+	SendCount  int          `db:"send_count"`
+	LastSentAt time.Time    `db:"last_sent_at"`
+	InvitedBy  *uuid.UUID   `db:"invited_by"`
+	AcceptedBy *uuid.UUID   `db:"accepted_by"`
+	AcceptedAt sql.NullTime `db:"accepted_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+	UpdatedAt  time.Time    `db:"updated_at"`
+}
+
+// Create stores an invitation
+func (r *InvitationRepository) Create(ctx context.Context, inv *domain.Invitation) error {
+	query := `
+		INSERT INTO public.tenant_invitations (
+			id, tenant_id, email, tenant_role, status, token_hash, expires_at, send_count,
+			last_sent_at, invited_by, accepted_by, accepted_at, revoked_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		inv.ID,
+		inv.TenantID,
+		inv.Email,
+		string(inv.Role),
+		string(inv.Status),
+		inv.TokenHash,
+		inv.ExpiresAt,
+		inv.SendCount,
+		inv.LastSentAt,
+		inv.InvitedBy,
+		inv.AcceptedBy,
+		inv.AcceptedAt,
+		inv.RevokedAt,
+		inv.CreatedAt,
+		inv.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrInvitationAlreadyExists
+		}
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an invitation
+func (r *InvitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Invitation, error) {
+	query := `SELECT * FROM public.tenant_invitations WHERE id = $1`
+
+	var row invitationRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	return rowToInvitation(&row), nil
+}
+
+// ListByTenant retrieves the invitations of a tenant, optionally of one
+// stored status, newest first
+func (r *InvitationRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID, status domain.InvitationStatus) ([]*domain.Invitation, error) {
+	query := `
+		SELECT * FROM public.tenant_invitations
+		WHERE tenant_id = $1 AND ($2::text = '' OR status = $2)
+		ORDER BY created_at DESC
+	`
+
+	var rows []invitationRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID, string(status)); err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	invitations := make([]*domain.Invitation, 0, len(rows))
+	for i := range rows {
+		invitations = append(invitations, rowToInvitation(&rows[i]))
+	}
+	return invitations, nil
+}
+
+// Update saves a pending invitation that was resent, revoked or accepted
+func (r *InvitationRepository) Update(ctx context.Context, inv *domain.Invitation, readHash string) error {
+	query := `
+		UPDATE public.tenant_invitations SET
+			status = $1,
+			token_hash = $2,
+			expires_at = $3,
+			send_count = $4,
+			last_sent_at = $5,
+			accepted_by = $6,
+			accepted_at = $7,
+			revoked_at = $8,
+			updated_at = $9
+		WHERE id = $10 AND status = $11 AND token_hash = $12
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		string(inv.Status),
+		inv.TokenHash,
+		inv.ExpiresAt,
+		inv.SendCount,
+		inv.LastSentAt,
+		inv.AcceptedBy,
+		inv.AcceptedAt,
+		inv.RevokedAt,
+		inv.UpdatedAt,
+		inv.ID,
+		string(domain.InvitationPending),
+		readHash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrInvitationNotPending
+	}
+
+	return nil
+}
+
+// DeleteByTenant removes every invitation of a tenant
+func (r *InvitationRepository) DeleteByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	query := `DELETE FROM public.tenant_invitations WHERE tenant_id = $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete invitations: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	return deleted, nil
+}
+
+// rowToInvitation converts a database row to a domain Invitation
+func rowToInvitation(row *invitationRow) *domain.Invitation {
+	inv := &domain.Invitation{
+		ID:         row.ID,
+		TenantID:   row.TenantID,
+		Email:      row.Email,
+		Role:       domain.MemberRole(row.Role),
+		Status:     domain.InvitationStatus(row.Status),
+		TokenHash:  row.TokenHash,
+		ExpiresAt:  row.ExpiresAt,
+		SendCount:  row.SendCount,
+		LastSentAt: row.LastSentAt,
+		InvitedBy:  row.InvitedBy,
+		AcceptedBy: row.AcceptedBy,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+
+	if row.AcceptedAt.Valid {
+		inv.AcceptedAt = &row.AcceptedAt.Time
+	}
+	if row.RevokedAt.Valid {
+		inv.RevokedAt = &row.RevokedAt.Time
+	}
+
+	return inv
+}
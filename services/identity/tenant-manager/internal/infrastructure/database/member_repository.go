@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// MemberRepository implements domain.MemberRepository
+type MemberRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewMemberRepository creates a new member repository
+func NewMemberRepository(db *sqlx.DB, logger *zap.Logger) *MemberRepository {
+	return &MemberRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// memberRow represents a database row from the user_tenant_mapping table
+type memberRow struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"keycloak_user_id"`
+	TenantID  uuid.UUID  `db:"tenant_id"`
+	Role      string     `db:"tenant_role"`
+	IsPrimary bool       `db:"is_primary"`
+	IsActive  bool       `db:"is_active"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	CreatedBy *uuid.UUID `db:"created_by"`
+	UpdatedBy *uuid.UUID `db:"updated_by"`
+}
+
+// memberColumns are the user_tenant_mapping columns read into a memberRow
+const memberColumns = `
+	id, keycloak_user_id, tenant_id, tenant_role, is_primary, is_active,
+	created_at, updated_at, created_by, updated_by
+`
+
+// Create adds a member
+func (r *MemberRepository) Create(ctx context.Context, m *domain.Member) error {
+	query := `
+		INSERT INTO public.user_tenant_mapping (
+			id, keycloak_user_id, tenant_id, tenant_role, is_primary, is_active,
+			created_at, updated_at, created_by, updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		m.ID,
+		m.UserID,
+		m.TenantID,
+		string(m.Role),
+		m.IsPrimary,
+		m.IsActive,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.CreatedBy,
+		m.UpdatedBy,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrMemberAlreadyExists
+		}
+		return fmt.Errorf("failed to create tenant member: %w", err)
+	}
+
+	r.logger.Info("Tenant member added",
+		zap.String("tenant_id", m.TenantID.String()),
+		zap.String("user_id", m.UserID.String()),
+		zap.String("role", string(m.Role)),
+	)
+
+	return nil
+}
+
+// Get retrieves the membership of a user in a tenant, active or not
+func (r *MemberRepository) Get(ctx context.Context, tenantID, userID uuid.UUID) (*domain.Member, error) {
+	query := `
+		SELECT ` + memberColumns + ` FROM public.user_tenant_mapping
+		WHERE tenant_id = $1 AND keycloak_user_id = $2
+	`
+
+	var row memberRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, tenantID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant member: %w", err)
+	}
+
+	return rowToMember(&row), nil
+}
+
+// ListByTenant retrieves the active members of a tenant, oldest first
+func (r *MemberRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*domain.Member, error) {
+	query := `
+		SELECT ` + memberColumns + ` FROM public.user_tenant_mapping
+		WHERE tenant_id = $1 AND is_active = true
+		ORDER BY created_at ASC
+	`
+
+	var rows []memberRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list tenant members: %w", err)
+	}
+
+	return rowsToMembers(rows), nil
+}
+
+// ListByUser retrieves the active memberships of a user, primary first
+func (r *MemberRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Member, error) {
+	query := `
+		SELECT ` + memberColumns + ` FROM public.user_tenant_mapping
+		WHERE keycloak_user_id = $1 AND is_active = true
+		ORDER BY is_primary DESC, created_at ASC
+	`
+
+	var rows []memberRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list user memberships: %w", err)
+	}
+
+	return rowsToMembers(rows), nil
+}
+
+// CountActive counts the active members of a tenant, optionally of one role
+func (r *MemberRepository) CountActive(ctx context.Context, tenantID uuid.UUID, role domain.MemberRole) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM public.user_tenant_mapping
+		WHERE tenant_id = $1 AND is_active = true
+		  AND ($2 = '' OR tenant_role = $2)
+	`
+
+	var count int
+	if err := conn(ctx, r.db).GetContext(ctx, &count, query, tenantID, string(role)); err != nil {
+		return 0, fmt.Errorf("failed to count tenant members: %w", err)
+	}
+
+	return count, nil
+}
+
+// Lock takes a transaction-level advisory lock on the tenant's members, so
+// concurrent changes are checked against the quota and the admin count one
+// at a time
+func (r *MemberRepository) Lock(ctx context.Context, tenantID uuid.UUID) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID.String()+":members"); err != nil {
+		return fmt.Errorf("failed to lock tenant members: %w", err)
+	}
+
+	return nil
+}
+
+// LockUser takes a transaction-level advisory lock on the user's
+// memberships, so the choice of their primary tenant is made one change at a
+// time
+func (r *MemberRepository) LockUser(ctx context.Context, userID uuid.UUID) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, userID.String()+":memberships"); err != nil {
+		return fmt.Errorf("failed to lock user memberships: %w", err)
+	}
+
+	return nil
+}
+
+// Update updates an existing membership
+func (r *MemberRepository) Update(ctx context.Context, m *domain.Member) error {
+	query := `
+		UPDATE public.user_tenant_mapping SET
+			tenant_role = $1,
+			is_primary = $2,
+			is_active = $3,
+			updated_by = $4
+		WHERE id = $5
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		string(m.Role),
+		m.IsPrimary,
+		m.IsActive,
+		m.UpdatedBy,
+		m.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update tenant member: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// rowsToMembers converts database rows to domain Members
+func rowsToMembers(rows []memberRow) []*domain.Member {
+	members := make([]*domain.Member, 0, len(rows))
+	for i := range rows {
+		members = append(members, rowToMember(&rows[i]))
+	}
+	return members
+}
+
+// rowToMember converts a database row to a domain Member
+func rowToMember(row *memberRow) *domain.Member {
+	return &domain.Member{
+		ID:        row.ID,
+		TenantID:  row.TenantID,
+		UserID:    row.UserID,
+		Role:      domain.MemberRole(row.Role),
+		IsPrimary: row.IsPrimary,
+		IsActive:  row.IsActive,
+		CreatedBy: row.CreatedBy,
+		UpdatedBy: row.UpdatedBy,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// planChangeRow represents a database row from the tenant_plan_history table
+type planChangeRow struct {
+	ID               uuid.UUID      `db:"id"`
+	TenantID         uuid.UUID      `db:"tenant_id"`
+	FromPlan         string         `db:"from_plan"`
+	ToPlan           string         `db:"to_plan"`
+	FromMaxUsers     int            `db:"from_max_users"`
+	ToMaxUsers       int            `db:"to_max_users"`
+	FromMaxStorageGB int            `db:"from_max_storage_gb"`
+	ToMaxStorageGB   int            `db:"to_max_storage_gb"`
+	ActorType        string         `db:"actor_type"`
+	ActorID          string         `db:"actor_id"`
+	ActorName        sql.NullString `db:"actor_name"`
+	ChangedAt        time.Time      `db:"changed_at"`
+}
+
+// savePlanChanges appends the tenant's pending plan changes to its plan history
+func (r *TenantRepository) savePlanChanges(ctx context.Context, tenant *domain.Tenant) error {
+	query := `
+		INSERT INTO public.tenant_plan_history (
+			id, tenant_id, from_plan, to_plan, from_max_users, to_max_users,
+			from_max_storage_gb, to_max_storage_gb, actor_type, actor_id, actor_name, changed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	for _, c := range tenant.PendingPlanChanges() {
+		_, err := conn(ctx, r.db).ExecContext(ctx, query,
+			c.ID,
+			c.TenantID,
+			string(c.FromPlan),
+			string(c.ToPlan),
+			c.FromMaxUsers,
+			c.ToMaxUsers,
+			c.FromMaxStorageGB,
+			c.ToMaxStorageGB,
+			string(c.ActorType),
+			c.ActorID,
+			c.ActorName,
+			c.ChangedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record plan change: %w", err)
+		}
+	}
+
+	tenant.ClearPlanChanges()
+
+	return nil
+}
+
+// ListPlanHistory retrieves the plan changes of a tenant, oldest first
+func (r *TenantRepository) ListPlanHistory(ctx context.Context, tenantID uuid.UUID) ([]*domain.PlanChange, error) {
+	query := `
+		SELECT * FROM public.tenant_plan_history
+		WHERE tenant_id = $1
+		ORDER BY changed_at ASC
+	`
+
+	var rows []planChangeRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list plan history: %w", err)
+	}
+
+	changes := make([]*domain.PlanChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, &domain.PlanChange{
+			ID:               row.ID,
+			TenantID:         row.TenantID,
+			FromPlan:         domain.PlanTier(row.FromPlan),
+			ToPlan:           domain.PlanTier(row.ToPlan),
+			FromMaxUsers:     row.FromMaxUsers,
+			ToMaxUsers:       row.ToMaxUsers,
+			FromMaxStorageGB: row.FromMaxStorageGB,
+			ToMaxStorageGB:   row.ToMaxStorageGB,
+			ActorType:        domain.ActorType(row.ActorType),
+			ActorID:          row.ActorID,
+			ActorName:        row.ActorName.String,
+			ChangedAt:        row.ChangedAt,
+		})
+	}
+
+	return changes, nil
+}
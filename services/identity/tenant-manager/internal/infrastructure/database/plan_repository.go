@@ -0,0 +1,234 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// PlanRepository implements domain.PlanRepository
+type PlanRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewPlanRepository creates a new plan repository
+func NewPlanRepository(db *sqlx.DB, logger *zap.Logger) *PlanRepository {
+	return &PlanRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// planRow represents a database row from the plans table
+type planRow struct {
+	Tier         string         `db:"tier"`
+	DisplayName  string         `db:"display_name"`
+	Description  sql.NullString `db:"description"`
+	MaxUsers     int            `db:"max_users"`
+	MaxStorageGB int            `db:"max_storage_gb"`
+	Features     []byte         `db:"features"` // JSONB
+	SortOrder    int            `db:"sort_order"`
+	RetiredAt    sql.NullTime   `db:"retired_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+// Create adds a plan and its entitlements to the catalog. Call it within a
+// transaction when the plan has entitlements.
+func (r *PlanRepository) Create(ctx context.Context, plan *domain.Plan) error {
+	query := `
+		INSERT INTO public.plans (
+			tier, display_name, description, max_users, max_storage_gb,
+			features, sort_order, retired_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	features, _ := json.Marshal(plan.Features)
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		string(plan.Tier),
+		plan.DisplayName,
+		plan.Description,
+		plan.MaxUsers,
+		plan.MaxStorageGB,
+		features,
+		plan.SortOrder,
+		plan.RetiredAt,
+		plan.CreatedAt,
+		plan.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrPlanAlreadyExists
+		}
+		return fmt.Errorf("failed to create plan: %w", err)
+	}
+
+	entitlementQuery := `
+		INSERT INTO public.plan_entitlements (
+			plan_tier, name, limit_value, window_type, period_seconds
+		) VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for _, l := range plan.Entitlements {
+		_, err := conn(ctx, r.db).ExecContext(ctx, entitlementQuery,
+			string(plan.Tier),
+			l.Name,
+			l.Limit,
+			string(l.Window),
+			int64(l.Period/time.Second),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create plan entitlement %s: %w", l.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// GetByTier retrieves a plan, retired or not
+func (r *PlanRepository) GetByTier(ctx context.Context, tier domain.PlanTier) (*domain.Plan, error) {
+	query := `SELECT * FROM public.plans WHERE tier = $1`
+
+	var row planRow
+	if err := r.db.GetContext(ctx, &row, query, string(tier)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPlanNotFound
+		}
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	plan := r.rowToPlan(&row)
+	if err := r.loadEntitlements(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// List retrieves every plan, retired or not, in display order
+func (r *PlanRepository) List(ctx context.Context) ([]*domain.Plan, error) {
+	query := `SELECT * FROM public.plans ORDER BY sort_order ASC, tier ASC`
+
+	var rows []planRow
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	plans := make([]*domain.Plan, 0, len(rows))
+	for i := range rows {
+		plans = append(plans, r.rowToPlan(&rows[i]))
+	}
+
+	if err := r.loadEntitlements(ctx, plans...); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// Update updates an existing plan. Only retirement can change.
+func (r *PlanRepository) Update(ctx context.Context, plan *domain.Plan) error {
+	query := `
+		UPDATE public.plans SET
+			retired_at = $1,
+			updated_at = $2
+		WHERE tier = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		plan.RetiredAt,
+		plan.UpdatedAt,
+		string(plan.Tier),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update plan: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrPlanNotFound
+	}
+
+	return nil
+}
+
+// planEntitlementRow represents a database row from the plan_entitlements table
+type planEntitlementRow struct {
+	PlanTier string `db:"plan_tier"`
+	entitlementLimitRow
+}
+
+// loadEntitlements attaches their entitlements to plans with a single query
+func (r *PlanRepository) loadEntitlements(ctx context.Context, plans ...*domain.Plan) error {
+	if len(plans) == 0 {
+		return nil
+	}
+
+	byTier := make(map[domain.PlanTier]*domain.Plan, len(plans))
+	tiers := make([]string, 0, len(plans))
+	for _, p := range plans {
+		byTier[p.Tier] = p
+		tiers = append(tiers, string(p.Tier))
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT plan_tier, name, limit_value, window_type, period_seconds
+		FROM public.plan_entitlements
+		WHERE plan_tier IN (?)
+		ORDER BY name ASC
+	`, tiers)
+	if err != nil {
+		return fmt.Errorf("failed to build plan entitlement query: %w", err)
+	}
+
+	var rows []planEntitlementRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to load plan entitlements: %w", err)
+	}
+
+	for i := range rows {
+		if p, ok := byTier[domain.PlanTier(rows[i].PlanTier)]; ok {
+			p.Entitlements = append(p.Entitlements, rowToEntitlementLimit(&rows[i].entitlementLimitRow))
+		}
+	}
+
+	return nil
+}
+
+// rowToPlan converts a database row to a domain plan
+func (r *PlanRepository) rowToPlan(row *planRow) *domain.Plan {
+	plan := &domain.Plan{
+		Tier:         domain.PlanTier(row.Tier),
+		DisplayName:  row.DisplayName,
+		Description:  row.Description.String,
+		MaxUsers:     row.MaxUsers,
+		MaxStorageGB: row.MaxStorageGB,
+		SortOrder:    row.SortOrder,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+
+	if row.RetiredAt.Valid {
+		plan.RetiredAt = &row.RetiredAt.Time
+	}
+
+	if len(row.Features) > 0 {
+		if err := json.Unmarshal(row.Features, &plan.Features); err != nil {
+			r.logger.Warn("Failed to unmarshal plan features", zap.String("plan", row.Tier), zap.Error(err))
+		}
+	}
+	if plan.Features == nil {
+		plan.Features = make(map[string]interface{})
+	}
+
+	return plan
+}
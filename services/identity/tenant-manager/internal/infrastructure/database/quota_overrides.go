@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// quotaOverrideRow represents a database row from the tenant_quota_overrides table
+type quotaOverrideRow struct {
+	TenantID  uuid.UUID      `db:"tenant_id"`
+	QuotaName string         `db:"quota_name"`
+	Value     int            `db:"value"`
+	Reason    string         `db:"reason"`
+	ExpiresAt sql.NullTime   `db:"expires_at"`
+	ActorType string         `db:"actor_type"`
+	ActorID   string         `db:"actor_id"`
+	ActorName sql.NullString `db:"actor_name"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+// loadQuotaOverrides attaches their quota overrides to tenants with a single query
+func (r *TenantRepository) loadQuotaOverrides(ctx context.Context, tenants ...*domain.Tenant) error {
+	if len(tenants) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*domain.Tenant, len(tenants))
+	ids := make([]uuid.UUID, 0, len(tenants))
+	for _, t := range tenants {
+		byID[t.TenantID] = t
+		ids = append(ids, t.TenantID)
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT * FROM public.tenant_quota_overrides
+		WHERE tenant_id IN (?)
+		ORDER BY quota_name ASC
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build quota override query: %w", err)
+	}
+
+	var rows []quotaOverrideRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to load quota overrides: %w", err)
+	}
+
+	for _, row := range rows {
+		t, ok := byID[row.TenantID]
+		if !ok {
+			continue
+		}
+		override := &domain.QuotaOverride{
+			TenantID:  row.TenantID,
+			Name:      domain.QuotaName(row.QuotaName),
+			Value:     row.Value,
+			Reason:    row.Reason,
+			ActorType: domain.ActorType(row.ActorType),
+			ActorID:   row.ActorID,
+			ActorName: row.ActorName.String,
+			CreatedAt: row.CreatedAt,
+		}
+		if row.ExpiresAt.Valid {
+			override.ExpiresAt = &row.ExpiresAt.Time
+		}
+		t.QuotaOverrides = append(t.QuotaOverrides, override)
+	}
+
+	return nil
+}
+
+// saveQuotaOverrides writes the overrides of the tenant's changed quotas,
+// deleting those that were removed
+func (r *TenantRepository) saveQuotaOverrides(ctx context.Context, tenant *domain.Tenant) error {
+	upsert := `
+		INSERT INTO public.tenant_quota_overrides (
+			tenant_id, quota_name, value, reason, expires_at,
+			actor_type, actor_id, actor_name, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (tenant_id, quota_name) DO UPDATE SET
+			value = EXCLUDED.value,
+			reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at,
+			actor_type = EXCLUDED.actor_type,
+			actor_id = EXCLUDED.actor_id,
+			actor_name = EXCLUDED.actor_name,
+			created_at = EXCLUDED.created_at
+	`
+	remove := `DELETE FROM public.tenant_quota_overrides WHERE tenant_id = $1 AND quota_name = $2`
+
+	for _, name := range tenant.PendingQuotaChanges() {
+		o := tenant.QuotaOverride(name)
+		if o == nil {
+			if _, err := conn(ctx, r.db).ExecContext(ctx, remove, tenant.TenantID, string(name)); err != nil {
+				return fmt.Errorf("failed to delete quota override: %w", err)
+			}
+			continue
+		}
+
+		_, err := conn(ctx, r.db).ExecContext(ctx, upsert,
+			o.TenantID,
+			string(o.Name),
+			o.Value,
+			o.Reason,
+			o.ExpiresAt,
+			string(o.ActorType),
+			o.ActorID,
+			o.ActorName,
+			o.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save quota override: %w", err)
+		}
+	}
+
+	tenant.ClearQuotaChanges()
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// ScheduledOperationRepository implements domain.ScheduledOperationRepository
+type ScheduledOperationRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewScheduledOperationRepository creates a new scheduled operation repository
+func NewScheduledOperationRepository(db *sqlx.DB, logger *zap.Logger) *ScheduledOperationRepository {
+	return &ScheduledOperationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// scheduledOperationRow represents a database row from the
+// tenant_scheduled_operations table
+type scheduledOperationRow struct {
+	ID                 uuid.UUID      `db:"id"`
+	TenantID           uuid.UUID      `db:"tenant_id"`
+	Action             string         `db:"action"`
+	SuspensionReason   sql.NullString `db:"suspension_reason"`
+	Notes              sql.NullString `db:"notes"`
+	RunAt              time.Time      `db:"run_at"`
+	ReactivateAfterSec int64          `db:"reactivate_after_seconds"`
+	FollowsID          *uuid.UUID     `db:"follows_operation_id"`
+	Status             string         `db:"status"`
+	Error              sql.NullString `db:"error"`
+	CreatedBy          *uuid.UUID     `db:"created_by"`
+	CreatedAt          time.Time      `db:"created_at"`
+	UpdatedAt          time.Time      `db:"updated_at"`
+	ExecutedAt         sql.NullTime   `db:"executed_at"`
+	CanceledAt         sql.NullTime   `db:"canceled_at"`
+}
+
+// Create schedules an operation
+func (r *ScheduledOperationRepository) Create(ctx context.Context, op *domain.ScheduledOperation) error {
+	query := `
+		INSERT INTO public.tenant_scheduled_operations (
+			id, tenant_id, action, suspension_reason, notes, run_at, reactivate_after_seconds,
+			follows_operation_id, status, error, created_by, created_at, updated_at, executed_at, canceled_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		op.ID,
+		op.TenantID,
+		string(op.Action),
+		sql.NullString{String: string(op.SuspensionReason), Valid: op.SuspensionReason != ""},
+		sql.NullString{String: op.Notes, Valid: op.Notes != ""},
+		op.RunAt,
+		int64(op.ReactivateAfter/time.Second),
+		op.FollowsID,
+		string(op.Status),
+		sql.NullString{String: op.Error, Valid: op.Error != ""},
+		op.CreatedBy,
+		op.CreatedAt,
+		op.UpdatedAt,
+		op.ExecutedAt,
+		op.CanceledAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled operation: %w", err)
+	}
+
+	r.logger.Info("Tenant operation scheduled",
+		zap.String("tenant_id", op.TenantID.String()),
+		zap.String("action", string(op.Action)),
+		zap.Time("run_at", op.RunAt),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a scheduled operation
+func (r *ScheduledOperationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ScheduledOperation, error) {
+	query := `SELECT * FROM public.tenant_scheduled_operations WHERE id = $1`
+
+	var row scheduledOperationRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrOperationNotFound
+		}
+		return nil, fmt.Errorf("failed to get scheduled operation: %w", err)
+	}
+
+	return rowToScheduledOperation(&row), nil
+}
+
+// ListByTenant retrieves the operations of a tenant, optionally of one
+// status, by run time, latest first
+func (r *ScheduledOperationRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID, status domain.OperationStatus) ([]*domain.ScheduledOperation, error) {
+	query := `
+		SELECT * FROM public.tenant_scheduled_operations
+		WHERE tenant_id = $1 AND ($2::text = '' OR status = $2)
+		ORDER BY run_at DESC
+	`
+
+	var rows []scheduledOperationRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID, string(status)); err != nil {
+		return nil, fmt.Errorf("failed to list scheduled operations: %w", err)
+	}
+
+	return rowsToScheduledOperations(rows), nil
+}
+
+// ListDue retrieves up to limit pending operations whose run time is not
+// after now, earliest first
+func (r *ScheduledOperationRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.ScheduledOperation, error) {
+	query := `
+		SELECT * FROM public.tenant_scheduled_operations
+		WHERE status = $1 AND run_at <= $2
+		ORDER BY run_at ASC
+		LIMIT $3
+	`
+
+	var rows []scheduledOperationRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, string(domain.OperationPending), now, limit); err != nil {
+		return nil, fmt.Errorf("failed to list due scheduled operations: %w", err)
+	}
+
+	return rowsToScheduledOperations(rows), nil
+}
+
+// Update saves a pending operation that completed, failed or was canceled
+func (r *ScheduledOperationRepository) Update(ctx context.Context, op *domain.ScheduledOperation) error {
+	query := `
+		UPDATE public.tenant_scheduled_operations SET
+			status = $1,
+			error = $2,
+			updated_at = $3,
+			executed_at = $4,
+			canceled_at = $5
+		WHERE id = $6 AND status = $7
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		string(op.Status),
+		sql.NullString{String: op.Error, Valid: op.Error != ""},
+		op.UpdatedAt,
+		op.ExecutedAt,
+		op.CanceledAt,
+		op.ID,
+		string(domain.OperationPending),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled operation: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrOperationNotPending
+	}
+
+	return nil
+}
+
+// rowsToScheduledOperations converts database rows to domain ScheduledOperations
+func rowsToScheduledOperations(rows []scheduledOperationRow) []*domain.ScheduledOperation {
+	ops := make([]*domain.ScheduledOperation, 0, len(rows))
+	for i := range rows {
+		ops = append(ops, rowToScheduledOperation(&rows[i]))
+	}
+	return ops
+}
+
+// rowToScheduledOperation converts a database row to a domain ScheduledOperation
+func rowToScheduledOperation(row *scheduledOperationRow) *domain.ScheduledOperation {
+	op := &domain.ScheduledOperation{
+		ID:               row.ID,
+		TenantID:         row.TenantID,
+		Action:           domain.LifecycleAction(row.Action),
+		SuspensionReason: domain.SuspensionReason(row.SuspensionReason.String),
+		Notes:            row.Notes.String,
+		RunAt:            row.RunAt,
+		ReactivateAfter:  time.Duration(row.ReactivateAfterSec) * time.Second,
+		FollowsID:        row.FollowsID,
+		Status:           domain.OperationStatus(row.Status),
+		Error:            row.Error.String,
+		CreatedBy:        row.CreatedBy,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+	}
+	if row.ExecutedAt.Valid {
+		op.ExecutedAt = &row.ExecutedAt.Time
+	}
+	if row.CanceledAt.Valid {
+		op.CanceledAt = &row.CanceledAt.Time
+	}
+	return op
+}
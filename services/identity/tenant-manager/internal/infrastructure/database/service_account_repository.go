@@ -0,0 +1,331 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations
+const uniqueViolation = "23505"
+
+// ServiceAccountRepository implements domain.ServiceAccountRepository
+type ServiceAccountRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewServiceAccountRepository creates a new service account repository
+func NewServiceAccountRepository(db *sqlx.DB, logger *zap.Logger) *ServiceAccountRepository {
+	return &ServiceAccountRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// serviceAccountRow represents a database row from the service_accounts table
+type serviceAccountRow struct {
+	ID          uuid.UUID      `db:"id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+	TenantID    uuid.NullUUID  `db:"tenant_id"`
+	IsActive    bool           `db:"is_active"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+	CreatedBy   uuid.NullUUID  `db:"created_by"`
+}
+
+// apiKeyRow represents a database row from the service_account_api_keys table
+type apiKeyRow struct {
+	ID               uuid.UUID     `db:"id"`
+	ServiceAccountID uuid.UUID     `db:"service_account_id"`
+	Name             string        `db:"name"`
+	KeyPrefix        string        `db:"key_prefix"`
+	KeyHash          string        `db:"key_hash"`
+	Permissions      []byte        `db:"permissions"` // JSONB
+	ExpiresAt        sql.NullTime  `db:"expires_at"`
+	LastUsedAt       sql.NullTime  `db:"last_used_at"`
+	RevokedAt        sql.NullTime  `db:"revoked_at"`
+	CreatedAt        time.Time     `db:"created_at"`
+	CreatedBy        uuid.NullUUID `db:"created_by"`
+}
+
+// Create creates a new service account
+func (r *ServiceAccountRepository) Create(ctx context.Context, account *domain.ServiceAccount) error {
+	query := `
+		INSERT INTO public.service_accounts (
+			id, name, description, tenant_id, is_active, created_at, updated_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		account.ID,
+		account.Name,
+		account.Description,
+		account.TenantID,
+		account.IsActive,
+		account.CreatedAt,
+		account.UpdatedAt,
+		account.CreatedBy,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrServiceAccountExists
+		}
+		return fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	r.logger.Info("Service account created",
+		zap.String("service_account_id", account.ID.String()),
+		zap.String("name", account.Name),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a service account by ID
+func (r *ServiceAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ServiceAccount, error) {
+	query := `SELECT * FROM public.service_accounts WHERE id = $1`
+
+	var row serviceAccountRow
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrServiceAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	return rowToServiceAccount(&row), nil
+}
+
+// List retrieves all service accounts
+func (r *ServiceAccountRepository) List(ctx context.Context) ([]*domain.ServiceAccount, error) {
+	query := `SELECT * FROM public.service_accounts ORDER BY created_at DESC`
+
+	var rows []serviceAccountRow
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	accounts := make([]*domain.ServiceAccount, 0, len(rows))
+	for i := range rows {
+		accounts = append(accounts, rowToServiceAccount(&rows[i]))
+	}
+
+	return accounts, nil
+}
+
+// Update updates an existing service account
+func (r *ServiceAccountRepository) Update(ctx context.Context, account *domain.ServiceAccount) error {
+	query := `
+		UPDATE public.service_accounts SET
+			description = $1,
+			is_active = $2,
+			updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		account.Description,
+		account.IsActive,
+		account.UpdatedAt,
+		account.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update service account: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrServiceAccountNotFound
+	}
+
+	return nil
+}
+
+// CreateAPIKey stores a new API key
+func (r *ServiceAccountRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	query := `
+		INSERT INTO public.service_account_api_keys (
+			id, service_account_id, name, key_prefix, key_hash, permissions,
+			expires_at, created_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	permissions, _ := json.Marshal(key.Permissions)
+
+	_, err := r.db.ExecContext(ctx, query,
+		key.ID,
+		key.ServiceAccountID,
+		key.Name,
+		key.KeyPrefix,
+		key.KeyHash,
+		permissions,
+		key.ExpiresAt,
+		key.CreatedAt,
+		key.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	r.logger.Info("API key issued",
+		zap.String("service_account_id", key.ServiceAccountID.String()),
+		zap.String("key_prefix", key.KeyPrefix),
+	)
+
+	return nil
+}
+
+// GetAPIKey retrieves an API key of a service account
+func (r *ServiceAccountRepository) GetAPIKey(ctx context.Context, serviceAccountID, keyID uuid.UUID) (*domain.APIKey, error) {
+	query := `SELECT * FROM public.service_account_api_keys WHERE id = $1 AND service_account_id = $2`
+
+	var row apiKeyRow
+	if err := r.db.GetContext(ctx, &row, query, keyID, serviceAccountID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	return r.rowToAPIKey(&row), nil
+}
+
+// GetAPIKeyByPrefix retrieves an API key by its lookup prefix
+func (r *ServiceAccountRepository) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	query := `SELECT * FROM public.service_account_api_keys WHERE key_prefix = $1`
+
+	var row apiKeyRow
+	if err := r.db.GetContext(ctx, &row, query, prefix); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get API key by prefix: %w", err)
+	}
+
+	return r.rowToAPIKey(&row), nil
+}
+
+// ListAPIKeys retrieves the API keys of a service account
+func (r *ServiceAccountRepository) ListAPIKeys(ctx context.Context, serviceAccountID uuid.UUID) ([]*domain.APIKey, error) {
+	query := `
+		SELECT * FROM public.service_account_api_keys
+		WHERE service_account_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var rows []apiKeyRow
+	if err := r.db.SelectContext(ctx, &rows, query, serviceAccountID); err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	keys := make([]*domain.APIKey, 0, len(rows))
+	for i := range rows {
+		keys = append(keys, r.rowToAPIKey(&rows[i]))
+	}
+
+	return keys, nil
+}
+
+// UpdateAPIKey updates revocation of an API key
+func (r *ServiceAccountRepository) UpdateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	query := `UPDATE public.service_account_api_keys SET revoked_at = $1 WHERE id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, key.RevokedAt, key.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update API key: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchAPIKey records the last time an API key was used
+func (r *ServiceAccountRepository) TouchAPIKey(ctx context.Context, keyID uuid.UUID, usedAt time.Time) error {
+	query := `
+		UPDATE public.service_account_api_keys SET last_used_at = $1
+		WHERE id = $2 AND (last_used_at IS NULL OR last_used_at < $1)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, usedAt, keyID); err != nil {
+		return fmt.Errorf("failed to record API key usage: %w", err)
+	}
+
+	return nil
+}
+
+func rowToServiceAccount(row *serviceAccountRow) *domain.ServiceAccount {
+	account := &domain.ServiceAccount{
+		ID:        row.ID,
+		Name:      row.Name,
+		IsActive:  row.IsActive,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+
+	if row.Description.Valid {
+		account.Description = row.Description.String
+	}
+	if row.TenantID.Valid {
+		account.TenantID = &row.TenantID.UUID
+	}
+	if row.CreatedBy.Valid {
+		account.CreatedBy = &row.CreatedBy.UUID
+	}
+
+	return account
+}
+
+func (r *ServiceAccountRepository) rowToAPIKey(row *apiKeyRow) *domain.APIKey {
+	key := &domain.APIKey{
+		ID:               row.ID,
+		ServiceAccountID: row.ServiceAccountID,
+		Name:             row.Name,
+		KeyPrefix:        row.KeyPrefix,
+		KeyHash:          row.KeyHash,
+		CreatedAt:        row.CreatedAt,
+	}
+
+	// Parse JSONB permissions; a corrupt value grants nothing
+	if len(row.Permissions) > 0 {
+		if err := json.Unmarshal(row.Permissions, &key.Permissions); err != nil {
+			r.logger.Warn("Failed to unmarshal API key permissions", zap.Error(err))
+			key.Permissions = nil
+		}
+	}
+
+	if row.ExpiresAt.Valid {
+		key.ExpiresAt = &row.ExpiresAt.Time
+	}
+	if row.LastUsedAt.Valid {
+		key.LastUsedAt = &row.LastUsedAt.Time
+	}
+	if row.RevokedAt.Valid {
+		key.RevokedAt = &row.RevokedAt.Time
+	}
+	if row.CreatedBy.Valid {
+		key.CreatedBy = &row.CreatedBy.UUID
+	}
+
+	return key
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
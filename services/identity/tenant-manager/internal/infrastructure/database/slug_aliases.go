@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// slugAliasRow represents a database row from the tenant_slug_aliases table
+type slugAliasRow struct {
+	Slug       string       `db:"slug"`
+	TenantID   uuid.UUID    `db:"tenant_id"`
+	CreatedAt  time.Time    `db:"created_at"`
+	ReleasedAt sql.NullTime `db:"released_at"`
+}
+
+// saveSlugAliases writes the tenant's former slugs as aliases. An alias
+// row is taken over when the slug was an expired alias of another tenant,
+// and the new canonical slug stops being an alias.
+func (r *TenantRepository) saveSlugAliases(ctx context.Context, tenant *domain.Tenant) error {
+	aliases := tenant.PendingSlugAliases()
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO public.tenant_slug_aliases (slug, tenant_id, created_at, released_at)
+		VALUES ($1, $2, $3, NULL)
+		ON CONFLICT (slug) DO UPDATE SET
+			tenant_id = EXCLUDED.tenant_id,
+			created_at = EXCLUDED.created_at,
+			released_at = NULL
+	`
+
+	for _, a := range aliases {
+		if _, err := conn(ctx, r.db).ExecContext(ctx, query, a.Slug, a.TenantID, a.CreatedAt); err != nil {
+			return fmt.Errorf("failed to record slug alias: %w", err)
+		}
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM public.tenant_slug_aliases WHERE slug = $1`,
+		tenant.TenantSlug,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove claimed slug alias: %w", err)
+	}
+
+	tenant.ClearSlugAliases()
+
+	return nil
+}
+
+// getBySlugAlias retrieves the tenant an unreleased alias resolves to
+func (r *TenantRepository) getBySlugAlias(ctx context.Context, slug string) (*domain.Tenant, error) {
+	query := `
+		SELECT t.* FROM public.tenant_registry t
+		JOIN public.tenant_slug_aliases a ON a.tenant_id = t.tenant_id
+		WHERE a.slug = $1 AND a.released_at IS NULL
+	`
+
+	var row tenantRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant by slug alias: %w", err)
+	}
+
+	return r.getTenant(ctx, &row)
+}
+
+// GetSlugAlias retrieves an alias, released or not
+func (r *TenantRepository) GetSlugAlias(ctx context.Context, slug string) (*domain.SlugAlias, error) {
+	query := `SELECT * FROM public.tenant_slug_aliases WHERE slug = $1`
+
+	var row slugAliasRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSlugAliasNotFound
+		}
+		return nil, fmt.Errorf("failed to get slug alias: %w", err)
+	}
+
+	return rowToSlugAlias(&row), nil
+}
+
+// ListSlugAliases retrieves the aliases of a tenant, released or not, oldest first
+func (r *TenantRepository) ListSlugAliases(ctx context.Context, tenantID uuid.UUID) ([]*domain.SlugAlias, error) {
+	query := `
+		SELECT * FROM public.tenant_slug_aliases
+		WHERE tenant_id = $1
+		ORDER BY created_at ASC
+	`
+
+	var rows []slugAliasRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list slug aliases: %w", err)
+	}
+
+	aliases := make([]*domain.SlugAlias, 0, len(rows))
+	for i := range rows {
+		aliases = append(aliases, rowToSlugAlias(&rows[i]))
+	}
+
+	return aliases, nil
+}
+
+// UpdateSlugAlias writes the release of an alias
+func (r *TenantRepository) UpdateSlugAlias(ctx context.Context, alias *domain.SlugAlias) error {
+	query := `
+		UPDATE public.tenant_slug_aliases SET released_at = $1
+		WHERE slug = $2 AND tenant_id = $3
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, alias.ReleasedAt, alias.Slug, alias.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to update slug alias: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrSlugAliasNotFound
+	}
+
+	return nil
+}
+
+// rowToSlugAlias converts a database row to a domain SlugAlias
+func rowToSlugAlias(row *slugAliasRow) *domain.SlugAlias {
+	alias := &domain.SlugAlias{
+		Slug:      row.Slug,
+		TenantID:  row.TenantID,
+		CreatedAt: row.CreatedAt,
+	}
+	if row.ReleasedAt.Valid {
+		alias.ReleasedAt = &row.ReleasedAt.Time
+	}
+	return alias
+}
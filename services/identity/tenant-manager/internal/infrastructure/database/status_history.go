@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// statusTransitionRow represents a database row from the tenant_status_history table
+type statusTransitionRow struct {
+	ID         uuid.UUID      `db:"id"`
+	TenantID   uuid.UUID      `db:"tenant_id"`
+	Action     string         `db:"action"`
+	FromStatus sql.NullString `db:"from_status"`
+	ToStatus   string         `db:"to_status"`
+	Reason     sql.NullString `db:"reason"`
+	ActorType  string         `db:"actor_type"`
+	ActorID    string         `db:"actor_id"`
+	ActorName  sql.NullString `db:"actor_name"`
+	OccurredAt time.Time      `db:"occurred_at"`
+}
+
+// saveTransitions appends the tenant's pending status transitions to its history
+func (r *TenantRepository) saveTransitions(ctx context.Context, tenant *domain.Tenant) error {
+	query := `
+		INSERT INTO public.tenant_status_history (
+			id, tenant_id, action, from_status, to_status, reason,
+			actor_type, actor_id, actor_name, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	for _, tr := range tenant.PendingTransitions() {
+		_, err := conn(ctx, r.db).ExecContext(ctx, query,
+			tr.ID,
+			tr.TenantID,
+			string(tr.Action),
+			sql.NullString{String: string(tr.FromStatus), Valid: tr.FromStatus != ""},
+			string(tr.ToStatus),
+			sql.NullString{String: tr.Reason, Valid: tr.Reason != ""},
+			string(tr.ActorType),
+			tr.ActorID,
+			tr.ActorName,
+			tr.OccurredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record status transition: %w", err)
+		}
+	}
+
+	tenant.ClearTransitions()
+
+	return nil
+}
+
+// ListStatusHistory retrieves the status transitions of a tenant, oldest first
+func (r *TenantRepository) ListStatusHistory(ctx context.Context, tenantID uuid.UUID) ([]*domain.StatusTransition, error) {
+	query := `
+		SELECT * FROM public.tenant_status_history
+		WHERE tenant_id = $1
+		ORDER BY occurred_at ASC
+	`
+
+	var rows []statusTransitionRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to list status history: %w", err)
+	}
+
+	transitions := make([]*domain.StatusTransition, 0, len(rows))
+	for _, row := range rows {
+		transitions = append(transitions, &domain.StatusTransition{
+			ID:         row.ID,
+			TenantID:   row.TenantID,
+			Action:     domain.LifecycleAction(row.Action),
+			FromStatus: domain.TenantStatus(row.FromStatus.String),
+			ToStatus:   domain.TenantStatus(row.ToStatus),
+			Reason:     row.Reason.String,
+			ActorType:  domain.ActorType(row.ActorType),
+			ActorID:    row.ActorID,
+			ActorName:  row.ActorName.String,
+			OccurredAt: row.OccurredAt,
+		})
+	}
+
+	return transitions, nil
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// StorageRepository implements domain.StorageRepository
+type StorageRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewStorageRepository creates a new storage repository
+func NewStorageRepository(db *sqlx.DB, logger *zap.Logger) *StorageRepository {
+	return &StorageRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// storageSnapshotRow represents a database row from the tenant_storage_snapshots table
+type storageSnapshotRow struct {
+	ID         uuid.UUID `db:"id"`
+	TenantID   uuid.UUID `db:"tenant_id"`
+	SchemaName string    `db:"schema_name"`
+	TableBytes int64     `db:"table_bytes"`
+	IndexBytes int64     `db:"index_bytes"`
+	ToastBytes int64     `db:"toast_bytes"`
+	TotalBytes int64     `db:"total_bytes"`
+	QuotaBytes int64     `db:"quota_bytes"`
+	MeasuredAt time.Time `db:"measured_at"`
+}
+
+// Record stores a storage snapshot
+func (r *StorageRepository) Record(ctx context.Context, snapshot *domain.StorageSnapshot) error {
+	query := `
+		INSERT INTO public.tenant_storage_snapshots (
+			id, tenant_id, schema_name, table_bytes, index_bytes, toast_bytes,
+			total_bytes, quota_bytes, measured_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		snapshot.ID,
+		snapshot.TenantID,
+		snapshot.Schema,
+		snapshot.TableBytes,
+		snapshot.IndexBytes,
+		snapshot.ToastBytes,
+		snapshot.TotalBytes(),
+		snapshot.QuotaBytes,
+		snapshot.MeasuredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record storage snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// GetLatest retrieves the most recent storage snapshot of a tenant
+func (r *StorageRepository) GetLatest(ctx context.Context, tenantID uuid.UUID) (*domain.StorageSnapshot, error) {
+	query := `
+		SELECT * FROM public.tenant_storage_snapshots
+		WHERE tenant_id = $1
+		ORDER BY measured_at DESC
+		LIMIT 1
+	`
+
+	var row storageSnapshotRow
+	if err := conn(ctx, r.db).GetContext(ctx, &row, query, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrStorageSnapshotNotFound
+		}
+		return nil, fmt.Errorf("failed to get storage snapshot: %w", err)
+	}
+
+	return rowToStorageSnapshot(&row), nil
+}
+
+// List retrieves up to limit storage snapshots of a tenant taken within the
+// time range, newest first
+func (r *StorageRepository) List(ctx context.Context, tenantID uuid.UUID, from, to time.Time, limit int) ([]*domain.StorageSnapshot, error) {
+	query := `
+		SELECT * FROM public.tenant_storage_snapshots
+		WHERE tenant_id = $1 AND measured_at >= $2 AND measured_at <= $3
+		ORDER BY measured_at DESC
+		LIMIT $4
+	`
+
+	var rows []storageSnapshotRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, tenantID, from, to, limit); err != nil {
+		return nil, fmt.Errorf("failed to list storage snapshots: %w", err)
+	}
+
+	snapshots := make([]*domain.StorageSnapshot, 0, len(rows))
+	for i := range rows {
+		snapshots = append(snapshots, rowToStorageSnapshot(&rows[i]))
+	}
+
+	return snapshots, nil
+}
+
+// DeleteBefore removes the snapshots taken before the given time
+func (r *StorageRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM public.tenant_storage_snapshots WHERE measured_at < $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete storage snapshots: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	return deleted, nil
+}
+
+// rowToStorageSnapshot converts a database row to a domain storage snapshot
+func rowToStorageSnapshot(row *storageSnapshotRow) *domain.StorageSnapshot {
+	return &domain.StorageSnapshot{
+		ID:       row.ID,
+		TenantID: row.TenantID,
+		Schema:   row.SchemaName,
+		SchemaSize: domain.SchemaSize{
+			TableBytes: row.TableBytes,
+			IndexBytes: row.IndexBytes,
+			ToastBytes: row.ToastBytes,
+		},
+		QuotaBytes: row.QuotaBytes,
+		MeasuredAt: row.MeasuredAt,
+	}
+}
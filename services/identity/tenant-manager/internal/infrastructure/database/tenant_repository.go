@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cotai/tenant-manager/internal/domain"
 	"github.com/google/uuid"
@@ -37,20 +38,28 @@ type tenantRow struct {
 	SchemaVersion       string         `db:"schema_version"`
 	Status              string         `db:"status"`
 	PlanTier            string         `db:"plan_tier"`
+	ParentTenantID      uuid.NullUUID  `db:"parent_tenant_id"`
+	InheritQuotas       bool           `db:"inherit_quotas"`
 	MaxUsers            int            `db:"max_users"`
 	MaxStorageGB        int            `db:"max_storage_gb"`
 	PrimaryContactEmail sql.NullString `db:"primary_contact_email"`
 	PrimaryContactName  sql.NullString `db:"primary_contact_name"`
 	BillingEmail        sql.NullString `db:"billing_email"`
-	Settings            []byte         `db:"settings"` // JSONB
-	Features            []byte         `db:"features"` // JSONB
+	Settings            []byte         `db:"settings"`          // JSONB
+	Features            []byte         `db:"features"`          // JSONB
+	FeatureOverrides    []byte         `db:"feature_overrides"` // JSONB
 	CreatedAt           sql.NullTime   `db:"created_at"`
 	UpdatedAt           sql.NullTime   `db:"updated_at"`
 	ActivatedAt         sql.NullTime   `db:"activated_at"`
 	SuspendedAt         sql.NullTime   `db:"suspended_at"`
+	SuspensionReason    sql.NullString `db:"suspension_reason"`
+	SuspensionNotes     sql.NullString `db:"suspension_notes"`
+	ReinstatedAt        sql.NullTime   `db:"reinstated_at"`
 	DeletedAt           sql.NullTime   `db:"deleted_at"`
+	PurgedAt            sql.NullTime   `db:"purged_at"`
 	CreatedBy           uuid.NullUUID  `db:"created_by"`
 	UpdatedBy           uuid.NullUUID  `db:"updated_by"`
+	trialRow
 }
 
 // Create creates a new tenant in the database
@@ -60,17 +69,21 @@ func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) er
 			id, tenant_id, tenant_name, tenant_slug, database_schema, schema_version,
 			status, plan_tier, max_users, max_storage_gb,
 			primary_contact_email, primary_contact_name, billing_email,
-			settings, features,
-			created_at, updated_at, created_by
+			settings, features, feature_overrides,
+			created_at, updated_at, created_by, parent_tenant_id, inherit_quotas,
+			trial_plan, trial_fallback_plan, trial_expiry_policy, trial_started_at, trial_ends_at,
+			trial_reminded_at, trial_ended_at, trial_outcome
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21,
+			$22, $23, $24, $25, $26, $27, $28, $29
 		)
 	`
 
-	settings, _ := json.Marshal(tenant.Settings)
-	features, _ := json.Marshal(tenant.Features)
+	trial := trialToRow(tenant.Trial)
 
-	_, err := r.db.ExecContext(ctx, query,
+	settings, features, featureOverrides := jsonColumns(tenant)
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
 		tenant.ID,
 		tenant.TenantID,
 		tenant.TenantName,
@@ -86,9 +99,20 @@ func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) er
 		tenant.BillingEmail,
 		settings,
 		features,
+		featureOverrides,
 		tenant.CreatedAt,
 		tenant.UpdatedAt,
 		tenant.CreatedBy,
+		tenant.ParentTenantID,
+		tenant.InheritQuotas,
+		trial.TrialPlan,
+		trial.TrialFallbackPlan,
+		trial.TrialExpiryPolicy,
+		trial.TrialStartedAt,
+		trial.TrialEndsAt,
+		trial.TrialRemindedAt,
+		trial.TrialEndedAt,
+		trial.TrialOutcome,
 	)
 
 	if err != nil {
@@ -99,6 +123,10 @@ func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) er
 		return fmt.Errorf("failed to create tenant: %w", err)
 	}
 
+	if err := r.saveTransitions(ctx, tenant); err != nil {
+		return err
+	}
+
 	r.logger.Info("Tenant created",
 		zap.String("tenant_id", tenant.TenantID.String()),
 		zap.String("slug", tenant.TenantSlug),
@@ -114,7 +142,7 @@ func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.T
 	`
 
 	var row tenantRow
-	err := r.db.GetContext(ctx, &row, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTenantNotFound
@@ -122,7 +150,7 @@ func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.T
 		return nil, fmt.Errorf("failed to get tenant by ID: %w", err)
 	}
 
-	return r.rowToTenant(&row)
+	return r.getTenant(ctx, &row)
 }
 
 // GetByTenantID retrieves a tenant by tenant_id
@@ -132,7 +160,7 @@ func (r *TenantRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID
 	`
 
 	var row tenantRow
-	err := r.db.GetContext(ctx, &row, query, tenantID)
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, tenantID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTenantNotFound
@@ -140,25 +168,46 @@ func (r *TenantRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID
 		return nil, fmt.Errorf("failed to get tenant by tenant_id: %w", err)
 	}
 
-	return r.rowToTenant(&row)
+	return r.getTenant(ctx, &row)
+}
+
+// GetByTenantIDForUpdate retrieves a tenant by tenant_id and locks its row
+// until the enclosing transaction ends, so that the tenant is changed from
+// its latest state
+func (r *TenantRepository) GetByTenantIDForUpdate(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	query := `
+		SELECT * FROM public.tenant_registry WHERE tenant_id = $1 FOR UPDATE
+	`
+
+	var row tenantRow
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to lock tenant by tenant_id: %w", err)
+	}
+
+	return r.getTenant(ctx, &row)
 }
 
-// GetBySlug retrieves a tenant by slug
+// GetBySlug retrieves a tenant by slug, falling back to the unreleased slug
+// aliases. The returned tenant carries its canonical slug.
 func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
 	query := `
 		SELECT * FROM public.tenant_registry WHERE tenant_slug = $1
 	`
 
 	var row tenantRow
-	err := r.db.GetContext(ctx, &row, query, slug)
+	err := conn(ctx, r.db).GetContext(ctx, &row, query, slug)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, domain.ErrTenantNotFound
+			return r.getBySlugAlias(ctx, slug)
 		}
 		return nil, fmt.Errorf("failed to get tenant by slug: %w", err)
 	}
 
-	return r.rowToTenant(&row)
+	return r.getTenant(ctx, &row)
 }
 
 // List retrieves all tenants with pagination
@@ -183,6 +232,13 @@ func (r *TenantRepository) List(ctx context.Context, filter domain.ListFilter) (
 		argPos++
 	}
 
+	if filter.SuspensionReason != "" {
+		query += fmt.Sprintf(" AND suspension_reason = $%d", argPos)
+		countQuery += fmt.Sprintf(" AND suspension_reason = $%d", argPos)
+		args = append(args, string(filter.SuspensionReason))
+		argPos++
+	}
+
 	if filter.Search != "" {
 		searchPattern := "%" + filter.Search + "%"
 		query += fmt.Sprintf(" AND (tenant_name ILIKE $%d OR tenant_slug ILIKE $%d)", argPos, argPos)
@@ -191,9 +247,16 @@ func (r *TenantRepository) List(ctx context.Context, filter domain.ListFilter) (
 		argPos++
 	}
 
+	if filter.AncestorID != nil {
+		query += fmt.Sprintf(" AND tenant_id IN (%s)", descendantIDsQuery(argPos))
+		countQuery += fmt.Sprintf(" AND tenant_id IN (%s)", descendantIDsQuery(argPos))
+		args = append(args, *filter.AncestorID)
+		argPos++
+	}
+
 	// Get total count
 	var total int
-	err := r.db.GetContext(ctx, &total, countQuery, args...)
+	err := conn(ctx, r.db).GetContext(ctx, &total, countQuery, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count tenants: %w", err)
 	}
@@ -204,7 +267,7 @@ func (r *TenantRepository) List(ctx context.Context, filter domain.ListFilter) (
 
 	// Execute query
 	var rows []tenantRow
-	err = r.db.SelectContext(ctx, &rows, query, args...)
+	err = conn(ctx, r.db).SelectContext(ctx, &rows, query, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list tenants: %w", err)
 	}
@@ -220,6 +283,14 @@ func (r *TenantRepository) List(ctx context.Context, filter domain.ListFilter) (
 		tenants = append(tenants, tenant)
 	}
 
+	if err := r.loadQuotaOverrides(ctx, tenants...); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.loadInheritedQuotas(ctx, tenants...); err != nil {
+		return nil, 0, err
+	}
+
 	return tenants, total, nil
 }
 
@@ -228,28 +299,46 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) er
 	query := `
 		UPDATE public.tenant_registry SET
 			tenant_name = $1,
-			status = $2,
-			plan_tier = $3,
-			max_users = $4,
-			max_storage_gb = $5,
-			primary_contact_email = $6,
-			primary_contact_name = $7,
-			billing_email = $8,
-			settings = $9,
-			features = $10,
-			updated_at = $11,
-			activated_at = $12,
-			suspended_at = $13,
-			deleted_at = $14,
-			updated_by = $15
-		WHERE tenant_id = $16
+			tenant_slug = $2,
+			status = $3,
+			plan_tier = $4,
+			max_users = $5,
+			max_storage_gb = $6,
+			primary_contact_email = $7,
+			primary_contact_name = $8,
+			billing_email = $9,
+			settings = $10,
+			features = $11,
+			feature_overrides = $12,
+			updated_at = $13,
+			activated_at = $14,
+			suspended_at = $15,
+			deleted_at = $16,
+			purged_at = $17,
+			updated_by = $18,
+			parent_tenant_id = $19,
+			inherit_quotas = $20,
+			trial_plan = $21,
+			trial_fallback_plan = $22,
+			trial_expiry_policy = $23,
+			trial_started_at = $24,
+			trial_ends_at = $25,
+			trial_reminded_at = $26,
+			trial_ended_at = $27,
+			trial_outcome = $28,
+			suspension_reason = $29,
+			suspension_notes = $30,
+			reinstated_at = $31
+		WHERE tenant_id = $32
 	`
 
-	settings, _ := json.Marshal(tenant.Settings)
-	features, _ := json.Marshal(tenant.Features)
+	trial := trialToRow(tenant.Trial)
 
-	result, err := r.db.ExecContext(ctx, query,
+	settings, features, featureOverrides := jsonColumns(tenant)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
 		tenant.TenantName,
+		tenant.TenantSlug,
 		string(tenant.Status),
 		string(tenant.PlanTier),
 		tenant.MaxUsers,
@@ -259,11 +348,26 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) er
 		tenant.BillingEmail,
 		settings,
 		features,
+		featureOverrides,
 		tenant.UpdatedAt,
 		tenant.ActivatedAt,
 		tenant.SuspendedAt,
 		tenant.DeletedAt,
+		tenant.PurgedAt,
 		tenant.UpdatedBy,
+		tenant.ParentTenantID,
+		tenant.InheritQuotas,
+		trial.TrialPlan,
+		trial.TrialFallbackPlan,
+		trial.TrialExpiryPolicy,
+		trial.TrialStartedAt,
+		trial.TrialEndsAt,
+		trial.TrialRemindedAt,
+		trial.TrialEndedAt,
+		trial.TrialOutcome,
+		sql.NullString{String: string(tenant.SuspensionReason), Valid: tenant.SuspensionReason != ""},
+		sql.NullString{String: tenant.SuspensionNotes, Valid: tenant.SuspensionNotes != ""},
+		tenant.ReinstatedAt,
 		tenant.TenantID,
 	)
 
@@ -280,6 +384,22 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) er
 		return domain.ErrTenantNotFound
 	}
 
+	if err := r.saveTransitions(ctx, tenant); err != nil {
+		return err
+	}
+
+	if err := r.savePlanChanges(ctx, tenant); err != nil {
+		return err
+	}
+
+	if err := r.saveQuotaOverrides(ctx, tenant); err != nil {
+		return err
+	}
+
+	if err := r.saveSlugAliases(ctx, tenant); err != nil {
+		return err
+	}
+
 	r.logger.Info("Tenant updated",
 		zap.String("tenant_id", tenant.TenantID.String()),
 	)
@@ -297,7 +417,7 @@ func (r *TenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		WHERE tenant_id = $4 AND status != $1
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
 		string(domain.StatusDeleted),
 		sql.NullTime{Time: sql.NullTime{}.Time, Valid: true},
 		sql.NullTime{}.Time,
@@ -316,12 +436,96 @@ func (r *TenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ListByStatus retrieves every tenant in one of the given statuses, oldest first
+func (r *TenantRepository) ListByStatus(ctx context.Context, statuses ...domain.TenantStatus) ([]*domain.Tenant, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		names = append(names, string(s))
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT * FROM public.tenant_registry
+		WHERE status IN (?)
+		ORDER BY created_at ASC
+	`, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tenant status query: %w", err)
+	}
+
+	var rows []tenantRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to list tenants by status: %w", err)
+	}
+
+	tenants := make([]*domain.Tenant, 0, len(rows))
+	for i := range rows {
+		tenant, err := r.rowToTenant(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	if err := r.loadQuotaOverrides(ctx, tenants...); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadInheritedQuotas(ctx, tenants...); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+// ListPurgeable retrieves up to limit deleted, unpurged tenants whose
+// deletion is older than deletedBefore, oldest first
+func (r *TenantRepository) ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]*domain.Tenant, error) {
+	query := `
+		SELECT * FROM public.tenant_registry
+		WHERE status = $1 AND purged_at IS NULL AND deleted_at < $2
+		ORDER BY deleted_at ASC
+		LIMIT $3
+	`
+
+	var rows []tenantRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, string(domain.StatusDeleted), deletedBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to list purgeable tenants: %w", err)
+	}
+
+	tenants := make([]*domain.Tenant, 0, len(rows))
+	for i := range rows {
+		tenant, err := r.rowToTenant(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	return tenants, nil
+}
+
+// LockDeletion takes a transaction-level advisory lock on the tenant's
+// deletion, so a purge and a restore of the tenant run one at a time
+func (r *TenantRepository) LockDeletion(ctx context.Context, tenantID uuid.UUID) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID.String()+":deletion"); err != nil {
+		return fmt.Errorf("failed to lock tenant deletion: %w", err)
+	}
+
+	return nil
+}
+
 // ExistsBySlug checks if a tenant with the given slug exists
 func (r *TenantRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
 	query := `SELECT EXISTS(SELECT 1 FROM public.tenant_registry WHERE tenant_slug = $1)`
 
 	var exists bool
-	err := r.db.GetContext(ctx, &exists, query, slug)
+	err := conn(ctx, r.db).GetContext(ctx, &exists, query, slug)
 	if err != nil {
 		return false, fmt.Errorf("failed to check tenant slug existence: %w", err)
 	}
@@ -334,7 +538,7 @@ func (r *TenantRepository) CountByStatus(ctx context.Context, status domain.Tena
 	query := `SELECT COUNT(*) FROM public.tenant_registry WHERE status = $1`
 
 	var count int
-	err := r.db.GetContext(ctx, &count, query, string(status))
+	err := conn(ctx, r.db).GetContext(ctx, &count, query, string(status))
 	if err != nil {
 		return 0, fmt.Errorf("failed to count tenants by status: %w", err)
 	}
@@ -342,6 +546,34 @@ func (r *TenantRepository) CountByStatus(ctx context.Context, status domain.Tena
 	return count, nil
 }
 
+// getTenant converts a database row to a domain Tenant with its quota
+// overrides and inherited quotas
+func (r *TenantRepository) getTenant(ctx context.Context, row *tenantRow) (*domain.Tenant, error) {
+	tenant, err := r.rowToTenant(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadQuotaOverrides(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadInheritedQuotas(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
+
+// jsonColumns encodes the JSONB columns of a tenant. Settings that could
+// not be read are encoded as they were stored.
+func jsonColumns(tenant *domain.Tenant) (settings, features, featureOverrides []byte) {
+	settings, _ = json.Marshal(tenant.Settings)
+	features, _ = json.Marshal(tenant.Features)
+	featureOverrides, _ = json.Marshal(tenant.FeatureOverrides)
+	return settings, features, featureOverrides
+}
+
 // rowToTenant converts a database row to a domain Tenant
 func (r *TenantRepository) rowToTenant(row *tenantRow) (*domain.Tenant, error) {
 	tenant := &domain.Tenant{
@@ -353,10 +585,20 @@ func (r *TenantRepository) rowToTenant(row *tenantRow) (*domain.Tenant, error) {
 		SchemaVersion:  row.SchemaVersion,
 		Status:         domain.TenantStatus(row.Status),
 		PlanTier:       domain.PlanTier(row.PlanTier),
+		InheritQuotas:  row.InheritQuotas,
 		MaxUsers:       row.MaxUsers,
 		MaxStorageGB:   row.MaxStorageGB,
 	}
 
+	if row.ParentTenantID.Valid {
+		parentID := row.ParentTenantID.UUID
+		tenant.ParentTenantID = &parentID
+	}
+
+	tenant.Trial = row.trialRow.toTrial()
+	tenant.SuspensionReason = domain.SuspensionReason(row.SuspensionReason.String)
+	tenant.SuspensionNotes = row.SuspensionNotes.String
+
 	// Handle nullable fields
 	if row.PrimaryContactEmail.Valid {
 		tenant.PrimaryContactEmail = row.PrimaryContactEmail.String
@@ -368,15 +610,17 @@ func (r *TenantRepository) rowToTenant(row *tenantRow) (*domain.Tenant, error) {
 		tenant.BillingEmail = row.BillingEmail.String
 	}
 
-	// Parse JSONB fields
-	if len(row.Settings) > 0 {
-		if err := json.Unmarshal(row.Settings, &tenant.Settings); err != nil {
-			r.logger.Warn("Failed to unmarshal settings", zap.Error(err))
-			tenant.Settings = make(map[string]interface{})
-		}
-	} else {
-		tenant.Settings = make(map[string]interface{})
+	// Parse JSONB fields; settings of older versions are upgraded here, and
+	// settings that cannot be read are kept to be written back unchanged
+	settings, err := domain.ParseSettings(row.Settings)
+	if err != nil {
+		r.logger.Warn("Failed to parse settings",
+			zap.String("tenant_id", row.TenantID.String()),
+			zap.Error(err),
+		)
+		settings = domain.UnreadSettings(row.Settings, err)
 	}
+	tenant.Settings = settings
 
 	if len(row.Features) > 0 {
 		if err := json.Unmarshal(row.Features, &tenant.Features); err != nil {
@@ -387,6 +631,15 @@ func (r *TenantRepository) rowToTenant(row *tenantRow) (*domain.Tenant, error) {
 		tenant.Features = make(map[string]interface{})
 	}
 
+	if len(row.FeatureOverrides) > 0 {
+		if err := json.Unmarshal(row.FeatureOverrides, &tenant.FeatureOverrides); err != nil {
+			r.logger.Warn("Failed to unmarshal feature overrides", zap.Error(err))
+			tenant.FeatureOverrides = make(map[string]interface{})
+		}
+	} else {
+		tenant.FeatureOverrides = make(map[string]interface{})
+	}
+
 	// Handle timestamps
 	if row.CreatedAt.Valid {
 		tenant.CreatedAt = row.CreatedAt.Time
@@ -400,9 +653,15 @@ func (r *TenantRepository) rowToTenant(row *tenantRow) (*domain.Tenant, error) {
 	if row.SuspendedAt.Valid {
 		tenant.SuspendedAt = &row.SuspendedAt.Time
 	}
+	if row.ReinstatedAt.Valid {
+		tenant.ReinstatedAt = &row.ReinstatedAt.Time
+	}
 	if row.DeletedAt.Valid {
 		tenant.DeletedAt = &row.DeletedAt.Time
 	}
+	if row.PurgedAt.Valid {
+		tenant.PurgedAt = &row.PurgedAt.Time
+	}
 
 	// Handle UUIDs
 	if row.CreatedBy.Valid {
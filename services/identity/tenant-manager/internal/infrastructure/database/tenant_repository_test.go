@@ -0,0 +1,40 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTenantRepository_UnreadableSettingsSurviveUpdate(t *testing.T) {
+	// Written by a newer replica during a rolling deploy
+	stored := []byte(`{"version": 3, "locale": {"language": "en-US"}, "branding": {"color": "#0055ff"}}`)
+	r := &TenantRepository{logger: zap.NewNop()}
+
+	tenant, err := r.rowToTenant(&tenantRow{
+		ID:         uuid.New(),
+		TenantID:   uuid.New(),
+		TenantName: "Acme",
+		TenantSlug: "acme",
+		Status:     string(domain.StatusActive),
+		PlanTier:   string(domain.PlanFree),
+		Settings:   stored,
+	})
+	require.NoError(t, err)
+	assert.ErrorIs(t, tenant.Settings.Unread(), domain.ErrSettingsUnreadable)
+
+	// An unrelated change writes the document back as it was stored
+	tenant.TenantName = "Acme Corp"
+	settings, _, _ := jsonColumns(tenant)
+	assert.JSONEq(t, string(stored), string(settings))
+
+	// and the settings themselves cannot be changed
+	err = tenant.UpdateSettings(map[string]interface{}{"locale": map[string]interface{}{"language": "pt-BR"}})
+	assert.ErrorIs(t, err, domain.ErrSettingsUnreadable)
+	settings, _, _ = jsonColumns(tenant)
+	assert.JSONEq(t, string(stored), string(settings))
+}
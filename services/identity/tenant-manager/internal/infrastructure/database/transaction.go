@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// queryer is implemented by both *sqlx.DB and *sqlx.Tx
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+type txKey struct{}
+
+// txState is the transaction bound to a context, with the functions to run
+// once it commits
+type txState struct {
+	tx          *sqlx.Tx
+	afterCommit []func()
+}
+
+// conn returns the transaction bound to the context, or db outside of one
+func conn(ctx context.Context, db *sqlx.DB) queryer {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db
+}
+
+// TxManager runs units of work in a database transaction
+type TxManager struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewTxManager creates a new transaction manager
+func NewTxManager(db *sqlx.DB, logger *zap.Logger) *TxManager {
+	return &TxManager{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// WithinTx runs fn in a transaction. Repositories called with the context
+// passed to fn take part in it. The transaction commits if fn returns nil
+// and rolls back otherwise. Nested calls join the outer transaction.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	state := &txState{tx: tx}
+	if err := fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			m.logger.Error("Failed to roll back transaction", zap.Error(rbErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, f := range state.afterCommit {
+		go f()
+	}
+
+	return nil
+}
+
+// AfterCommit runs fn in its own goroutine once the transaction bound to ctx
+// commits, and never if it rolls back. Outside a transaction fn starts at
+// once.
+func (m *TxManager) AfterCommit(ctx context.Context, fn func()) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	go fn()
+}
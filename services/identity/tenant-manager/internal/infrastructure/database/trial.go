@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// trialRow holds the trial columns of tenant_registry, all NULL for a tenant
+// that never had a trial
+type trialRow struct {
+	TrialPlan         sql.NullString `db:"trial_plan"`
+	TrialFallbackPlan sql.NullString `db:"trial_fallback_plan"`
+	TrialExpiryPolicy sql.NullString `db:"trial_expiry_policy"`
+	TrialStartedAt    sql.NullTime   `db:"trial_started_at"`
+	TrialEndsAt       sql.NullTime   `db:"trial_ends_at"`
+	TrialRemindedAt   sql.NullTime   `db:"trial_reminded_at"`
+	TrialEndedAt      sql.NullTime   `db:"trial_ended_at"`
+	TrialOutcome      sql.NullString `db:"trial_outcome"`
+}
+
+// trialToRow converts a trial to its columns
+func trialToRow(trial *domain.Trial) trialRow {
+	if trial == nil {
+		return trialRow{}
+	}
+
+	row := trialRow{
+		TrialPlan:         sql.NullString{String: string(trial.Plan), Valid: true},
+		TrialFallbackPlan: sql.NullString{String: string(trial.FallbackPlan), Valid: true},
+		TrialExpiryPolicy: sql.NullString{String: string(trial.ExpiryPolicy), Valid: true},
+		TrialStartedAt:    sql.NullTime{Time: trial.StartedAt, Valid: true},
+		TrialEndsAt:       sql.NullTime{Time: trial.EndsAt, Valid: true},
+		TrialOutcome:      sql.NullString{String: string(trial.Outcome), Valid: trial.Outcome != ""},
+	}
+	if trial.RemindedAt != nil {
+		row.TrialRemindedAt = sql.NullTime{Time: *trial.RemindedAt, Valid: true}
+	}
+	if trial.EndedAt != nil {
+		row.TrialEndedAt = sql.NullTime{Time: *trial.EndedAt, Valid: true}
+	}
+
+	return row
+}
+
+// toTrial converts the trial columns to a domain Trial, nil when unset
+func (row trialRow) toTrial() *domain.Trial {
+	if !row.TrialPlan.Valid {
+		return nil
+	}
+
+	trial := &domain.Trial{
+		Plan:         domain.PlanTier(row.TrialPlan.String),
+		FallbackPlan: domain.PlanTier(row.TrialFallbackPlan.String),
+		ExpiryPolicy: domain.TrialExpiryPolicy(row.TrialExpiryPolicy.String),
+		StartedAt:    row.TrialStartedAt.Time,
+		EndsAt:       row.TrialEndsAt.Time,
+		Outcome:      domain.TrialOutcome(row.TrialOutcome.String),
+	}
+	if row.TrialRemindedAt.Valid {
+		remindedAt := row.TrialRemindedAt.Time
+		trial.RemindedAt = &remindedAt
+	}
+	if row.TrialEndedAt.Valid {
+		endedAt := row.TrialEndedAt.Time
+		trial.EndedAt = &endedAt
+	}
+
+	return trial
+}
+
+// ListTrialsEndingBefore retrieves up to limit tenants, not deleted, whose
+// trial is still running and ends before endsBefore, soonest first
+func (r *TenantRepository) ListTrialsEndingBefore(ctx context.Context, endsBefore time.Time, limit int) ([]*domain.Tenant, error) {
+	query := `
+		SELECT * FROM public.tenant_registry
+		WHERE trial_ends_at < $1 AND trial_ended_at IS NULL AND status != $2
+		ORDER BY trial_ends_at ASC
+		LIMIT $3
+	`
+
+	var rows []tenantRow
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, endsBefore, string(domain.StatusDeleted), limit); err != nil {
+		return nil, fmt.Errorf("failed to list tenant trials: %w", err)
+	}
+
+	tenants := make([]*domain.Tenant, 0, len(rows))
+	for i := range rows {
+		tenant, err := r.rowToTenant(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	if err := r.loadQuotaOverrides(ctx, tenants...); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadInheritedQuotas(ctx, tenants...); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+// UpdateTrialReminder writes only the reminder timestamp of the tenant's trial
+func (r *TenantRepository) UpdateTrialReminder(ctx context.Context, tenant *domain.Tenant) error {
+	if tenant.Trial == nil {
+		return domain.ErrNoActiveTrial
+	}
+
+	query := `
+		UPDATE public.tenant_registry SET trial_reminded_at = $1
+		WHERE tenant_id = $2 AND trial_started_at = $3 AND trial_ended_at IS NULL
+	`
+
+	row := trialToRow(tenant.Trial)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, row.TrialRemindedAt, tenant.TenantID, row.TrialStartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update tenant trial reminder: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return domain.ErrNoActiveTrial
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// UsageRepository implements domain.UsageRepository
+type UsageRepository struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// NewUsageRepository creates a new usage repository
+func NewUsageRepository(db *sqlx.DB, logger *zap.Logger) *UsageRepository {
+	return &UsageRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetUsage measures the current usage of a tenant: its active members and
+// the schema size at its latest storage snapshot
+func (r *UsageRepository) GetUsage(ctx context.Context, tenantID uuid.UUID) (*domain.TenantUsage, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM public.user_tenant_mapping
+			 WHERE tenant_id = $1 AND is_active = true) AS active_users,
+			COALESCE((SELECT total_bytes FROM public.tenant_storage_snapshots
+			 WHERE tenant_id = $1 ORDER BY measured_at DESC LIMIT 1), 0) AS storage_bytes
+	`
+
+	var row struct {
+		ActiveUsers  int   `db:"active_users"`
+		StorageBytes int64 `db:"storage_bytes"`
+	}
+	if err := conn(ctx, r.db).GetContext(ctx, &row, query, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to measure tenant usage: %w", err)
+	}
+
+	return &domain.TenantUsage{ActiveUsers: row.ActiveUsers, StorageBytes: row.StorageBytes}, nil
+}
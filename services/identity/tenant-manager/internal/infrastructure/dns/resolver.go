@@ -0,0 +1,64 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Resolver implements domain.TXTResolver on top of net.Resolver
+type Resolver struct {
+	resolver *net.Resolver
+	timeout  time.Duration
+	logger   *zap.Logger
+}
+
+// NewResolver creates a TXT resolver. An empty nameserver ("host:port") uses
+// the system resolver; every lookup is bounded by timeout.
+func NewResolver(nameserver string, timeout time.Duration, logger *zap.Logger) *Resolver {
+	resolver := net.DefaultResolver
+	if nameserver != "" {
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, nameserver)
+			},
+		}
+	}
+
+	return &Resolver{
+		resolver: resolver,
+		timeout:  timeout,
+		logger:   logger,
+	}
+}
+
+// LookupTXT returns the TXT records of name. A name that does not exist, or
+// has no TXT records, yields no records and no error.
+func (r *Resolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	records, err := r.resolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil
+		}
+
+		r.logger.Warn("TXT lookup failed",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,74 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// FileSender implements usecase.MailSender by writing each message as an
+// .eml file under a directory, for local development and tests
+type FileSender struct {
+	dir    string
+	from   string
+	logger *zap.Logger
+}
+
+// NewFileSender creates a file mail sender, creating dir if needed
+func NewFileSender(dir, from string, logger *zap.Logger) (*FileSender, error) {
+	if dir == "" {
+		return nil, errors.New("mail directory is required")
+	}
+	if _, err := envelopeAddress(from); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	return &FileSender{
+		dir:    dir,
+		from:   from,
+		logger: logger,
+	}, nil
+}
+
+// Send writes the message to a new file named after the time it was sent
+func (s *FileSender) Send(ctx context.Context, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	msg, err := compose(s.from, to, subject, body, now)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(s.dir, now.UTC().Format("20060102T150405Z")+"-*.eml")
+	if err != nil {
+		return fmt.Errorf("failed to create mail file: %w", err)
+	}
+
+	if _, err := f.Write(msg); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	s.logger.Debug("Mail written to file",
+		zap.String("to", to),
+		zap.String("path", f.Name()),
+	)
+
+	return nil
+}
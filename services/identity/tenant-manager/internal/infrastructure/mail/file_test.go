@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFileSender_WritesMessage(t *testing.T) {
+	dir := t.TempDir()
+	sender, err := NewFileSender(dir, "Cotai <no-reply@cotai.dev>", zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send(context.Background(), "ana@example.com", "Convite para Acme", "Olá\nhttps://app.cotai.dev/accept"))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	msg := string(data)
+
+	assert.Contains(t, msg, "From: Cotai <no-reply@cotai.dev>\r\n")
+	assert.Contains(t, msg, "To: ana@example.com\r\n")
+	assert.Contains(t, msg, "Message-ID: <")
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\nOlá\r\nhttps://app.cotai.dev/accept"))
+}
+
+func TestFileSender_RejectsHeaderInjection(t *testing.T) {
+	sender, err := NewFileSender(t.TempDir(), "no-reply@cotai.dev", zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, sender.Send(ctx, "ana@example.com\r\nBcc: eve@example.com", "Hi", "body"), errInvalidHeader)
+	assert.ErrorIs(t, sender.Send(ctx, "ana@example.com", "Hi\nBcc: eve@example.com", "body"), errInvalidHeader)
+	assert.Error(t, sender.Send(ctx, "not-an-address", "Hi", "body"))
+}
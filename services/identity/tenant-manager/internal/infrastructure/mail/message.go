@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// errInvalidHeader is returned for an address or subject that would inject
+// headers into the message
+var errInvalidHeader = errors.New("mail header must not contain line breaks")
+
+// compose builds a plain text UTF-8 message with CRLF line endings
+func compose(from, to, subject, body string, now time.Time) ([]byte, error) {
+	for _, value := range []string{from, to, subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errInvalidHeader
+		}
+	}
+
+	if _, err := mail.ParseAddress(to); err != nil {
+		return nil, fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	domain := "localhost"
+	if addr, err := mail.ParseAddress(from); err == nil {
+		if _, host, ok := strings.Cut(addr.Address, "@"); ok {
+			domain = host
+		}
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate message ID: %w", err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@%s>\r\n", hex.EncodeToString(id), domain)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return buf.Bytes(), nil
+}
+
+// envelopeAddress returns the bare address of a header address, such as
+// "Cotai <no-reply@cotai.dev>"
+func envelopeAddress(address string) (string, error) {
+	addr, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid mail address %q: %w", address, err)
+	}
+	return addr.Address, nil
+}
@@ -0,0 +1,109 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SMTPSender implements usecase.MailSender over plain, unauthenticated SMTP,
+// such as a local MailHog or Mailpit stub
+type SMTPSender struct {
+	addr    string
+	from    string
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// NewSMTPSender creates an SMTP mail sender for the server at addr
+// ("host:port"); every delivery is bounded by timeout
+func NewSMTPSender(addr, from string, timeout time.Duration, logger *zap.Logger) (*SMTPSender, error) {
+	if addr == "" {
+		return nil, errors.New("SMTP server address is required")
+	}
+	if _, err := envelopeAddress(from); err != nil {
+		return nil, err
+	}
+
+	return &SMTPSender{
+		addr:    addr,
+		from:    from,
+		timeout: timeout,
+		logger:  logger,
+	}, nil
+}
+
+// Send delivers the message to the SMTP server
+func (s *SMTPSender) Send(ctx context.Context, to, subject, body string) error {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	msg, err := compose(s.from, to, subject, body, time.Now())
+	if err != nil {
+		return err
+	}
+
+	sender, err := envelopeAddress(s.from)
+	if err != nil {
+		return err
+	}
+	recipient, err := envelopeAddress(to)
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	c, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		c.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(s.addr)
+	client, err := smtp.NewClient(c, host)
+	if err != nil {
+		c.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.Mail(sender); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM failed: %w", err)
+	}
+	if err := client.Rcpt(recipient); err != nil {
+		return fmt.Errorf("SMTP RCPT TO failed: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA failed: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to write SMTP message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP server rejected message: %w", err)
+	}
+
+	if err := client.Quit(); err != nil {
+		s.logger.Debug("SMTP QUIT failed", zap.Error(err))
+	}
+
+	s.logger.Debug("Mail sent over SMTP",
+		zap.String("to", to),
+		zap.String("server", s.addr),
+	)
+
+	return nil
+}
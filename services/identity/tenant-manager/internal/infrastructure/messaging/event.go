@@ -1,6 +1,7 @@
 package messaging
 
 import (
+	"math"
 	"time"
 
 	"github.com/cotai/tenant-manager/internal/domain"
@@ -10,12 +11,32 @@ import (
 type EventType string
 
 const (
-	EventTenantCreated     EventType = "tenant.created"
-	EventTenantActivated   EventType = "tenant.activated"
-	EventTenantSuspended   EventType = "tenant.suspended"
-	EventTenantDeleted     EventType = "tenant.deleted"
-	EventTenantPlanChanged EventType = "tenant.plan.changed"
-	EventTenantUpdated     EventType = "tenant.updated"
+	EventTenantCreated      EventType = "tenant.created"
+	EventTenantActivated    EventType = "tenant.activated"
+	EventTenantSuspended    EventType = "tenant.suspended"
+	EventTenantDeleted      EventType = "tenant.deleted"
+	EventTenantArchived     EventType = "tenant.archived"
+	EventTenantUnarchived   EventType = "tenant.unarchived"
+	EventTenantRestored     EventType = "tenant.restored"
+	EventTenantPurged       EventType = "tenant.purged"
+	EventTenantPlanChanged  EventType = "tenant.plan.changed"
+	EventTenantQuotaChanged EventType = "tenant.quota.changed"
+	EventTenantSlugChanged  EventType = "tenant.slug.changed"
+	EventTenantUpdated      EventType = "tenant.updated"
+
+	EventTenantEntitlementThresholdReached EventType = "tenant.entitlement.threshold_reached"
+	EventTenantStorageOverQuota            EventType = "tenant.storage.over_quota"
+	EventTenantFeaturesChanged             EventType = "tenant.features.changed"
+	EventTenantDomainVerified              EventType = "tenant.domain.verified"
+	EventTenantDomainFailed                EventType = "tenant.domain.failed"
+	EventTenantDomainRevoked               EventType = "tenant.domain.revoked"
+	EventTenantTrialReminder               EventType = "tenant.trial.reminder"
+	EventTenantTrialConverted              EventType = "tenant.trial.converted"
+	EventTenantTrialExpired                EventType = "tenant.trial.expired"
+	EventTenantOperationFailed             EventType = "tenant.operation.failed"
+	EventTenantMemberAdded                 EventType = "tenant.member.added"
+	EventTenantMemberRemoved               EventType = "tenant.member.removed"
+	EventTenantMemberRoleChanged           EventType = "tenant.member.role_changed"
 )
 
 // TenantLifecycleEvent represents a tenant lifecycle event
@@ -30,7 +51,7 @@ type TenantLifecycleEvent struct {
 
 // TenantToEventPayload converts a domain tenant to event payload
 func TenantToEventPayload(tenant *domain.Tenant) map[string]interface{} {
-	return map[string]interface{}{
+	payload := map[string]interface{}{
 		"id":           tenant.ID.String(),
 		"tenantId":     tenant.TenantID.String(),
 		"name":         tenant.TenantName,
@@ -44,4 +65,211 @@ func TenantToEventPayload(tenant *domain.Tenant) map[string]interface{} {
 		"createdAt":    tenant.CreatedAt.Format(time.RFC3339),
 		"updatedAt":    tenant.UpdatedAt.Format(time.RFC3339),
 	}
+	if tenant.ParentTenantID != nil {
+		payload["parentTenantId"] = tenant.ParentTenantID.String()
+	}
+	return payload
+}
+
+// SuspensionToEventPayload converts a suspended tenant and why it was
+// suspended to event payload
+func SuspensionToEventPayload(tenant *domain.Tenant) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	suspension := map[string]interface{}{
+		"reason":     string(tenant.SuspensionReason),
+		"restricted": tenant.SuspensionReason.IsRestricted(),
+	}
+	if tenant.SuspensionNotes != "" {
+		suspension["notes"] = tenant.SuspensionNotes
+	}
+	if tenant.SuspendedAt != nil {
+		suspension["suspendedAt"] = tenant.SuspendedAt.Format(time.RFC3339)
+	}
+	payload["suspension"] = suspension
+	return payload
+}
+
+// PlanChangeToEventPayload converts a tenant and its plan change to event payload
+func PlanChangeToEventPayload(tenant *domain.Tenant, change *domain.PlanChange) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	payload["planChange"] = map[string]interface{}{
+		"oldPlan": string(change.FromPlan),
+		"newPlan": string(change.ToPlan),
+		"oldQuotas": map[string]interface{}{
+			"maxUsers":     change.FromMaxUsers,
+			"maxStorageGb": change.FromMaxStorageGB,
+		},
+		"newQuotas": map[string]interface{}{
+			"maxUsers":     change.ToMaxUsers,
+			"maxStorageGb": change.ToMaxStorageGB,
+		},
+		"changedAt": change.ChangedAt.Format(time.RFC3339),
+	}
+	return payload
+}
+
+// QuotasToEventPayload converts a tenant and its effective quotas to event payload
+func QuotasToEventPayload(tenant *domain.Tenant) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	quotas := make(map[string]interface{}, len(domain.QuotaNames))
+	for _, q := range tenant.EffectiveQuotas(time.Now()) {
+		quota := map[string]interface{}{
+			"default":   q.Default,
+			"effective": q.Effective,
+		}
+		if q.Override != nil && q.Override.ExpiresAt != nil {
+			quota["overrideExpiresAt"] = q.Override.ExpiresAt.Format(time.RFC3339)
+		}
+		quotas[string(q.Name)] = quota
+	}
+	payload["quotas"] = quotas
+	return payload
+}
+
+// EntitlementThresholdToEventPayload converts a tenant and its usage of an
+// entitlement that reached a threshold to event payload
+func EntitlementThresholdToEventPayload(tenant *domain.Tenant, usage *domain.EntitlementUsage, threshold int) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	entitlement := map[string]interface{}{
+		"name":        usage.Name,
+		"threshold":   threshold,
+		"limit":       usage.Limit,
+		"used":        usage.Used,
+		"remaining":   usage.Remaining(),
+		"window":      string(usage.Window),
+		"windowStart": usage.WindowStart.Format(time.RFC3339),
+	}
+	if usage.ResetsAt != nil {
+		entitlement["resetsAt"] = usage.ResetsAt.Format(time.RFC3339)
+	}
+	payload["entitlement"] = entitlement
+	return payload
+}
+
+// StorageToEventPayload converts a tenant and the storage snapshot that put it
+// over its storage quota to event payload
+func StorageToEventPayload(tenant *domain.Tenant, snapshot *domain.StorageSnapshot) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	payload["storage"] = map[string]interface{}{
+		"tableBytes": snapshot.TableBytes,
+		"indexBytes": snapshot.IndexBytes,
+		"toastBytes": snapshot.ToastBytes,
+		"totalBytes": snapshot.TotalBytes(),
+		"quotaBytes": snapshot.QuotaBytes,
+		"measuredAt": snapshot.MeasuredAt.Format(time.RFC3339),
+	}
+	return payload
+}
+
+// FeaturesToEventPayload converts a tenant and its evaluated feature flags to
+// event payload
+func FeaturesToEventPayload(tenant *domain.Tenant, features []*domain.FeatureValue) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	values := make(map[string]interface{}, len(features))
+	for _, f := range features {
+		values[f.Key] = map[string]interface{}{
+			"value":  f.Value,
+			"source": string(f.Source),
+		}
+	}
+	payload["features"] = values
+	return payload
+}
+
+// SlugChangeToEventPayload converts a renamed tenant and its former slug to
+// event payload
+func SlugChangeToEventPayload(tenant *domain.Tenant, previousSlug string) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	payload["previousSlug"] = previousSlug
+	return payload
+}
+
+// DomainToEventPayload converts a tenant and one of its custom domains to
+// event payload
+func DomainToEventPayload(tenant *domain.Tenant, d *domain.CustomDomain) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	customDomain := map[string]interface{}{
+		"id":       d.ID.String(),
+		"hostname": d.Hostname,
+		"status":   string(d.Status),
+	}
+	if d.VerifiedAt != nil {
+		customDomain["verifiedAt"] = d.VerifiedAt.Format(time.RFC3339)
+	}
+	if d.LastError != "" {
+		customDomain["lastError"] = d.LastError
+	}
+	payload["domain"] = customDomain
+	return payload
+}
+
+// TrialToEventPayload converts a tenant and its trial to event payload
+func TrialToEventPayload(tenant *domain.Tenant) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	if tenant.Trial == nil {
+		return payload
+	}
+
+	trial := map[string]interface{}{
+		"plan":         string(tenant.Trial.Plan),
+		"fallbackPlan": string(tenant.Trial.FallbackPlan),
+		"expiryPolicy": string(tenant.Trial.ExpiryPolicy),
+		"startedAt":    tenant.Trial.StartedAt.Format(time.RFC3339),
+		"endsAt":       tenant.Trial.EndsAt.Format(time.RFC3339),
+	}
+	if tenant.Trial.EndedAt != nil {
+		trial["endedAt"] = tenant.Trial.EndedAt.Format(time.RFC3339)
+		trial["outcome"] = string(tenant.Trial.Outcome)
+	}
+	payload["trial"] = trial
+	return payload
+}
+
+// TrialReminderToEventPayload converts a tenant and the time left on its
+// trial to event payload
+func TrialReminderToEventPayload(tenant *domain.Tenant, remaining time.Duration) map[string]interface{} {
+	payload := TrialToEventPayload(tenant)
+	if trial, ok := payload["trial"].(map[string]interface{}); ok {
+		trial["daysLeft"] = int(math.Ceil(remaining.Hours() / 24))
+	}
+	return payload
+}
+
+// OperationToEventPayload converts a tenant and one of its scheduled
+// operations to event payload
+func OperationToEventPayload(tenant *domain.Tenant, op *domain.ScheduledOperation) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	operation := map[string]interface{}{
+		"id":     op.ID.String(),
+		"action": string(op.Action),
+		"runAt":  op.RunAt.Format(time.RFC3339),
+		"status": string(op.Status),
+	}
+	if op.Error != "" {
+		operation["error"] = op.Error
+	}
+	payload["operation"] = operation
+	return payload
+}
+
+// MemberToEventPayload converts a tenant and one of its members to event
+// payload
+func MemberToEventPayload(tenant *domain.Tenant, m *domain.Member) map[string]interface{} {
+	payload := TenantToEventPayload(tenant)
+	payload["member"] = map[string]interface{}{
+		"userId":    m.UserID.String(),
+		"role":      string(m.Role),
+		"isPrimary": m.IsPrimary,
+	}
+	return payload
+}
+
+// MemberRoleChangeToEventPayload converts a tenant, one of its members and
+// the member's former role to event payload
+func MemberRoleChangeToEventPayload(tenant *domain.Tenant, m *domain.Member, previousRole domain.MemberRole) map[string]interface{} {
+	payload := MemberToEventPayload(tenant, m)
+	if member, ok := payload["member"].(map[string]interface{}); ok {
+		member["previousRole"] = string(previousRole)
+	}
+	return payload
 }
@@ -57,9 +57,10 @@ func (p *KafkaProducer) PublishTenantActivated(ctx context.Context, tenant *doma
 	return p.publishEvent(ctx, EventTenantActivated, tenant)
 }
 
-// PublishTenantSuspended publishes a tenant.suspended event
+// PublishTenantSuspended publishes a tenant.suspended event with the
+// suspension reason
 func (p *KafkaProducer) PublishTenantSuspended(ctx context.Context, tenant *domain.Tenant) error {
-	return p.publishEvent(ctx, EventTenantSuspended, tenant)
+	return p.publishEventWithPayload(ctx, EventTenantSuspended, tenant, SuspensionToEventPayload(tenant))
 }
 
 // PublishTenantDeleted publishes a tenant.deleted event
@@ -67,6 +68,112 @@ func (p *KafkaProducer) PublishTenantDeleted(ctx context.Context, tenant *domain
 	return p.publishEvent(ctx, EventTenantDeleted, tenant)
 }
 
+// PublishTenantArchived publishes a tenant.archived event
+func (p *KafkaProducer) PublishTenantArchived(ctx context.Context, tenant *domain.Tenant) error {
+	return p.publishEvent(ctx, EventTenantArchived, tenant)
+}
+
+// PublishTenantUnarchived publishes a tenant.unarchived event
+func (p *KafkaProducer) PublishTenantUnarchived(ctx context.Context, tenant *domain.Tenant) error {
+	return p.publishEvent(ctx, EventTenantUnarchived, tenant)
+}
+
+// PublishTenantRestored publishes a tenant.restored event
+func (p *KafkaProducer) PublishTenantRestored(ctx context.Context, tenant *domain.Tenant) error {
+	return p.publishEvent(ctx, EventTenantRestored, tenant)
+}
+
+// PublishTenantPurged publishes a tenant.purged event
+func (p *KafkaProducer) PublishTenantPurged(ctx context.Context, tenant *domain.Tenant) error {
+	return p.publishEvent(ctx, EventTenantPurged, tenant)
+}
+
+// PublishTenantPlanChanged publishes a tenant.plan.changed event
+func (p *KafkaProducer) PublishTenantPlanChanged(ctx context.Context, tenant *domain.Tenant, change *domain.PlanChange) error {
+	return p.publishEventWithPayload(ctx, EventTenantPlanChanged, tenant, PlanChangeToEventPayload(tenant, change))
+}
+
+// PublishTenantQuotaChanged publishes a tenant.quota.changed event
+func (p *KafkaProducer) PublishTenantQuotaChanged(ctx context.Context, tenant *domain.Tenant) error {
+	return p.publishEventWithPayload(ctx, EventTenantQuotaChanged, tenant, QuotasToEventPayload(tenant))
+}
+
+// PublishEntitlementThresholdReached publishes a tenant.entitlement.threshold_reached event
+func (p *KafkaProducer) PublishEntitlementThresholdReached(ctx context.Context, tenant *domain.Tenant, usage *domain.EntitlementUsage, threshold int) error {
+	return p.publishEventWithPayload(ctx, EventTenantEntitlementThresholdReached, tenant, EntitlementThresholdToEventPayload(tenant, usage, threshold))
+}
+
+// PublishTenantStorageOverQuota publishes a tenant.storage.over_quota event
+func (p *KafkaProducer) PublishTenantStorageOverQuota(ctx context.Context, tenant *domain.Tenant, snapshot *domain.StorageSnapshot) error {
+	return p.publishEventWithPayload(ctx, EventTenantStorageOverQuota, tenant, StorageToEventPayload(tenant, snapshot))
+}
+
+// PublishTenantFeaturesChanged publishes a tenant.features.changed event
+func (p *KafkaProducer) PublishTenantFeaturesChanged(ctx context.Context, tenant *domain.Tenant, features []*domain.FeatureValue) error {
+	return p.publishEventWithPayload(ctx, EventTenantFeaturesChanged, tenant, FeaturesToEventPayload(tenant, features))
+}
+
+// PublishTenantSlugChanged publishes a tenant.slug.changed event
+func (p *KafkaProducer) PublishTenantSlugChanged(ctx context.Context, tenant *domain.Tenant, previousSlug string) error {
+	return p.publishEventWithPayload(ctx, EventTenantSlugChanged, tenant, SlugChangeToEventPayload(tenant, previousSlug))
+}
+
+// PublishTenantDomainChanged publishes a tenant.domain.verified,
+// tenant.domain.failed or tenant.domain.revoked event, after the domain status
+func (p *KafkaProducer) PublishTenantDomainChanged(ctx context.Context, tenant *domain.Tenant, d *domain.CustomDomain) error {
+	var eventType EventType
+	switch d.Status {
+	case domain.DomainVerified:
+		eventType = EventTenantDomainVerified
+	case domain.DomainFailed:
+		eventType = EventTenantDomainFailed
+	case domain.DomainRevoked:
+		eventType = EventTenantDomainRevoked
+	default:
+		return fmt.Errorf("no event for custom domain status %q", d.Status)
+	}
+	return p.publishEventWithPayload(ctx, eventType, tenant, DomainToEventPayload(tenant, d))
+}
+
+// PublishTenantTrialReminder publishes a tenant.trial.reminder event
+func (p *KafkaProducer) PublishTenantTrialReminder(ctx context.Context, tenant *domain.Tenant, remaining time.Duration) error {
+	return p.publishEventWithPayload(ctx, EventTenantTrialReminder, tenant, TrialReminderToEventPayload(tenant, remaining))
+}
+
+// PublishTenantTrialEnded publishes a tenant.trial.converted or
+// tenant.trial.expired event, after the trial outcome
+func (p *KafkaProducer) PublishTenantTrialEnded(ctx context.Context, tenant *domain.Tenant) error {
+	if tenant.Trial == nil || tenant.Trial.IsActive() {
+		return fmt.Errorf("tenant %s has no ended trial", tenant.TenantID)
+	}
+
+	eventType := EventTenantTrialExpired
+	if tenant.Trial.Outcome == domain.TrialConverted {
+		eventType = EventTenantTrialConverted
+	}
+	return p.publishEventWithPayload(ctx, eventType, tenant, TrialToEventPayload(tenant))
+}
+
+// PublishTenantOperationFailed publishes a tenant.operation.failed event
+func (p *KafkaProducer) PublishTenantOperationFailed(ctx context.Context, tenant *domain.Tenant, op *domain.ScheduledOperation) error {
+	return p.publishEventWithPayload(ctx, EventTenantOperationFailed, tenant, OperationToEventPayload(tenant, op))
+}
+
+// PublishTenantMemberAdded publishes a tenant.member.added event
+func (p *KafkaProducer) PublishTenantMemberAdded(ctx context.Context, tenant *domain.Tenant, m *domain.Member) error {
+	return p.publishEventWithPayload(ctx, EventTenantMemberAdded, tenant, MemberToEventPayload(tenant, m))
+}
+
+// PublishTenantMemberRemoved publishes a tenant.member.removed event
+func (p *KafkaProducer) PublishTenantMemberRemoved(ctx context.Context, tenant *domain.Tenant, m *domain.Member) error {
+	return p.publishEventWithPayload(ctx, EventTenantMemberRemoved, tenant, MemberToEventPayload(tenant, m))
+}
+
+// PublishTenantMemberRoleChanged publishes a tenant.member.role_changed event
+func (p *KafkaProducer) PublishTenantMemberRoleChanged(ctx context.Context, tenant *domain.Tenant, m *domain.Member, previousRole domain.MemberRole) error {
+	return p.publishEventWithPayload(ctx, EventTenantMemberRoleChanged, tenant, MemberRoleChangeToEventPayload(tenant, m, previousRole))
+}
+
 // PublishTenantUpdated publishes a tenant.updated event
 func (p *KafkaProducer) PublishTenantUpdated(ctx context.Context, tenant *domain.Tenant) error {
 	return p.publishEvent(ctx, EventTenantUpdated, tenant)
@@ -74,6 +181,11 @@ func (p *KafkaProducer) PublishTenantUpdated(ctx context.Context, tenant *domain
 
 // publishEvent is a generic method to publish any tenant lifecycle event
 func (p *KafkaProducer) publishEvent(ctx context.Context, eventType EventType, tenant *domain.Tenant) error {
+	return p.publishEventWithPayload(ctx, eventType, tenant, TenantToEventPayload(tenant))
+}
+
+// publishEventWithPayload publishes a tenant lifecycle event with a custom payload
+func (p *KafkaProducer) publishEventWithPayload(ctx context.Context, eventType EventType, tenant *domain.Tenant, payload map[string]interface{}) error {
 	// Get correlation ID from context, or generate new one
 	correlationID := GetCorrelationID(ctx)
 	if correlationID == "" {
@@ -87,7 +199,7 @@ func (p *KafkaProducer) publishEvent(ctx context.Context, eventType EventType, t
 		TenantID:      tenant.TenantID.String(),
 		Timestamp:     time.Now().UTC(),
 		CorrelationID: correlationID,
-		Payload:       TenantToEventPayload(tenant),
+		Payload:       payload,
 	}
 
 	// Marshal to JSON
@@ -21,6 +21,10 @@ type Metrics struct {
 	// Active tenant gauge
 	ActiveTenants            *prometheus.GaugeVec
 
+	// Storage metering gauges
+	TenantStorageBytes       *prometheus.GaugeVec
+	TenantStorageQuotaBytes  *prometheus.GaugeVec
+
 	// API metrics
 	HTTPRequestsTotal        *prometheus.CounterVec
 	HTTPRequestDuration      *prometheus.HistogramVec
@@ -102,6 +106,22 @@ func NewMetrics() *Metrics {
 			[]string{"plan"},
 		),
 
+		// Storage metering
+		TenantStorageBytes: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "tenant_manager_tenant_storage_bytes",
+				Help: "Disk usage of a tenant schema at its latest measurement",
+			},
+			[]string{"tenant_id"},
+		),
+		TenantStorageQuotaBytes: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "tenant_manager_tenant_storage_quota_bytes",
+				Help: "Effective storage quota of a tenant at its latest measurement",
+			},
+			[]string{"tenant_id"},
+		),
+
 		// HTTP metrics
 		HTTPRequestsTotal: promauto.NewCounterVec(
 			prometheus.CounterOpts{
@@ -0,0 +1,428 @@
+package provisioning
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"go.uber.org/zap"
+)
+
+// archiveFormatVersion is bumped when the archive layout changes
+const archiveFormatVersion = 1
+
+// manifestName is the first entry of every schema archive
+const manifestName = "manifest.json"
+
+// archiveManifest describes the contents of a schema archive
+type archiveManifest struct {
+	FormatVersion int            `json:"format_version"`
+	TenantID      string         `json:"tenant_id"`
+	Schema        string         `json:"schema"`
+	ExportedAt    time.Time      `json:"exported_at"`
+	Tables        []archiveTable `json:"tables"` // in restore order
+}
+
+// archiveTable describes one table dump in a schema archive
+type archiveTable struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Entry   string   `json:"entry"`
+}
+
+// ExportSchema writes the data of a tenant schema to w as a gzip-compressed
+// tar archive. Table data is taken with COPY from a single repeatable-read
+// snapshot; the table structure comes from migrations on restore.
+func (p *SchemaProvisioner) ExportSchema(ctx context.Context, tenantID uuid.UUID, w io.Writer) error {
+	schemaName := FormatSchemaName(tenantID)
+
+	return p.withConn(ctx, func(conn *pgx.Conn) error {
+		tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+		if err != nil {
+			return fmt.Errorf("failed to begin export snapshot: %w", err)
+		}
+		defer tx.Rollback(ctx)
+
+		tables, err := schemaTables(ctx, tx, schemaName)
+		if err != nil {
+			return err
+		}
+
+		manifest := archiveManifest{
+			FormatVersion: archiveFormatVersion,
+			TenantID:      tenantID.String(),
+			Schema:        schemaName,
+			ExportedAt:    time.Now().UTC(),
+			Tables:        tables,
+		}
+
+		gz := gzip.NewWriter(w)
+		tw := tar.NewWriter(gz)
+
+		manifestData, err := json.Marshal(manifest)
+		if err != nil {
+			return fmt.Errorf("failed to encode archive manifest: %w", err)
+		}
+		if err := writeTarEntry(tw, manifestName, int64(len(manifestData)), strings.NewReader(string(manifestData))); err != nil {
+			return err
+		}
+
+		for _, table := range tables {
+			if err := p.exportTable(ctx, tx, tw, schemaName, table); err != nil {
+				return err
+			}
+		}
+
+		if err := tw.Close(); err != nil {
+			return fmt.Errorf("failed to finish archive: %w", err)
+		}
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("failed to finish archive compression: %w", err)
+		}
+
+		p.logger.Info("Tenant schema exported",
+			zap.String("tenant_id", tenantID.String()),
+			zap.Int("tables", len(tables)),
+		)
+
+		return nil
+	})
+}
+
+// RestoreSchema recreates a tenant schema from an archive written by
+// ExportSchema. The schema is provisioned from migrations, then the table
+// data is loaded in a single transaction.
+func (p *SchemaProvisioner) RestoreSchema(ctx context.Context, tenantID uuid.UUID, r io.Reader) error {
+	schemaName := FormatSchemaName(tenantID)
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	manifest, err := readManifest(tr)
+	if err != nil {
+		return err
+	}
+	if manifest.TenantID != tenantID.String() {
+		return fmt.Errorf("archive belongs to tenant %s, not %s", manifest.TenantID, tenantID)
+	}
+
+	if err := p.ProvisionTenant(ctx, tenantID); err != nil {
+		return fmt.Errorf("failed to provision schema for restore: %w", err)
+	}
+
+	err = p.withConn(ctx, func(conn *pgx.Conn) error {
+		tx, err := conn.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to begin restore: %w", err)
+		}
+		defer tx.Rollback(ctx)
+
+		// Clear rows seeded by provisioning before loading the archived data
+		if len(manifest.Tables) > 0 {
+			names := make([]string, 0, len(manifest.Tables))
+			for _, table := range manifest.Tables {
+				names = append(names, pgx.Identifier{schemaName, table.Name}.Sanitize())
+			}
+			if _, err := tx.Exec(ctx, "TRUNCATE "+strings.Join(names, ", ")+" CASCADE"); err != nil {
+				return fmt.Errorf("failed to clear seeded data: %w", err)
+			}
+		}
+
+		for _, table := range manifest.Tables {
+			header, err := tr.Next()
+			if err != nil {
+				return fmt.Errorf("failed to read archive entry for %s: %w", table.Name, err)
+			}
+			if header.Name != table.Entry {
+				return fmt.Errorf("unexpected archive entry %q, want %q", header.Name, table.Entry)
+			}
+
+			if err := restoreTable(ctx, tx, schemaName, table, tr); err != nil {
+				return err
+			}
+		}
+
+		if err := resetSequences(ctx, tx, schemaName); err != nil {
+			return err
+		}
+
+		return tx.Commit(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("Tenant schema restored",
+		zap.String("tenant_id", tenantID.String()),
+		zap.Int("tables", len(manifest.Tables)),
+	)
+
+	return nil
+}
+
+// withConn runs fn on a dedicated pgx connection from the pool
+func (p *SchemaProvisioner) withConn(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	sqlConn, err := p.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer sqlConn.Close()
+
+	return sqlConn.Raw(func(driverConn any) error {
+		conn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported driver connection %T", driverConn)
+		}
+		return fn(conn.Conn())
+	})
+}
+
+// exportTable copies one table into the archive. COPY output is spooled to
+// a temporary file because tar headers need the entry size up front.
+func (p *SchemaProvisioner) exportTable(ctx context.Context, tx pgx.Tx, tw *tar.Writer, schemaName string, table archiveTable) error {
+	spool, err := os.CreateTemp("", "tenant-export-*")
+	if err != nil {
+		return fmt.Errorf("failed to create export spool: %w", err)
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	query := fmt.Sprintf("COPY %s (%s) TO STDOUT",
+		pgx.Identifier{schemaName, table.Name}.Sanitize(), quoteColumns(table.Columns))
+	if _, err := tx.Conn().PgConn().CopyTo(ctx, spool, query); err != nil {
+		return fmt.Errorf("failed to export table %s: %w", table.Name, err)
+	}
+
+	size, err := spool.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("failed to size export of %s: %w", table.Name, err)
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind export of %s: %w", table.Name, err)
+	}
+
+	return writeTarEntry(tw, table.Entry, size, spool)
+}
+
+// restoreTable loads one table dump with COPY
+func restoreTable(ctx context.Context, tx pgx.Tx, schemaName string, table archiveTable, r io.Reader) error {
+	query := fmt.Sprintf("COPY %s (%s) FROM STDIN",
+		pgx.Identifier{schemaName, table.Name}.Sanitize(), quoteColumns(table.Columns))
+	if _, err := tx.Conn().PgConn().CopyFrom(ctx, r, query); err != nil {
+		return fmt.Errorf("failed to restore table %s: %w", table.Name, err)
+	}
+	return nil
+}
+
+// schemaTables lists the tables of a schema with their columns, ordered so
+// that tables referenced by foreign keys come before the tables referencing them
+func schemaTables(ctx context.Context, tx pgx.Tx, schemaName string) ([]archiveTable, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.relname
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1
+		  AND c.relkind IN ('r', 'p')
+		  AND NOT c.relispartition
+		  AND c.relname <> 'schema_migrations'
+	`, schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema tables: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema tables: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT DISTINCT src.relname, dst.relname
+		FROM pg_constraint con
+		JOIN pg_class src ON src.oid = con.conrelid
+		JOIN pg_class dst ON dst.oid = con.confrelid
+		JOIN pg_namespace n ON n.oid = src.relnamespace
+		WHERE con.contype = 'f'
+		  AND n.nspname = $1
+		  AND dst.relnamespace = src.relnamespace
+	`, schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys: %w", err)
+	}
+	dependsOn := make(map[string][]string)
+	var src, dst string
+	_, err = pgx.ForEachRow(rows, []any{&src, &dst}, func() error {
+		if src != dst {
+			dependsOn[src] = append(dependsOn[src], dst)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys: %w", err)
+	}
+
+	tables := make([]archiveTable, 0, len(names))
+	for i, name := range orderByDependencies(names, dependsOn) {
+		rows, err := tx.Query(ctx, `
+			SELECT column_name
+			FROM information_schema.columns
+			WHERE table_schema = $1 AND table_name = $2 AND is_generated = 'NEVER'
+			ORDER BY ordinal_position
+		`, schemaName, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list columns of %s: %w", name, err)
+		}
+		columns, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return nil, fmt.Errorf("failed to list columns of %s: %w", name, err)
+		}
+
+		tables = append(tables, archiveTable{
+			Name:    name,
+			Columns: columns,
+			Entry:   fmt.Sprintf("tables/%03d_%s.copy", i, name),
+		})
+	}
+
+	return tables, nil
+}
+
+// orderByDependencies sorts tables so that every table follows the tables
+// it references. Cycles are broken alphabetically.
+func orderByDependencies(names []string, dependsOn map[string][]string) []string {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+
+	known := make(map[string]bool, len(sorted))
+	for _, name := range sorted {
+		known[name] = true
+	}
+
+	ordered := make([]string, 0, len(sorted))
+	state := make(map[string]int) // 0 unvisited, 1 visiting, 2 done
+
+	var visit func(name string)
+	visit = func(name string) {
+		if state[name] != 0 {
+			return
+		}
+		state[name] = 1
+		deps := append([]string(nil), dependsOn[name]...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if known[dep] {
+				visit(dep)
+			}
+		}
+		state[name] = 2
+		ordered = append(ordered, name)
+	}
+
+	for _, name := range sorted {
+		visit(name)
+	}
+
+	return ordered
+}
+
+// resetSequences moves the sequences owned by restored columns past the
+// highest restored value
+func resetSequences(ctx context.Context, tx pgx.Tx, schemaName string) error {
+	rows, err := tx.Query(ctx, `
+		SELECT seq_ns.nspname, seq.relname, tbl.relname, att.attname
+		FROM pg_depend dep
+		JOIN pg_class seq ON seq.oid = dep.objid AND seq.relkind = 'S'
+		JOIN pg_namespace seq_ns ON seq_ns.oid = seq.relnamespace
+		JOIN pg_class tbl ON tbl.oid = dep.refobjid
+		JOIN pg_namespace tbl_ns ON tbl_ns.oid = tbl.relnamespace
+		JOIN pg_attribute att ON att.attrelid = tbl.oid AND att.attnum = dep.refobjsubid
+		WHERE tbl_ns.nspname = $1 AND dep.deptype IN ('a', 'i')
+	`, schemaName)
+	if err != nil {
+		return fmt.Errorf("failed to list sequences: %w", err)
+	}
+
+	type ownedSequence struct{ seqSchema, seq, table, column string }
+	var sequences []ownedSequence
+	var s ownedSequence
+	_, err = pgx.ForEachRow(rows, []any{&s.seqSchema, &s.seq, &s.table, &s.column}, func() error {
+		sequences = append(sequences, s)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list sequences: %w", err)
+	}
+
+	for _, s := range sequences {
+		query := fmt.Sprintf("SELECT setval('%s', COALESCE(MAX(%s), 0) + 1, false) FROM %s",
+			strings.ReplaceAll(pgx.Identifier{s.seqSchema, s.seq}.Sanitize(), "'", "''"),
+			pgx.Identifier{s.column}.Sanitize(),
+			pgx.Identifier{schemaName, s.table}.Sanitize(),
+		)
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("failed to reset sequence %s: %w", s.seq, err)
+		}
+	}
+
+	return nil
+}
+
+// readManifest reads and validates the manifest entry of an archive
+func readManifest(tr *tar.Reader) (*archiveManifest, error) {
+	header, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive manifest: %w", err)
+	}
+	if header.Name != manifestName {
+		return nil, errors.New("archive does not start with a manifest")
+	}
+
+	var manifest archiveManifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode archive manifest: %w", err)
+	}
+	if manifest.FormatVersion != archiveFormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d", manifest.FormatVersion)
+	}
+
+	return &manifest, nil
+}
+
+// writeTarEntry writes one regular file entry to the archive
+func writeTarEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    size,
+		ModTime: time.Now().UTC(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+	return nil
+}
+
+// quoteColumns renders a quoted, comma-separated column list
+func quoteColumns(columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, pgx.Identifier{column}.Sanitize())
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,31 @@
+package provisioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderByDependencies(t *testing.T) {
+	names := []string{"order_items", "orders", "customers", "products"}
+	dependsOn := map[string][]string{
+		"orders":      {"customers"},
+		"order_items": {"orders", "products"},
+	}
+
+	ordered := orderByDependencies(names, dependsOn)
+
+	assert.Equal(t, []string{"customers", "orders", "products", "order_items"}, ordered)
+}
+
+func TestOrderByDependencies_Cycle(t *testing.T) {
+	names := []string{"b", "a"}
+	dependsOn := map[string][]string{
+		"a": {"b"},
+		"b": {"a"},
+	}
+
+	ordered := orderByDependencies(names, dependsOn)
+
+	assert.ElementsMatch(t, []string{"a", "b"}, ordered)
+}
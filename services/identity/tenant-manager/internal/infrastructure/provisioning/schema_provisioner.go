@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cotai/tenant-manager/internal/domain"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -187,16 +188,36 @@ func FormatSchemaName(tenantID uuid.UUID) string {
 	return fmt.Sprintf("tenant_%s", cleanID)
 }
 
-// GetSchemaInfo returns information about a tenant schema
+// MeasureSchema measures the exact disk usage of a tenant schema
+func (p *SchemaProvisioner) MeasureSchema(ctx context.Context, tenantID uuid.UUID) (domain.SchemaSize, error) {
+	info, err := p.GetSchemaInfo(ctx, FormatSchemaName(tenantID))
+	if err != nil {
+		return domain.SchemaSize{}, err
+	}
+
+	return domain.SchemaSize{
+		TableBytes: info.TableBytes,
+		IndexBytes: info.IndexBytes,
+		ToastBytes: info.ToastBytes,
+	}, nil
+}
+
+// GetSchemaInfo returns the disk usage of a schema, in bytes. It adds up the
+// tables and materialized views of the schema; partitioned tables have no
+// storage of their own and are counted through their partitions. The TOAST
+// size of each table is split out of pg_table_size, which includes it.
 func (p *SchemaProvisioner) GetSchemaInfo(ctx context.Context, schemaName string) (*SchemaInfo, error) {
 	query := `
 		SELECT
-			schemaname,
-			pg_size_pretty(pg_total_relation_size(schemaname||'.*')) as size
-		FROM pg_tables
-		WHERE schemaname = $1
-		GROUP BY schemaname
-		LIMIT 1
+			n.nspname AS schemaname,
+			COALESCE(SUM(pg_table_size(c.oid) - COALESCE(pg_total_relation_size(NULLIF(c.reltoastrelid, 0)), 0)), 0) AS table_bytes,
+			COALESCE(SUM(pg_indexes_size(c.oid)), 0) AS index_bytes,
+			COALESCE(SUM(pg_total_relation_size(NULLIF(c.reltoastrelid, 0))), 0) AS toast_bytes,
+			COALESCE(SUM(pg_total_relation_size(c.oid)), 0) AS total_bytes
+		FROM pg_namespace n
+		LEFT JOIN pg_class c ON c.relnamespace = n.oid AND c.relkind IN ('r', 'm')
+		WHERE n.nspname = $1
+		GROUP BY n.nspname
 	`
 
 	var info SchemaInfo
@@ -211,8 +232,11 @@ func (p *SchemaProvisioner) GetSchemaInfo(ctx context.Context, schemaName string
 	return &info, nil
 }
 
-// SchemaInfo holds information about a tenant schema
+// SchemaInfo holds the disk usage of a tenant schema, in bytes
 type SchemaInfo struct {
 	SchemaName string `db:"schemaname"`
-	Size       string `db:"size"`
+	TableBytes int64  `db:"table_bytes"`
+	IndexBytes int64  `db:"index_bytes"`
+	ToastBytes int64  `db:"toast_bytes"`
+	TotalBytes int64  `db:"total_bytes"`
 }
@@ -0,0 +1,92 @@
+package actor
+
+import (
+	"context"
+	"net"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Actor is the principal performing a request, as recorded in the audit log
+type Actor struct {
+	Type      domain.ActorType
+	ID        string
+	Name      string
+	RequestID string
+	SourceIP  string
+}
+
+// System is the actor of operations not triggered by an authenticated caller
+var System = Actor{Type: domain.ActorSystem, ID: "system", Name: "tenant-manager"}
+
+type contextKey struct{}
+
+// WithActor stores the actor in the context
+func WithActor(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, a)
+}
+
+// FromContext returns the actor stored in the context, or System
+func FromContext(ctx context.Context) Actor {
+	if a, ok := ctx.Value(contextKey{}).(Actor); ok {
+		return a
+	}
+	return System
+}
+
+// UUID returns the actor ID as a UUID, or nil if it is not one
+func (a Actor) UUID() *uuid.UUID {
+	id, err := uuid.Parse(a.ID)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// Stamp copies the actor onto an audit event
+func (a Actor) Stamp(event *domain.AuditEvent) *domain.AuditEvent {
+	event.ActorType = a.Type
+	event.ActorID = a.ID
+	event.ActorName = a.Name
+	event.RequestID = a.RequestID
+	event.SourceIP = a.SourceIP
+	return event
+}
+
+// StampTransitions copies the actor onto a tenant's pending status transitions
+func (a Actor) StampTransitions(tenant *domain.Tenant) {
+	for _, tr := range tenant.PendingTransitions() {
+		tr.ActorType = a.Type
+		tr.ActorID = a.ID
+		tr.ActorName = a.Name
+	}
+}
+
+// StampPlanChanges copies the actor onto a tenant's pending plan changes
+func (a Actor) StampPlanChanges(tenant *domain.Tenant) {
+	for _, c := range tenant.PendingPlanChanges() {
+		c.ActorType = a.Type
+		c.ActorID = a.ID
+		c.ActorName = a.Name
+	}
+}
+
+// StampQuotaOverrides copies the actor onto a tenant's changed quota overrides
+func (a Actor) StampQuotaOverrides(tenant *domain.Tenant) {
+	for _, name := range tenant.PendingQuotaChanges() {
+		if o := tenant.QuotaOverride(name); o != nil {
+			o.ActorType = a.Type
+			o.ActorID = a.ID
+			o.ActorName = a.Name
+		}
+	}
+}
+
+// HostOnly strips the port from a host:port address
+func HostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+const (
+	// keyScheme prefixes every key so leaked keys are easy to recognize
+	keyScheme = "cotai_"
+	// prefixLength is the length of the hex lookup prefix
+	prefixLength = 12
+	// secretBytes is the entropy of the secret part
+	secretBytes = 32
+)
+
+// Generate creates a new random API key.
+// It returns the plaintext key, its lookup prefix and its hash.
+func Generate() (key, prefix, hash string, err error) {
+	prefixRaw := make([]byte, prefixLength/2)
+	if _, err := rand.Read(prefixRaw); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate key prefix: %w", err)
+	}
+
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate key secret: %w", err)
+	}
+
+	prefix = hex.EncodeToString(prefixRaw)
+	key = keyScheme + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	return key, prefix, Hash(key), nil
+}
+
+// Parse extracts the lookup prefix from a plaintext key
+func Parse(key string) (string, error) {
+	rest, ok := strings.CutPrefix(key, keyScheme)
+	if !ok || len(rest) <= prefixLength+1 || rest[prefixLength] != '_' {
+		return "", domain.ErrInvalidAPIKey
+	}
+
+	prefix := rest[:prefixLength]
+	if _, err := hex.DecodeString(prefix); err != nil {
+		return "", domain.ErrInvalidAPIKey
+	}
+
+	return prefix, nil
+}
+
+// Hash returns the hex SHA-256 digest stored for a key
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches compares a plaintext key with a stored hash in constant time
+func Matches(key, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(Hash(key)), []byte(hash)) == 1
+}
@@ -0,0 +1,95 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// lastUsedResolution limits how often last_used_at is written for a busy key
+const lastUsedResolution = time.Minute
+
+// Validator authenticates API keys against the service account repository
+type Validator struct {
+	repo   domain.ServiceAccountRepository
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// NewValidator creates a new API key validator
+func NewValidator(repo domain.ServiceAccountRepository, logger *zap.Logger) *Validator {
+	return &Validator{
+		repo:   repo,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// ValidateAPIKey validates a plaintext API key and returns the claims of its service account
+func (v *Validator) ValidateAPIKey(ctx context.Context, key string) (*middleware.TokenClaims, error) {
+	prefix, err := Parse(key)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := v.repo.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return nil, domain.ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+
+	if !Matches(key, apiKey.KeyHash) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	now := v.now()
+	if err := apiKey.CheckUsable(now); err != nil {
+		return nil, err
+	}
+
+	account, err := v.repo.GetByID(ctx, apiKey.ServiceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	if !account.IsActive {
+		return nil, domain.ErrServiceAccountInactive
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedResolution {
+		go func() {
+			touchCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := v.repo.TouchAPIKey(touchCtx, apiKey.ID, now); err != nil {
+				v.logger.Warn("Failed to record API key usage",
+					zap.String("key_id", apiKey.ID.String()),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	claims := &middleware.TokenClaims{
+		Subject:     account.ID.String(),
+		Username:    middleware.ServiceAccountUsername(account.Name),
+		ClientID:    apiKey.KeyPrefix,
+		Permissions: apiKey.Permissions,
+		// The key belongs to the account, never to a person
+		ServiceAccount: true,
+	}
+	if account.TenantID != nil {
+		claims.TenantID = account.TenantID.String()
+	}
+	if apiKey.ExpiresAt != nil {
+		claims.ExpiresAt = *apiKey.ExpiresAt
+	}
+
+	return claims, nil
+}
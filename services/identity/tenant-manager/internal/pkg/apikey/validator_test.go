@@ -0,0 +1,129 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeRepo is an in-memory domain.ServiceAccountRepository
+type fakeRepo struct {
+	domain.ServiceAccountRepository
+	accounts map[uuid.UUID]*domain.ServiceAccount
+	keys     map[string]*domain.APIKey
+}
+
+func (f *fakeRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.ServiceAccount, error) {
+	if account, ok := f.accounts[id]; ok {
+		return account, nil
+	}
+	return nil, domain.ErrServiceAccountNotFound
+}
+
+func (f *fakeRepo) GetAPIKeyByPrefix(_ context.Context, prefix string) (*domain.APIKey, error) {
+	if key, ok := f.keys[prefix]; ok {
+		return key, nil
+	}
+	return nil, domain.ErrAPIKeyNotFound
+}
+
+func (f *fakeRepo) TouchAPIKey(context.Context, uuid.UUID, time.Time) error {
+	return nil
+}
+
+func newFixture(t *testing.T) (*fakeRepo, *domain.ServiceAccount, *domain.APIKey, string) {
+	t.Helper()
+
+	tenantID := uuid.New()
+	account, err := domain.NewServiceAccount("billing-job", "", &tenantID)
+	require.NoError(t, err)
+
+	plaintext, prefix, hash, err := Generate()
+	require.NoError(t, err)
+
+	key, err := domain.NewAPIKey(account.ID, "ci", prefix, hash, []string{"tenant:read"}, nil)
+	require.NoError(t, err)
+
+	repo := &fakeRepo{
+		accounts: map[uuid.UUID]*domain.ServiceAccount{account.ID: account},
+		keys:     map[string]*domain.APIKey{prefix: key},
+	}
+
+	return repo, account, key, plaintext
+}
+
+func TestGenerateAndParse(t *testing.T) {
+	plaintext, prefix, hash, err := Generate()
+	require.NoError(t, err)
+
+	parsed, err := Parse(plaintext)
+	require.NoError(t, err)
+	assert.Equal(t, prefix, parsed)
+	assert.True(t, Matches(plaintext, hash))
+	assert.False(t, Matches(plaintext+"x", hash))
+
+	for _, bad := range []string{"", "cotai_", "cotai_zzzzzzzzzzzz_secret", "other_0123456789ab_secret"} {
+		_, err := Parse(bad)
+		assert.ErrorIs(t, err, domain.ErrInvalidAPIKey, bad)
+	}
+}
+
+func TestValidator_ValidateAPIKey(t *testing.T) {
+	repo, account, _, plaintext := newFixture(t)
+	v := NewValidator(repo, zap.NewNop())
+
+	claims, err := v.ValidateAPIKey(context.Background(), plaintext)
+	require.NoError(t, err)
+	assert.Equal(t, account.ID.String(), claims.Subject)
+	assert.Equal(t, account.TenantID.String(), claims.TenantID)
+	assert.Equal(t, []string{"tenant:read"}, claims.Permissions)
+	assert.True(t, claims.IsServiceAccount())
+}
+
+func TestValidator_RejectsUnusableKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*domain.ServiceAccount, *domain.APIKey, *string)
+		wantErr error
+	}{
+		{
+			name:    "wrong secret",
+			mutate:  func(_ *domain.ServiceAccount, _ *domain.APIKey, key *string) { *key += "x" },
+			wantErr: domain.ErrInvalidAPIKey,
+		},
+		{
+			name: "expired",
+			mutate: func(_ *domain.ServiceAccount, k *domain.APIKey, _ *string) {
+				past := time.Now().Add(-time.Minute)
+				k.ExpiresAt = &past
+			},
+			wantErr: domain.ErrAPIKeyExpired,
+		},
+		{
+			name:    "revoked",
+			mutate:  func(_ *domain.ServiceAccount, k *domain.APIKey, _ *string) { _ = k.Revoke() },
+			wantErr: domain.ErrAPIKeyRevoked,
+		},
+		{
+			name:    "inactive account",
+			mutate:  func(a *domain.ServiceAccount, _ *domain.APIKey, _ *string) { _ = a.Deactivate() },
+			wantErr: domain.ErrServiceAccountInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, account, key, plaintext := newFixture(t)
+			tt.mutate(account, key, &plaintext)
+
+			_, err := NewValidator(repo, zap.NewNop()).ValidateAPIKey(context.Background(), plaintext)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
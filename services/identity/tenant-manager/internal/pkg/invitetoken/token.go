@@ -0,0 +1,131 @@
+package invitetoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// tokenScheme prefixes every token so leaked tokens are easy to recognize
+	tokenScheme = "cotai_inv_"
+	// nonceBytes makes every token of an invitation unique
+	nonceBytes = 16
+	// payloadLength is the invitation ID, the expiry and the nonce
+	payloadLength = 16 + 8 + nonceBytes
+	// MinKeyLength is the minimum length of a signing key
+	MinKeyLength = 32
+)
+
+// ErrKeyTooShort is returned for a signing key shorter than MinKeyLength
+var ErrKeyTooShort = errors.New("invitation signing key must be at least 32 bytes")
+
+// Signer signs and verifies invitation tokens. A token carries the
+// invitation ID and expiry under an HMAC-SHA256 signature, so forged and
+// expired tokens are refused before the invitation is looked up.
+type Signer struct {
+	key []byte
+	now func() time.Time
+}
+
+// NewSigner creates a new token signer
+func NewSigner(key []byte) (*Signer, error) {
+	if len(key) < MinKeyLength {
+		return nil, ErrKeyTooShort
+	}
+
+	return &Signer{
+		key: key,
+		now: time.Now,
+	}, nil
+}
+
+// GenerateKey creates a random signing key
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, MinKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	return key, nil
+}
+
+// Sign creates a new token for an invitation expiring at expiresAt.
+// It returns the plaintext token and its hash.
+func (s *Signer) Sign(invitationID uuid.UUID, expiresAt time.Time) (token, hash string, err error) {
+	payload := make([]byte, payloadLength)
+	copy(payload, invitationID[:])
+	binary.BigEndian.PutUint64(payload[16:24], uint64(expiresAt.Unix()))
+	if _, err := rand.Read(payload[24:]); err != nil {
+		return "", "", fmt.Errorf("failed to generate token nonce: %w", err)
+	}
+
+	token = tokenScheme +
+		base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(s.mac(payload))
+
+	return token, Hash(token), nil
+}
+
+// Verify checks a token's signature and expiry and returns its invitation ID
+func (s *Signer) Verify(token string) (uuid.UUID, error) {
+	rest, ok := strings.CutPrefix(token, tokenScheme)
+	if !ok {
+		return uuid.Nil, domain.ErrInvalidInvitationToken
+	}
+
+	encodedPayload, encodedMAC, ok := strings.Cut(rest, ".")
+	if !ok {
+		return uuid.Nil, domain.ErrInvalidInvitationToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != payloadLength {
+		return uuid.Nil, domain.ErrInvalidInvitationToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.mac(payload)) {
+		return uuid.Nil, domain.ErrInvalidInvitationToken
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[16:24])), 0)
+	if !s.now().Before(expiresAt) {
+		return uuid.Nil, domain.ErrInvitationExpired
+	}
+
+	id, err := uuid.FromBytes(payload[:16])
+	if err != nil {
+		return uuid.Nil, domain.ErrInvalidInvitationToken
+	}
+
+	return id, nil
+}
+
+// mac returns the HMAC-SHA256 of a token payload
+func (s *Signer) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+// Hash returns the hex SHA-256 digest stored for a token
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches compares a plaintext token with a stored hash in constant time
+func Matches(token, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(Hash(token)), []byte(hash)) == 1
+}
@@ -0,0 +1,85 @@
+package invitetoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigner(t *testing.T) *Signer {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	s, err := NewSigner(key)
+	require.NoError(t, err)
+	return s
+}
+
+func TestNewSigner_ShortKey(t *testing.T) {
+	_, err := NewSigner([]byte("too-short"))
+	assert.ErrorIs(t, err, ErrKeyTooShort)
+}
+
+func TestSigner_SignAndVerify(t *testing.T) {
+	s := newSigner(t)
+	id := uuid.New()
+
+	token, hash, err := s.Sign(id, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, Matches(token, hash))
+
+	got, err := s.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, id, got)
+
+	// Every token of an invitation is unique, so a resent link replaces the old one
+	other, _, err := s.Sign(id, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.False(t, Matches(other, hash))
+}
+
+func TestSigner_Verify_Rejects(t *testing.T) {
+	s := newSigner(t)
+	token, _, err := s.Sign(uuid.New(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	foreign, _, err := newSigner(t).Sign(uuid.New(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	payload, mac, _ := strings.Cut(strings.TrimPrefix(token, tokenScheme), ".")
+	first := "A"
+	if payload[:1] == first {
+		first = "B"
+	}
+
+	for name, tok := range map[string]string{
+		"empty":         "",
+		"no scheme":     strings.TrimPrefix(token, tokenScheme),
+		"no signature":  tokenScheme + payload,
+		"tampered":      tokenScheme + first + payload[1:] + "." + mac,
+		"other key":     foreign,
+		"bad encoding":  tokenScheme + "!!!." + mac,
+		"short payload": tokenScheme + "AAAA." + mac,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.Verify(tok)
+			assert.ErrorIs(t, err, domain.ErrInvalidInvitationToken)
+		})
+	}
+}
+
+func TestSigner_Verify_Expired(t *testing.T) {
+	s := newSigner(t)
+	token, _, err := s.Sign(uuid.New(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	s.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, err = s.Verify(token)
+	assert.ErrorIs(t, err, domain.ErrInvitationExpired)
+}
@@ -0,0 +1,148 @@
+package jwt
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+)
+
+// numericDate represents a JWT NumericDate (seconds since epoch)
+type numericDate float64
+
+// Time converts the numeric date to time.Time
+func (d numericDate) Time() time.Time {
+	sec := int64(d)
+	nsec := int64((float64(d) - float64(sec)) * 1e9)
+	return time.Unix(sec, nsec)
+}
+
+// audience handles the "aud" claim, which may be a string or an array
+type audience []string
+
+// UnmarshalJSON accepts both string and array forms
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+func (a audience) contains(value string) bool {
+	for _, aud := range a {
+		if aud == value {
+			return true
+		}
+	}
+	return false
+}
+
+// roleSet represents a Keycloak role container ({"roles": [...]})
+type roleSet struct {
+	Roles []string `json:"roles"`
+}
+
+// keycloakClaims represents the claims of a Keycloak access token
+type keycloakClaims struct {
+	Issuer    string       `json:"iss"`
+	Subject   string       `json:"sub"`
+	Audience  audience     `json:"aud"`
+	ExpiresAt *numericDate `json:"exp"`
+	NotBefore *numericDate `json:"nbf"`
+	IssuedAt  *numericDate `json:"iat"`
+
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	AuthorizedParty   string `json:"azp"`
+
+	RealmAccess    roleSet            `json:"realm_access"`
+	ResourceAccess map[string]roleSet `json:"resource_access"`
+
+	// raw holds every claim so the tenant claim name can be configured
+	raw map[string]interface{}
+}
+
+// UnmarshalJSON decodes the known claims and keeps the raw claim set
+func (c *keycloakClaims) UnmarshalJSON(data []byte) error {
+	type plain keycloakClaims
+	if err := json.Unmarshal(data, (*plain)(c)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &c.raw)
+}
+
+// toTokenClaims maps Keycloak claims into middleware.TokenClaims.
+// Realm roles are merged with the client roles of the expected audience
+// and of the authorized party.
+func (c *keycloakClaims) toTokenClaims(expectedAudience, tenantClaim string) *middleware.TokenClaims {
+	seen := make(map[string]bool)
+	roles := make([]string, 0, len(c.RealmAccess.Roles))
+
+	addRoles := func(list []string) {
+		for _, role := range list {
+			if !seen[role] {
+				seen[role] = true
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	addRoles(c.RealmAccess.Roles)
+	if expectedAudience != "" {
+		addRoles(c.ResourceAccess[expectedAudience].Roles)
+	}
+	if c.AuthorizedParty != "" && c.AuthorizedParty != expectedAudience {
+		addRoles(c.ResourceAccess[c.AuthorizedParty].Roles)
+	}
+
+	claims := &middleware.TokenClaims{
+		Subject:        c.Subject,
+		Email:          c.Email,
+		EmailVerified:  c.EmailVerified,
+		Username:       c.PreferredUsername,
+		ClientID:       c.AuthorizedParty,
+		Roles:          roles,
+		TenantID:       extractTenantID(c.raw[tenantClaim]),
+		ServiceAccount: c.isClientCredentials(),
+	}
+	if c.ExpiresAt != nil {
+		claims.ExpiresAt = c.ExpiresAt.Time()
+	}
+
+	return claims
+}
+
+// isClientCredentials reports whether the token was issued to a client
+// through the client credentials grant: its user is then the service
+// account Keycloak creates for the client, named after the client ID in
+// lower case. A user signing in through the client keeps their own name.
+func (c *keycloakClaims) isClientCredentials() bool {
+	return c.AuthorizedParty != "" &&
+		c.PreferredUsername == middleware.ServiceAccountUsername(strings.ToLower(c.AuthorizedParty))
+}
+
+// extractTenantID reads the tenant claim. Multi-tenant users may carry an
+// array; like the Keycloak TenantIdMapper, the first entry wins.
+func extractTenantID(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			if s, ok := v[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,268 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// jsonWebKey represents a single key from a JWKS document (RFC 7517)
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+
+	// RSA public key parameters
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC public key parameters
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwksDocument represents a JWKS document
+type jwksDocument struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// publicKey is a parsed verification key
+type publicKey struct {
+	kid string
+	alg string
+	key crypto.PublicKey
+}
+
+// KeySet fetches and caches the JWKS published by the identity provider.
+// Keys are indexed by kid and refreshed periodically and on demand when an
+// unknown kid is seen (key rotation).
+type KeySet struct {
+	source          string
+	httpClient      *http.Client
+	minRefreshDelay time.Duration
+	logger          *zap.Logger
+
+	mu          sync.RWMutex
+	keys        map[string]*publicKey
+	lastRefresh time.Time
+
+	refreshMu sync.Mutex
+}
+
+// NewKeySet creates a new key set for the given source. The source may be an
+// http(s) URL, a file:// URL or a plain filesystem path.
+func NewKeySet(source string, httpClient *http.Client, minRefreshDelay time.Duration, logger *zap.Logger) *KeySet {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &KeySet{
+		source:          source,
+		httpClient:      httpClient,
+		minRefreshDelay: minRefreshDelay,
+		logger:          logger,
+		keys:            make(map[string]*publicKey),
+	}
+}
+
+// Key returns the key for the given kid, refreshing the key set once if the
+// kid is not known yet
+func (ks *KeySet) Key(ctx context.Context, kid string) (*publicKey, error) {
+	if key := ks.lookup(kid); key != nil {
+		return key, nil
+	}
+
+	// Unknown kid: keys may have been rotated, try to refresh
+	if err := ks.refreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	if key := ks.lookup(kid); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+}
+
+// Refresh fetches the JWKS document and replaces the cached keys
+func (ks *KeySet) Refresh(ctx context.Context) error {
+	ks.refreshMu.Lock()
+	defer ks.refreshMu.Unlock()
+
+	return ks.refresh(ctx)
+}
+
+// refreshIfStale refreshes the key set unless it was refreshed recently.
+// This prevents tokens with bogus kids from hammering the JWKS endpoint.
+func (ks *KeySet) refreshIfStale(ctx context.Context) error {
+	ks.refreshMu.Lock()
+	defer ks.refreshMu.Unlock()
+
+	ks.mu.RLock()
+	lastRefresh := ks.lastRefresh
+	ks.mu.RUnlock()
+
+	if !lastRefresh.IsZero() && time.Since(lastRefresh) < ks.minRefreshDelay {
+		return nil
+	}
+
+	return ks.refresh(ctx)
+}
+
+func (ks *KeySet) refresh(ctx context.Context) error {
+	data, err := ks.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrJWKSUnavailable, err)
+	}
+
+	var doc jwksDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%w: invalid JWKS document: %v", ErrJWKSUnavailable, err)
+	}
+
+	keys := make(map[string]*publicKey, len(doc.Keys))
+	for _, jwk := range doc.Keys {
+		// Skip encryption keys
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := parseJSONWebKey(jwk)
+		if err != nil {
+			ks.logger.Warn("Skipping unsupported JWKS key",
+				zap.String("kid", jwk.Kid),
+				zap.String("kty", jwk.Kty),
+				zap.Error(err),
+			)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.lastRefresh = time.Now()
+	ks.mu.Unlock()
+
+	ks.logger.Debug("JWKS refreshed",
+		zap.String("source", ks.source),
+		zap.Int("keys", len(keys)),
+	)
+
+	return nil
+}
+
+func (ks *KeySet) lookup(kid string) *publicKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	return ks.keys[kid]
+}
+
+// fetch reads the raw JWKS document from the configured source
+func (ks *KeySet) fetch(ctx context.Context) ([]byte, error) {
+	u, err := url.Parse(ks.source)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.source, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := ks.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, ks.source)
+		}
+
+		return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	}
+
+	path := ks.source
+	if err == nil && u.Scheme == "file" {
+		path = u.Path
+	}
+
+	return os.ReadFile(path)
+}
+
+// parseJSONWebKey converts a JWK into a crypto public key
+func parseJSONWebKey(jwk jsonWebKey) (*publicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+
+		return &publicKey{
+			kid: jwk.Kid,
+			alg: jwk.Alg,
+			key: &rsa.PublicKey{N: n, E: int(e.Int64())},
+		}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+
+		return &publicKey{
+			kid: jwk.Kid,
+			alg: jwk.Alg,
+			key: &ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -1,34 +1,275 @@
 package jwt
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/cotai/tenant-manager/internal/delivery/http/middleware"
+	"go.uber.org/zap"
 )
 
-// Validator validates JWT tokens
+// Validation errors
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("unknown signing key")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrTokenExpired         = errors.New("token is expired")
+	ErrTokenNotYetValid     = errors.New("token is not valid yet")
+	ErrInvalidIssuer        = errors.New("invalid token issuer")
+	ErrInvalidAudience      = errors.New("invalid token audience")
+	ErrJWKSUnavailable      = errors.New("JWKS unavailable")
+)
+
+// Config holds the JWT validator configuration
+type Config struct {
+	// JWKSURL is the JWKS location (http(s) URL, file:// URL or file path)
+	JWKSURL string
+	// Issuer is the expected "iss" claim
+	Issuer string
+	// Audience is the expected "aud" claim (skipped when empty)
+	Audience string
+	// TenantClaim is the claim carrying the tenant ID (default "tenant_id")
+	TenantClaim string
+	// ClockSkew is the leeway applied to exp/nbf/iat checks
+	ClockSkew time.Duration
+	// RefreshInterval is how often keys are refreshed in the background
+	RefreshInterval time.Duration
+	// HTTPClient is used to fetch the JWKS (optional)
+	HTTPClient *http.Client
+}
+
+// Validator validates JWT tokens issued by Keycloak
 type Validator struct {
-	publicKeyURL string
-	issuer       string
+	cfg    Config
+	keys   *KeySet
+	logger *zap.Logger
+	now    func() time.Time
+
+	stop chan struct{}
+	done chan struct{}
 }
 
 // NewValidator creates a new JWT validator
-func NewValidator(publicKeyURL, issuer string) *Validator {
+func NewValidator(cfg Config, logger *zap.Logger) *Validator {
+	if cfg.TenantClaim == "" {
+		cfg.TenantClaim = "tenant_id"
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = 15 * time.Minute
+	}
+
 	return &Validator{
-		publicKeyURL: publicKeyURL,
-		issuer:       issuer,
+		cfg:    cfg,
+		keys:   NewKeySet(cfg.JWKSURL, cfg.HTTPClient, 10*time.Second, logger),
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Start loads the key set and refreshes it in the background until Close is called.
+// A failed initial load is not fatal: keys are fetched again on first use.
+func (v *Validator) Start(ctx context.Context) {
+	if err := v.keys.Refresh(ctx); err != nil {
+		v.logger.Warn("Initial JWKS load failed, will retry",
+			zap.String("jwks_url", v.cfg.JWKSURL),
+			zap.Error(err),
+		)
+	}
+
+	v.stop = make(chan struct{})
+	v.done = make(chan struct{})
+
+	go func() {
+		defer close(v.done)
+
+		ticker := time.NewTicker(v.cfg.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-v.stop:
+				return
+			case <-ticker.C:
+				refreshCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				if err := v.keys.Refresh(refreshCtx); err != nil {
+					v.logger.Warn("Background JWKS refresh failed", zap.Error(err))
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// Close stops the background refresh
+func (v *Validator) Close() {
+	if v.stop == nil {
+		return
 	}
+	close(v.stop)
+	<-v.done
+	v.stop = nil
+}
+
+// tokenHeader represents the JOSE header
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
 }
 
 // ValidateToken validates a JWT token and returns claims
-// TODO: Implement full JWT validation with Keycloak JWKS
 func (v *Validator) ValidateToken(tokenString string) (*middleware.TokenClaims, error) {
-	// TODO: Implement actual JWT validation:
-	// 1. Fetch public key from Keycloak JWKS endpoint
-	// 2. Parse and verify token signature
-	// 3. Validate issuer, expiry, etc.
-	// 4. Extract claims
-
-	// For now, return error to indicate not implemented
-	return nil, fmt.Errorf("JWT validation not yet implemented - needs Keycloak integration")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return v.ValidateTokenContext(ctx, tokenString)
+}
+
+// ValidateTokenContext validates a JWT token using the given context for key lookups
+func (v *Validator) ValidateTokenContext(ctx context.Context, tokenString string) (*middleware.TokenClaims, error) {
+	parts := strings.Split(tokenString, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: invalid header: %v", ErrMalformedToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding", ErrMalformedToken)
+	}
+
+	key, err := v.keys.Key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pin the algorithm to the key's declared algorithm when present
+	if key.alg != "" && key.alg != header.Alg {
+		return nil, fmt.Errorf("%w: token alg %q does not match key alg %q", ErrUnsupportedAlgorithm, header.Alg, key.alg)
+	}
+
+	if err := verifySignature(header.Alg, key.key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims keycloakClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: invalid payload: %v", ErrMalformedToken, err)
+	}
+
+	if err := v.validateClaims(&claims); err != nil {
+		return nil, err
+	}
+
+	return claims.toTokenClaims(v.cfg.Audience, v.cfg.TenantClaim), nil
+}
+
+// validateClaims checks issuer, audience and time-based claims
+func (v *Validator) validateClaims(claims *keycloakClaims) error {
+	now := v.now()
+	skew := v.cfg.ClockSkew
+
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("%w: missing exp claim", ErrMalformedToken)
+	}
+	if now.After(claims.ExpiresAt.Time().Add(skew)) {
+		return ErrTokenExpired
+	}
+	if claims.NotBefore != nil && now.Add(skew).Before(claims.NotBefore.Time()) {
+		return ErrTokenNotYetValid
+	}
+	if claims.IssuedAt != nil && now.Add(skew).Before(claims.IssuedAt.Time()) {
+		return ErrTokenNotYetValid
+	}
+
+	if v.cfg.Issuer != "" && claims.Issuer != v.cfg.Issuer {
+		return fmt.Errorf("%w: %q", ErrInvalidIssuer, claims.Issuer)
+	}
+
+	if v.cfg.Audience != "" && !claims.Audience.contains(v.cfg.Audience) {
+		return fmt.Errorf("%w: expected %q", ErrInvalidAudience, v.cfg.Audience)
+	}
+
+	return nil
+}
+
+// verifySignature verifies the JWS signature for the supported algorithms
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, alg)
+	}
+
+	h := hash.New()
+	h.Write([]byte(signingInput))
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: key type does not match %s", ErrUnsupportedAlgorithm, alg)
+		}
+		if err := rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature); err != nil {
+			return ErrInvalidSignature
+		}
+
+	case "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: key type does not match %s", ErrUnsupportedAlgorithm, alg)
+		}
+		opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash}
+		if err := rsa.VerifyPSS(rsaKey, hash, digest, signature, opts); err != nil {
+			return ErrInvalidSignature
+		}
+
+	case "ES":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: key type does not match %s", ErrUnsupportedAlgorithm, alg)
+		}
+		// JWS encodes ECDSA signatures as the fixed-size concatenation R || S
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return ErrInvalidSignature
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return ErrInvalidSignature
+		}
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
 }
@@ -0,0 +1,283 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testIssuer   = "http://keycloak.test/realms/cotai"
+	testAudience = "cotai-backend-services"
+)
+
+type testKey struct {
+	kid     string
+	private *rsa.PrivateKey
+}
+
+func newTestKey(t *testing.T, kid string) testKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return testKey{kid: kid, private: key}
+}
+
+func (k testKey) jwk() map[string]string {
+	return map[string]string{
+		"kid": k.kid,
+		"kty": "RSA",
+		"alg": "RS256",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(k.private.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.private.E)).Bytes()),
+	}
+}
+
+func jwksJSON(t *testing.T, keys ...testKey) []byte {
+	t.Helper()
+	doc := map[string]interface{}{"keys": []map[string]string{}}
+	list := make([]map[string]string, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, k.jwk())
+	}
+	doc["keys"] = list
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return data
+}
+
+func (k testKey) sign(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": k.kid})
+	payload, _ := json.Marshal(claims)
+
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, k.private, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims() map[string]interface{} {
+	now := time.Now()
+	return map[string]interface{}{
+		"iss":                testIssuer,
+		"sub":                "f0b9c7a2-5d4e-4c1b-9a8f-1e2d3c4b5a69",
+		"aud":                []string{testAudience, "account"},
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"iat":                now.Unix(),
+		"email":              "admin@cotai.local",
+		"email_verified":     true,
+		"preferred_username": "admin@cotai.local",
+		"azp":                "cotai-web-app",
+		"tenant_id":          "00000000-0000-0000-0000-000000000000",
+		"realm_access":       map[string]interface{}{"roles": []string{"cotai_admin", "cotai_user"}},
+		"resource_access": map[string]interface{}{
+			testAudience: map[string]interface{}{"roles": []string{"tenant:read"}},
+			"account":    map[string]interface{}{"roles": []string{"manage-account"}},
+		},
+	}
+}
+
+func newTestValidator(jwksURL string) *Validator {
+	return NewValidator(Config{
+		JWKSURL:   jwksURL,
+		Issuer:    testIssuer,
+		Audience:  testAudience,
+		ClockSkew: 30 * time.Second,
+	}, zap.NewNop())
+}
+
+func TestValidator_ValidToken(t *testing.T) {
+	key := newTestKey(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jwksJSON(t, key))
+	}))
+	defer server.Close()
+
+	v := newTestValidator(server.URL)
+
+	claims, err := v.ValidateToken(key.sign(t, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "f0b9c7a2-5d4e-4c1b-9a8f-1e2d3c4b5a69", claims.Subject)
+	assert.Equal(t, "admin@cotai.local", claims.Email)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, "cotai-web-app", claims.ClientID)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", claims.TenantID)
+	assert.ElementsMatch(t, []string{"cotai_admin", "cotai_user", "tenant:read"}, claims.Roles)
+	assert.NotContains(t, claims.Roles, "manage-account")
+	assert.False(t, claims.IsServiceAccount())
+}
+
+func TestValidator_ClientCredentialsToken(t *testing.T) {
+	key := newTestKey(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jwksJSON(t, key))
+	}))
+	defer server.Close()
+
+	v := newTestValidator(server.URL)
+
+	// Keycloak issues client credentials tokens to the client's service account user
+	claims := validClaims()
+	delete(claims, "email")
+	claims["azp"] = "core-bidding"
+	claims["preferred_username"] = "service-account-core-bidding"
+	validated, err := v.ValidateToken(key.sign(t, claims))
+	require.NoError(t, err)
+	assert.True(t, validated.IsServiceAccount())
+
+	// A user signing in through the client is not its service account
+	claims = validClaims()
+	claims["azp"] = "core-bidding"
+	validated, err = v.ValidateToken(key.sign(t, claims))
+	require.NoError(t, err)
+	assert.False(t, validated.IsServiceAccount())
+}
+
+func TestValidator_RejectsInvalidTokens(t *testing.T) {
+	key := newTestKey(t, "key-1")
+	other := newTestKey(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jwksJSON(t, key))
+	}))
+	defer server.Close()
+
+	v := newTestValidator(server.URL)
+
+	tests := []struct {
+		name    string
+		mutate  func(map[string]interface{})
+		signer  testKey
+		wantErr error
+	}{
+		{
+			name:    "expired",
+			mutate:  func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+			signer:  key,
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "not yet valid",
+			mutate:  func(c map[string]interface{}) { c["nbf"] = time.Now().Add(time.Minute).Unix() },
+			signer:  key,
+			wantErr: ErrTokenNotYetValid,
+		},
+		{
+			name:    "wrong issuer",
+			mutate:  func(c map[string]interface{}) { c["iss"] = "http://evil.test/realms/cotai" },
+			signer:  key,
+			wantErr: ErrInvalidIssuer,
+		},
+		{
+			name:    "wrong audience",
+			mutate:  func(c map[string]interface{}) { c["aud"] = "another-client" },
+			signer:  key,
+			wantErr: ErrInvalidAudience,
+		},
+		{
+			name:    "bad signature",
+			mutate:  func(c map[string]interface{}) {},
+			signer:  other,
+			wantErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			tt.mutate(claims)
+
+			_, err := v.ValidateToken(tt.signer.sign(t, claims))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidator_ClockSkew(t *testing.T) {
+	key := newTestKey(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jwksJSON(t, key))
+	}))
+	defer server.Close()
+
+	v := newTestValidator(server.URL)
+
+	claims := validClaims()
+	claims["exp"] = time.Now().Add(-10 * time.Second).Unix()
+
+	_, err := v.ValidateToken(key.sign(t, claims))
+	assert.NoError(t, err)
+}
+
+func TestValidator_KeyRotation(t *testing.T) {
+	oldKey := newTestKey(t, "key-old")
+	newKey := newTestKey(t, "key-new")
+
+	var rotated atomic.Bool
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		if rotated.Load() {
+			w.Write(jwksJSON(t, newKey))
+			return
+		}
+		w.Write(jwksJSON(t, oldKey))
+	}))
+	defer server.Close()
+
+	v := newTestValidator(server.URL)
+
+	_, err := v.ValidateToken(oldKey.sign(t, validClaims()))
+	require.NoError(t, err)
+
+	// Force the rate limiter to allow an immediate refresh
+	v.keys.minRefreshDelay = 0
+	rotated.Store(true)
+
+	claims, err := v.ValidateToken(newKey.sign(t, validClaims()))
+	require.NoError(t, err)
+	assert.NotEmpty(t, claims.Subject)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	_, err = v.ValidateToken(oldKey.sign(t, validClaims()))
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestValidator_LocalJWKSFile(t *testing.T) {
+	key := newTestKey(t, "key-file")
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwksJSON(t, key), 0o600))
+
+	for _, source := range []string{path, "file://" + path} {
+		v := newTestValidator(source)
+
+		claims, err := v.ValidateToken(key.sign(t, validClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, "admin@cotai.local", claims.Username)
+	}
+}
+
+func TestValidator_MalformedToken(t *testing.T) {
+	v := newTestValidator("/nonexistent/jwks.json")
+
+	_, err := v.ValidateToken("not-a-jwt")
+	assert.ErrorIs(t, err, ErrMalformedToken)
+}
@@ -0,0 +1,309 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrForbidden is returned when the principal lacks the required permission
+var ErrForbidden = errors.New("insufficient permissions")
+
+// Permission is an action on a resource, written as "resource:action"
+type Permission string
+
+// Tenant permissions
+const (
+	TenantCreate  Permission = "tenant:create"
+	TenantList    Permission = "tenant:list"
+	TenantRead    Permission = "tenant:read"
+	TenantUpdate  Permission = "tenant:update"
+	TenantSuspend Permission = "tenant:suspend"
+	TenantDelete  Permission = "tenant:delete"
+	TenantArchive Permission = "tenant:archive"
+	// TenantChangePlan is kept apart from TenantUpdate so that tenant admins
+	// cannot change their own subscription
+	TenantChangePlan Permission = "tenant:change_plan"
+	// TenantManageQuotas grants per-tenant quota overrides, also admin-only
+	TenantManageQuotas Permission = "tenant:manage_quotas"
+	// TenantManageFeatures grants per-tenant feature flag overrides, also admin-only
+	TenantManageFeatures Permission = "tenant:manage_features"
+	// TenantManageDomains grants registering, verifying and revoking custom
+	// domains; tenant admins hold it for their own tenant
+	TenantManageDomains Permission = "tenant:manage_domains"
+	// TenantManageMembers grants adding and removing users and changing
+	// their role; tenant admins hold it for their own tenant
+	TenantManageMembers Permission = "tenant:manage_members"
+	// TenantReinstate grants lifting abuse and security suspensions, on top
+	// of TenantSuspend; platform admins only
+	TenantReinstate Permission = "tenant:reinstate"
+	// TenantLiftLegalHold grants lifting legal hold suspensions, on top of
+	// TenantSuspend; compliance officers only
+	TenantLiftLegalHold Permission = "tenant:lift_legal_hold"
+)
+
+// Platform permissions
+const (
+	ServiceAccountManage Permission = "service_account:manage"
+	AuditRead            Permission = "audit:read"
+	PlanManage           Permission = "plan:manage"
+	FeatureManage        Permission = "feature:manage"
+)
+
+// Entitlement and feature flag permissions, held mostly by the service accounts of
+// downstream services
+const (
+	EntitlementCheck   Permission = "entitlement:check"
+	EntitlementConsume Permission = "entitlement:consume"
+	FeatureEvaluate    Permission = "feature:evaluate"
+)
+
+// AllPermissions lists every known permission
+var AllPermissions = []Permission{
+	TenantCreate,
+	TenantList,
+	TenantRead,
+	TenantUpdate,
+	TenantSuspend,
+	TenantDelete,
+	TenantArchive,
+	TenantChangePlan,
+	TenantManageQuotas,
+	TenantManageFeatures,
+	TenantManageDomains,
+	TenantManageMembers,
+	TenantReinstate,
+	TenantLiftLegalHold,
+	ServiceAccountManage,
+	AuditRead,
+	PlanManage,
+	FeatureManage,
+	EntitlementCheck,
+	EntitlementConsume,
+	FeatureEvaluate,
+}
+
+// IsValid checks if the permission is known
+func (p Permission) IsValid() bool {
+	for _, known := range AllPermissions {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope limits where a granted permission applies
+type Scope string
+
+const (
+	// ScopeGlobal applies to every tenant
+	ScopeGlobal Scope = "global"
+	// ScopeTenant applies only to the principal's own tenant
+	ScopeTenant Scope = "tenant"
+	// ScopeSubtree applies to the principal's own tenant and its descendants
+	ScopeSubtree Scope = "subtree"
+)
+
+// Grant is a permission granted at a scope
+type Grant struct {
+	Permission Permission
+	Scope      Scope
+}
+
+// Role names mapped by the default policy
+const (
+	RolePlatformAdmin    = "cotai_admin"
+	RoleTenantAdmin      = "cotai_tenant_admin"
+	RoleTenantAdminLocal = "tenant_admin"
+	// RoleOrganizationAdmin is a platform admin scoped to a parent tenant
+	RoleOrganizationAdmin = "cotai_org_admin"
+	// RoleComplianceOfficer places and lifts legal holds on any tenant
+	RoleComplianceOfficer = "cotai_compliance"
+	// RoleEntitlementService is held by the service accounts of services that
+	// meter usage against entitlements
+	RoleEntitlementService = "cotai_entitlement_service"
+	// RoleServiceReader is held by the service accounts of services that
+	// look up tenants and check their entitlements and features
+	RoleServiceReader = "cotai_service_reader"
+)
+
+// Policy maps role names to the grants they confer
+type Policy map[string][]Grant
+
+// DefaultPolicy is the built-in role to permission mapping.
+// Platform admins manage every tenant but cannot lift legal holds, which is
+// left to compliance officers; organization admins manage their tenant and
+// its descendants, but cannot create or list tenants nor lift restricted
+// suspensions; entitlement services check and consume entitlements of any
+// tenant; service readers read any tenant and check its entitlements and
+// features; tenant admins may read and update their own tenant, and
+// manage its custom domains and members, only.
+var DefaultPolicy = Policy{
+	RolePlatformAdmin: {
+		{Permission: TenantCreate, Scope: ScopeGlobal},
+		{Permission: TenantList, Scope: ScopeGlobal},
+		{Permission: TenantRead, Scope: ScopeGlobal},
+		{Permission: TenantUpdate, Scope: ScopeGlobal},
+		{Permission: TenantSuspend, Scope: ScopeGlobal},
+		{Permission: TenantDelete, Scope: ScopeGlobal},
+		{Permission: TenantArchive, Scope: ScopeGlobal},
+		{Permission: TenantChangePlan, Scope: ScopeGlobal},
+		{Permission: TenantManageQuotas, Scope: ScopeGlobal},
+		{Permission: TenantManageFeatures, Scope: ScopeGlobal},
+		{Permission: TenantManageDomains, Scope: ScopeGlobal},
+		{Permission: TenantManageMembers, Scope: ScopeGlobal},
+		{Permission: TenantReinstate, Scope: ScopeGlobal},
+		{Permission: ServiceAccountManage, Scope: ScopeGlobal},
+		{Permission: AuditRead, Scope: ScopeGlobal},
+		{Permission: PlanManage, Scope: ScopeGlobal},
+		{Permission: FeatureManage, Scope: ScopeGlobal},
+		{Permission: EntitlementCheck, Scope: ScopeGlobal},
+		{Permission: EntitlementConsume, Scope: ScopeGlobal},
+		{Permission: FeatureEvaluate, Scope: ScopeGlobal},
+	},
+	RoleOrganizationAdmin: {
+		{Permission: TenantRead, Scope: ScopeSubtree},
+		{Permission: TenantUpdate, Scope: ScopeSubtree},
+		{Permission: TenantSuspend, Scope: ScopeSubtree},
+		{Permission: TenantDelete, Scope: ScopeSubtree},
+		{Permission: TenantArchive, Scope: ScopeSubtree},
+		{Permission: TenantChangePlan, Scope: ScopeSubtree},
+		{Permission: TenantManageQuotas, Scope: ScopeSubtree},
+		{Permission: TenantManageFeatures, Scope: ScopeSubtree},
+		{Permission: TenantManageDomains, Scope: ScopeSubtree},
+		{Permission: TenantManageMembers, Scope: ScopeSubtree},
+	},
+	RoleComplianceOfficer: {
+		{Permission: TenantList, Scope: ScopeGlobal},
+		{Permission: TenantRead, Scope: ScopeGlobal},
+		{Permission: TenantSuspend, Scope: ScopeGlobal},
+		{Permission: TenantLiftLegalHold, Scope: ScopeGlobal},
+		{Permission: AuditRead, Scope: ScopeGlobal},
+	},
+	RoleEntitlementService: {
+		{Permission: EntitlementCheck, Scope: ScopeGlobal},
+		{Permission: EntitlementConsume, Scope: ScopeGlobal},
+	},
+	RoleServiceReader: {
+		{Permission: TenantRead, Scope: ScopeGlobal},
+		{Permission: EntitlementCheck, Scope: ScopeGlobal},
+		{Permission: FeatureEvaluate, Scope: ScopeGlobal},
+	},
+	RoleTenantAdmin: {
+		{Permission: TenantRead, Scope: ScopeTenant},
+		{Permission: TenantUpdate, Scope: ScopeTenant},
+		{Permission: TenantManageDomains, Scope: ScopeTenant},
+		{Permission: TenantManageMembers, Scope: ScopeTenant},
+	},
+	RoleTenantAdminLocal: {
+		{Permission: TenantRead, Scope: ScopeTenant},
+		{Permission: TenantUpdate, Scope: ScopeTenant},
+		{Permission: TenantManageDomains, Scope: ScopeTenant},
+		{Permission: TenantManageMembers, Scope: ScopeTenant},
+	},
+}
+
+// Principal is the authenticated caller as seen by the authorizer
+type Principal struct {
+	Roles []string
+	// TenantID is the caller's home tenant, used for ScopeTenant grants
+	TenantID string
+	// Grants are direct grants in addition to those conferred by roles
+	Grants []Grant
+}
+
+// Hierarchy answers whether a tenant is below another, for ScopeSubtree grants
+type Hierarchy interface {
+	// IsAncestor reports whether ancestorID is a strict ancestor of tenantID
+	IsAncestor(ctx context.Context, ancestorID, tenantID string) (bool, error)
+}
+
+// Authorizer evaluates permissions against a policy
+type Authorizer struct {
+	policy    Policy
+	hierarchy Hierarchy
+}
+
+// NewAuthorizer creates a new authorizer
+func NewAuthorizer(policy Policy) *Authorizer {
+	return &Authorizer{policy: policy}
+}
+
+// WithHierarchy lets ScopeSubtree grants reach the descendants of the
+// principal's tenant. Without it they only cover the tenant itself.
+func (a *Authorizer) WithHierarchy(h Hierarchy) *Authorizer {
+	a.hierarchy = h
+	return a
+}
+
+// Authorize checks whether the principal holds the permission for the
+// target tenant. An empty target only matches global grants.
+func (a *Authorizer) Authorize(p Principal, perm Permission, targetTenantID string) error {
+	return a.AuthorizeContext(context.Background(), p, perm, targetTenantID)
+}
+
+// AuthorizeContext is Authorize with a context for hierarchy lookups
+func (a *Authorizer) AuthorizeContext(ctx context.Context, p Principal, perm Permission, targetTenantID string) error {
+	if a.CanContext(ctx, p, perm, targetTenantID) {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// Can reports whether the principal holds the permission for the target tenant
+func (a *Authorizer) Can(p Principal, perm Permission, targetTenantID string) bool {
+	return a.CanContext(context.Background(), p, perm, targetTenantID)
+}
+
+// CanContext is Can with a context for hierarchy lookups. A failed lookup
+// denies.
+func (a *Authorizer) CanContext(ctx context.Context, p Principal, perm Permission, targetTenantID string) bool {
+	subtree := false
+
+	for _, grant := range p.Grants {
+		if grant.matches(perm, p.TenantID, targetTenantID) {
+			return true
+		}
+		subtree = subtree || grant.reachesDescendants(perm)
+	}
+
+	for _, role := range p.Roles {
+		for _, grant := range a.policy[role] {
+			if grant.matches(perm, p.TenantID, targetTenantID) {
+				return true
+			}
+			subtree = subtree || grant.reachesDescendants(perm)
+		}
+	}
+
+	if !subtree || a.hierarchy == nil || p.TenantID == "" || targetTenantID == "" {
+		return false
+	}
+	below, err := a.hierarchy.IsAncestor(ctx, p.TenantID, targetTenantID)
+	return err == nil && below
+}
+
+// matches reports whether the grant covers the permission on the target,
+// without looking at the hierarchy
+func (g Grant) matches(perm Permission, principalTenantID, targetTenantID string) bool {
+	if g.Permission != perm {
+		return false
+	}
+
+	switch g.Scope {
+	case ScopeGlobal:
+		return true
+	case ScopeTenant, ScopeSubtree:
+		return principalTenantID != "" && targetTenantID != "" &&
+			strings.EqualFold(principalTenantID, targetTenantID)
+	default:
+		return false
+	}
+}
+
+// reachesDescendants reports whether the grant covers the permission on
+// the descendants of the principal's tenant
+func (g Grant) reachesDescendants(perm Permission) bool {
+	return g.Permission == perm && g.Scope == ScopeSubtree
+}
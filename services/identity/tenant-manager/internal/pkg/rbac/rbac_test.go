@@ -0,0 +1,95 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer_DefaultPolicy(t *testing.T) {
+	const (
+		ownTenant   = "550e8400-e29b-41d4-a716-446655440000"
+		otherTenant = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	)
+
+	authorizer := NewAuthorizer(DefaultPolicy)
+	platformAdmin := Principal{Roles: []string{RolePlatformAdmin}}
+	tenantAdmin := Principal{Roles: []string{RoleTenantAdmin}, TenantID: ownTenant}
+	user := Principal{Roles: []string{"cotai_user"}, TenantID: ownTenant}
+	compliance := Principal{Roles: []string{RoleComplianceOfficer}}
+	entitlementService := Principal{Roles: []string{RoleEntitlementService}}
+
+	tests := []struct {
+		name      string
+		principal Principal
+		perm      Permission
+		target    string
+		want      bool
+	}{
+		{"platform admin lists tenants", platformAdmin, TenantList, "", true},
+		{"platform admin deletes any tenant", platformAdmin, TenantDelete, otherTenant, true},
+		{"platform admin lifts abuse suspensions", platformAdmin, TenantReinstate, otherTenant, true},
+		{"platform admin lifts legal holds", platformAdmin, TenantLiftLegalHold, otherTenant, false},
+		{"compliance officer lifts legal holds", compliance, TenantLiftLegalHold, otherTenant, true},
+		{"compliance officer lifts abuse suspensions", compliance, TenantReinstate, otherTenant, false},
+		{"entitlement service consumes any entitlement", entitlementService, EntitlementConsume, otherTenant, true},
+		{"entitlement service reads tenants", entitlementService, TenantRead, otherTenant, false},
+		{"tenant admin reads own tenant", tenantAdmin, TenantRead, ownTenant, true},
+		{"tenant admin updates own tenant", tenantAdmin, TenantUpdate, ownTenant, true},
+		{"tenant admin reads other tenant", tenantAdmin, TenantRead, otherTenant, false},
+		{"tenant admin manages own members", tenantAdmin, TenantManageMembers, ownTenant, true},
+		{"tenant admin manages other members", tenantAdmin, TenantManageMembers, otherTenant, false},
+		{"tenant admin suspends own tenant", tenantAdmin, TenantSuspend, ownTenant, false},
+		{"tenant admin lists tenants", tenantAdmin, TenantList, "", false},
+		{"tenant admin without tenant claim", Principal{Roles: []string{RoleTenantAdmin}}, TenantRead, ownTenant, false},
+		{"plain user reads own tenant", user, TenantRead, ownTenant, false},
+		{"direct tenant grant", Principal{TenantID: ownTenant, Grants: []Grant{{TenantSuspend, ScopeTenant}}}, TenantSuspend, ownTenant, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, authorizer.Can(tt.principal, tt.perm, tt.target))
+		})
+	}
+
+	assert.ErrorIs(t, authorizer.Authorize(user, TenantRead, ownTenant), ErrForbidden)
+}
+
+type fakeHierarchy map[string]string
+
+func (h fakeHierarchy) IsAncestor(_ context.Context, ancestorID, tenantID string) (bool, error) {
+	for parent, ok := h[tenantID]; ok; parent, ok = h[parent] {
+		if parent == ancestorID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestAuthorizer_SubtreeScope(t *testing.T) {
+	const (
+		holding = "550e8400-e29b-41d4-a716-446655440000"
+		company = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+		unit    = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+		other   = "6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+	)
+
+	hierarchy := fakeHierarchy{company: holding, unit: company}
+	orgAdmin := Principal{Roles: []string{RoleOrganizationAdmin}, TenantID: holding}
+	tenantAdmin := Principal{Roles: []string{RoleTenantAdmin}, TenantID: holding}
+
+	authorizer := NewAuthorizer(DefaultPolicy).WithHierarchy(hierarchy)
+
+	assert.True(t, authorizer.Can(orgAdmin, TenantSuspend, holding))
+	assert.True(t, authorizer.Can(orgAdmin, TenantSuspend, company))
+	assert.True(t, authorizer.Can(orgAdmin, TenantChangePlan, unit))
+	assert.False(t, authorizer.Can(orgAdmin, TenantSuspend, other))
+	assert.False(t, authorizer.Can(orgAdmin, TenantList, ""))
+	assert.False(t, authorizer.Can(Principal{Roles: []string{RoleOrganizationAdmin}, TenantID: unit}, TenantRead, company))
+	assert.False(t, authorizer.Can(tenantAdmin, TenantRead, company), "tenant scope does not reach children")
+
+	withoutHierarchy := NewAuthorizer(DefaultPolicy)
+	assert.True(t, withoutHierarchy.Can(orgAdmin, TenantRead, holding))
+	assert.False(t, withoutHierarchy.Can(orgAdmin, TenantRead, company))
+}
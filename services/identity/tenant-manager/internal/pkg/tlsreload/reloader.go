@@ -0,0 +1,217 @@
+package tlsreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ClientAuthMode controls whether client certificates are requested
+type ClientAuthMode string
+
+const (
+	// ClientAuthNone disables client certificates (server-side TLS only)
+	ClientAuthNone ClientAuthMode = "none"
+	// ClientAuthRequest verifies client certificates when presented
+	ClientAuthRequest ClientAuthMode = "request"
+	// ClientAuthRequire requires and verifies a client certificate (mTLS)
+	ClientAuthRequire ClientAuthMode = "require"
+)
+
+// tlsClientAuth maps the mode to the crypto/tls setting
+func (m ClientAuthMode) tlsClientAuth() (tls.ClientAuthType, error) {
+	switch m {
+	case ClientAuthNone, "":
+		return tls.NoClientCert, nil
+	case ClientAuthRequest:
+		return tls.VerifyClientCertIfGiven, nil
+	case ClientAuthRequire:
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("invalid client auth mode %q", m)
+	}
+}
+
+// Config holds the reloader configuration
+type Config struct {
+	CertFile       string
+	KeyFile        string
+	ClientCAFile   string
+	ClientAuth     ClientAuthMode
+	ReloadInterval time.Duration
+}
+
+// Reloader serves a TLS configuration whose certificate and client CA pool
+// are reloaded from disk when the files change, without a restart
+type Reloader struct {
+	cfg        Config
+	clientAuth tls.ClientAuthType
+	logger     *zap.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	modTimes map[string]time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewReloader loads the certificate files and returns a reloader
+func NewReloader(cfg Config, logger *zap.Logger) (*Reloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("TLS certificate and key files are required")
+	}
+
+	clientAuth, err := cfg.ClientAuth.tlsClientAuth()
+	if err != nil {
+		return nil, err
+	}
+	if clientAuth != tls.NoClientCert && cfg.ClientCAFile == "" {
+		return nil, errors.New("client CA file is required to verify client certificates")
+	}
+
+	if cfg.ReloadInterval <= 0 {
+		cfg.ReloadInterval = time.Minute
+	}
+
+	r := &Reloader{
+		cfg:        cfg,
+		clientAuth: clientAuth,
+		logger:     logger,
+		modTimes:   make(map[string]time.Time),
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// TLSConfig returns a server TLS configuration backed by the reloader
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   r.clientAuth,
+				ClientCAs:    r.clientCA,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}
+}
+
+// Start watches the files and reloads them until Close is called
+func (r *Reloader) Start() {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.cfg.ReloadInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				if !r.changed() {
+					continue
+				}
+				if err := r.load(); err != nil {
+					// Keep serving the previous certificate
+					r.logger.Error("Failed to reload TLS certificates", zap.Error(err))
+					continue
+				}
+				r.logger.Info("TLS certificates reloaded",
+					zap.String("cert_file", r.cfg.CertFile),
+				)
+			}
+		}
+	}()
+}
+
+// Close stops watching the files
+func (r *Reloader) Close() {
+	if r.stop == nil {
+		return
+	}
+	close(r.stop)
+	<-r.done
+	r.stop = nil
+}
+
+// load reads the certificate, key and client CA from disk
+func (r *Reloader) load() error {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", r.cfg.ClientCAFile)
+		}
+	}
+
+	modTimes := make(map[string]time.Time)
+	for _, path := range r.files() {
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime()
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCA = pool
+	r.modTimes = modTimes
+	r.mu.Unlock()
+
+	return nil
+}
+
+// changed reports whether any watched file was modified since the last load
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+	return files
+}
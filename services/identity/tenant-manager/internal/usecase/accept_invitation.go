@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/pkg/invitetoken"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AcceptInvitationCommand represents the input for accepting an invitation
+type AcceptInvitationCommand struct {
+	Token string
+	// UserID and Email identify the authenticated Keycloak user accepting
+	UserID uuid.UUID
+	Email  string
+	// EmailVerified is set when Keycloak verified the user's email
+	EmailVerified bool
+}
+
+// AcceptInvitationResult carries the accepted invitation and the membership
+// it created
+type AcceptInvitationResult struct {
+	Invitation *domain.Invitation
+	Member     *domain.Member
+}
+
+// AcceptInvitationUseCase makes the invitee a member of the tenant through
+// the add member use case
+type AcceptInvitationUseCase struct {
+	invitations domain.InvitationRepository
+	addMemberUC *AddMemberUseCase
+	tx          Transactor
+	audit       domain.AuditRepository
+	signer      *invitetoken.Signer
+	logger      *zap.Logger
+}
+
+// NewAcceptInvitationUseCase creates a new AcceptInvitationUseCase
+func NewAcceptInvitationUseCase(
+	invitations domain.InvitationRepository,
+	addMemberUC *AddMemberUseCase,
+	tx Transactor,
+	audit domain.AuditRepository,
+	signer *invitetoken.Signer,
+	logger *zap.Logger,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		invitations: invitations,
+		addMemberUC: addMemberUC,
+		tx:          tx,
+		audit:       audit,
+		signer:      signer,
+		logger:      logger,
+	}
+}
+
+// Execute executes the accept invitation use case. The token must be the
+// last one sent for the invitation, and the user's verified email the
+// invitee's: anyone can register an unverified address in Keycloak. The
+// membership is added within the tenant's max users quota as it stands now,
+// in the same transaction as the acceptance, so a refused membership leaves
+// the invitation pending.
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, cmd AcceptInvitationCommand) (*AcceptInvitationResult, error) {
+	invitationID, err := uc.signer.Verify(cmd.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := uc.invitations.GetByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvitationNotFound) {
+			return nil, domain.ErrInvalidInvitationToken
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	// A token replaced by a resend no longer works
+	if !invitetoken.Matches(cmd.Token, inv.TokenHash) {
+		return nil, domain.ErrInvalidInvitationToken
+	}
+	if !inv.MatchesEmail(cmd.Email) {
+		return nil, domain.ErrInvitationEmailMismatch
+	}
+	if !cmd.EmailVerified {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	before := inv.Snapshot()
+	if err := inv.Accept(cmd.UserID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	tenantID := inv.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantInvitationAccepted, &tenantID, before, inv.Snapshot(),
+	))
+
+	var member *domain.Member
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.invitations.Update(ctx, inv, inv.TokenHash); err != nil {
+			return err
+		}
+		if err := uc.audit.Record(ctx, event); err != nil {
+			return err
+		}
+
+		// The member added event is published once the transaction commits
+		var err error
+		member, err = uc.addMemberUC.Execute(ctx, AddMemberCommand{
+			TenantID: inv.TenantID,
+			UserID:   cmd.UserID,
+			Role:     inv.Role,
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvitationNotPending) ||
+			errors.Is(err, domain.ErrTenantNotFound) ||
+			errors.Is(err, domain.ErrTenantDeleted) ||
+			errors.Is(err, domain.ErrMemberAlreadyExists) ||
+			errors.Is(err, domain.ErrMemberLimitReached) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to accept invitation",
+			zap.String("tenant_id", inv.TenantID.String()),
+			zap.String("invitation_id", inv.ID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	uc.logger.Info("Invitation accepted",
+		zap.String("tenant_id", inv.TenantID.String()),
+		zap.String("invitation_id", inv.ID.String()),
+		zap.String("user_id", cmd.UserID.String()),
+	)
+
+	return &AcceptInvitationResult{Invitation: inv, Member: member}, nil
+}
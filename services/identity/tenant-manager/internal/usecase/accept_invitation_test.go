@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/invitetoken"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// acceptFixture is an accept invitation use case with a pending invitation
+type acceptFixture struct {
+	uc          *AcceptInvitationUseCase
+	tenant      *domain.Tenant
+	invitation  *domain.Invitation
+	token       string
+	members     *fakeMemberRepo
+	invitations *fakeInvitationRepo
+}
+
+func newAcceptFixture(t *testing.T, plan domain.PlanTier) *acceptFixture {
+	t.Helper()
+
+	key, err := invitetoken.GenerateKey()
+	require.NoError(t, err)
+	signer, err := invitetoken.NewSigner(key)
+	require.NoError(t, err)
+
+	tenant := newActiveTenant(plan)
+	inv, err := domain.NewInvitation(tenant.TenantID, "Ana@Example.com", domain.MemberUser, 24*time.Hour, time.Now())
+	require.NoError(t, err)
+	token, hash, err := signer.Sign(inv.ID, inv.ExpiresAt)
+	require.NoError(t, err)
+	inv.TokenHash = hash
+
+	tenants := newFakeTenantRepo(tenant)
+	memberRepo := newFakeMemberRepo()
+	invitations := newFakeInvitationRepo(inv)
+	tx := &fakeTx{stores: []fakeStore{tenants, memberRepo, invitations}}
+	audit := &fakeAuditRepo{}
+
+	addMemberUC := NewAddMemberUseCase(tenants, memberRepo, tx, audit, &fakePublisher{}, zap.NewNop())
+	return &acceptFixture{
+		uc:          NewAcceptInvitationUseCase(invitations, addMemberUC, tx, audit, signer, zap.NewNop()),
+		tenant:      tenant,
+		invitation:  inv,
+		token:       token,
+		members:     memberRepo,
+		invitations: invitations,
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	f := newAcceptFixture(t, domain.PlanProfessional)
+	userID := uuid.New()
+
+	result, err := f.uc.Execute(context.Background(), AcceptInvitationCommand{
+		Token:         f.token,
+		UserID:        userID,
+		Email:         "ana@example.com",
+		EmailVerified: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvitationAccepted, result.Invitation.Status)
+	assert.Equal(t, domain.MemberUser, result.Member.Role)
+	assert.True(t, result.Member.IsPrimary)
+
+	stored, err := f.invitations.GetByID(context.Background(), f.invitation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvitationAccepted, stored.Status)
+
+	// A token is accepted once
+	_, err = f.uc.Execute(context.Background(), AcceptInvitationCommand{
+		Token:         f.token,
+		UserID:        userID,
+		Email:         "ana@example.com",
+		EmailVerified: true,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvitationNotPending)
+}
+
+func TestAcceptInvitation_Refusals(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     func(f *acceptFixture) AcceptInvitationCommand
+		wantErr error
+	}{
+		{
+			name: "unverified email",
+			cmd: func(f *acceptFixture) AcceptInvitationCommand {
+				return AcceptInvitationCommand{Token: f.token, UserID: uuid.New(), Email: "ana@example.com"}
+			},
+			wantErr: domain.ErrEmailNotVerified,
+		},
+		{
+			name: "another email",
+			cmd: func(f *acceptFixture) AcceptInvitationCommand {
+				return AcceptInvitationCommand{Token: f.token, UserID: uuid.New(), Email: "eve@example.com", EmailVerified: true}
+			},
+			wantErr: domain.ErrInvitationEmailMismatch,
+		},
+		{
+			name: "tampered token",
+			cmd: func(f *acceptFixture) AcceptInvitationCommand {
+				return AcceptInvitationCommand{Token: f.token + "x", UserID: uuid.New(), Email: "ana@example.com", EmailVerified: true}
+			},
+			wantErr: domain.ErrInvalidInvitationToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAcceptFixture(t, domain.PlanProfessional)
+
+			_, err := f.uc.Execute(context.Background(), tt.cmd(f))
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			stored, err := f.invitations.GetByID(context.Background(), f.invitation.ID)
+			require.NoError(t, err)
+			assert.Equal(t, domain.InvitationPending, stored.Status)
+		})
+	}
+}
+
+func TestAcceptInvitation_RefusedMembershipLeavesInvitationPending(t *testing.T) {
+	// The free plan's five seats are taken
+	var members []*domain.Member
+	f := newAcceptFixture(t, domain.PlanFree)
+	for i := 0; i < testPlans[domain.PlanFree].MaxUsers; i++ {
+		m, err := domain.NewMember(f.tenant.TenantID, uuid.New(), domain.MemberUser, time.Now())
+		require.NoError(t, err)
+		members = append(members, m)
+	}
+	for _, m := range members {
+		require.NoError(t, f.members.Create(context.Background(), m))
+	}
+
+	_, err := f.uc.Execute(context.Background(), AcceptInvitationCommand{
+		Token:         f.token,
+		UserID:        uuid.New(),
+		Email:         "ana@example.com",
+		EmailVerified: true,
+	})
+	assert.ErrorIs(t, err, domain.ErrMemberLimitReached)
+
+	stored, err := f.invitations.GetByID(context.Background(), f.invitation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvitationPending, stored.Status)
+}
+
+// resentAfterRead is an invitation repository on which the invitation is
+// resent, under another token, right after each read
+type resentAfterRead struct {
+	*fakeInvitationRepo
+}
+
+func (r resentAfterRead) GetByID(ctx context.Context, id uuid.UUID) (*domain.Invitation, error) {
+	inv, err := r.fakeInvitationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resent := *inv
+	if err := resent.Resend(24*time.Hour, time.Now()); err != nil {
+		return nil, err
+	}
+	resent.TokenHash = "resent"
+	r.mu.Lock()
+	r.invitations[id] = resent
+	r.mu.Unlock()
+
+	return inv, nil
+}
+
+func TestAcceptInvitation_RacingResendIsRefused(t *testing.T) {
+	f := newAcceptFixture(t, domain.PlanProfessional)
+	f.uc.invitations = resentAfterRead{f.invitations}
+
+	_, err := f.uc.Execute(context.Background(), AcceptInvitationCommand{
+		Token:         f.token,
+		UserID:        uuid.New(),
+		Email:         "ana@example.com",
+		EmailVerified: true,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvitationNotPending)
+
+	// The resend's token stands, and no membership was added
+	stored, err := f.invitations.GetByID(context.Background(), f.invitation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.InvitationPending, stored.Status)
+	assert.Equal(t, "resent", stored.TokenHash)
+	count, err := f.members.CountActive(context.Background(), f.tenant.TenantID, "")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
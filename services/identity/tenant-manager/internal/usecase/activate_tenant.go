@@ -12,11 +12,18 @@ import (
 // ActivateTenantCommand represents the input for activating a tenant
 type ActivateTenantCommand struct {
 	TenantID uuid.UUID
+	// Authorities are the caller's rights to lift restricted suspensions
+	Authorities []domain.ReinstatementAuthority
+	// Ends, for a scheduled reactivation, is the time-boxed suspension it
+	// ends; the tenant is only activated while still under it
+	Ends *domain.ScheduledOperation
 }
 
 // ActivateTenantUseCase handles tenant activation/reactivation
 type ActivateTenantUseCase struct {
 	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
 	publisher EventPublisher
 	logger    *zap.Logger
 }
@@ -24,11 +31,15 @@ type ActivateTenantUseCase struct {
 // NewActivateTenantUseCase creates a new ActivateTenantUseCase
 func NewActivateTenantUseCase(
 	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
 	publisher EventPublisher,
 	logger *zap.Logger,
 ) *ActivateTenantUseCase {
 	return &ActivateTenantUseCase{
 		repo:      repo,
+		tx:        tx,
+		audit:     audit,
 		publisher: publisher,
 		logger:    logger,
 	}
@@ -50,8 +61,16 @@ func (uc *ActivateTenantUseCase) Execute(ctx context.Context, cmd ActivateTenant
 		return nil, fmt.Errorf("failed to get tenant: %w", err)
 	}
 
-	// Activate tenant
-	if err := tenant.Activate(); err != nil {
+	if cmd.Ends != nil {
+		if err := cmd.Ends.StillSuspends(tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	before := tenant.Snapshot()
+
+	// Activate tenant; a restricted suspension needs the authority its reason requires
+	if err := tenant.Reinstate(cmd.Authorities...); err != nil {
 		uc.logger.Error("Failed to activate tenant",
 			zap.String("tenant_id", cmd.TenantID.String()),
 			zap.Error(err),
@@ -60,7 +79,7 @@ func (uc *ActivateTenantUseCase) Execute(ctx context.Context, cmd ActivateTenant
 	}
 
 	// Update tenant
-	if err := uc.repo.Update(ctx, tenant); err != nil {
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantActivated, tenant, before); err != nil {
 		uc.logger.Error("Failed to update tenant",
 			zap.String("tenant_id", cmd.TenantID.String()),
 			zap.Error(err),
@@ -68,8 +87,8 @@ func (uc *ActivateTenantUseCase) Execute(ctx context.Context, cmd ActivateTenant
 		return nil, fmt.Errorf("failed to update tenant: %w", err)
 	}
 
-	// Publish event (async)
-	go func() {
+	// Publish event (async) once a scheduled operation's transaction commits
+	uc.tx.AfterCommit(ctx, func() {
 		publishCtx := context.Background()
 		if err := uc.publisher.PublishTenantActivated(publishCtx, tenant); err != nil {
 			uc.logger.Error("Failed to publish tenant.activated event",
@@ -77,7 +96,7 @@ func (uc *ActivateTenantUseCase) Execute(ctx context.Context, cmd ActivateTenant
 				zap.Error(err),
 			)
 		}
-	}()
+	})
 
 	uc.logger.Info("Tenant activated",
 		zap.String("tenant_id", cmd.TenantID.String()),
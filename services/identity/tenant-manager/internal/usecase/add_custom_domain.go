@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AddCustomDomainCommand represents the input for registering a custom domain
+type AddCustomDomainCommand struct {
+	TenantID uuid.UUID
+	Hostname string
+}
+
+// AddCustomDomainUseCase registers a hostname for a tenant. The domain stays
+// pending until its verification TXT record is found.
+type AddCustomDomainUseCase struct {
+	repo    domain.TenantRepository
+	domains domain.CustomDomainRepository
+	tx      Transactor
+	audit   domain.AuditRepository
+	logger  *zap.Logger
+}
+
+// NewAddCustomDomainUseCase creates a new AddCustomDomainUseCase
+func NewAddCustomDomainUseCase(
+	repo domain.TenantRepository,
+	domains domain.CustomDomainRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *AddCustomDomainUseCase {
+	return &AddCustomDomainUseCase{
+		repo:    repo,
+		domains: domains,
+		tx:      tx,
+		audit:   audit,
+		logger:  logger,
+	}
+}
+
+// Execute executes the add custom domain use case
+func (uc *AddCustomDomainUseCase) Execute(ctx context.Context, cmd AddCustomDomainCommand) (*domain.CustomDomain, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	d, err := domain.NewCustomDomain(cmd.TenantID, cmd.Hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	// A hostname verified by another tenant cannot be registered until it is
+	// revoked or fails; the same tenant registering twice is caught on insert
+	verified, err := uc.domains.GetVerified(ctx, d.Hostname)
+	if err != nil && !errors.Is(err, domain.ErrDomainNotFound) {
+		return nil, fmt.Errorf("failed to check hostname: %w", err)
+	}
+	if verified != nil && verified.TenantID != cmd.TenantID {
+		return nil, domain.ErrDomainAlreadyExists
+	}
+
+	tenantID := cmd.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantDomainAdded, &tenantID, nil, d.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.domains.Create(ctx, d); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrDomainAlreadyExists) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to add custom domain",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("hostname", d.Hostname),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to add custom domain: %w", err)
+	}
+
+	uc.logger.Info("Custom domain added",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("hostname", d.Hostname),
+	)
+
+	return d, nil
+}
+
+// List retrieves the custom domains of a tenant, revoked or not, newest first
+func (uc *AddCustomDomainUseCase) List(ctx context.Context, tenantID uuid.UUID) ([]*domain.CustomDomain, error) {
+	// Distinguish an unknown tenant from one without domains
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	domains, err := uc.domains.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom domains: %w", err)
+	}
+
+	return domains, nil
+}
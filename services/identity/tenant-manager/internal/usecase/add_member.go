@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AddMemberCommand represents the input for adding a user to a tenant
+type AddMemberCommand struct {
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+	Role     domain.MemberRole
+}
+
+// AddMemberUseCase adds a user to a tenant within its max users quota
+type AddMemberUseCase struct {
+	repo      domain.TenantRepository
+	members   domain.MemberRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewAddMemberUseCase creates a new AddMemberUseCase
+func NewAddMemberUseCase(
+	repo domain.TenantRepository,
+	members domain.MemberRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *AddMemberUseCase {
+	return &AddMemberUseCase{
+		repo:      repo,
+		members:   members,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the add member use case. A removed member is reactivated
+// with the new role. The membership becomes the user's primary one when the
+// user has no other.
+func (uc *AddMemberUseCase) Execute(ctx context.Context, cmd AddMemberCommand) (*domain.Member, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	now := time.Now()
+	member, err := domain.NewMember(cmd.TenantID, cmd.UserID, cmd.Role, now)
+	if err != nil {
+		return nil, err
+	}
+
+	a := actor.FromContext(ctx)
+	member.CreatedBy = a.UUID()
+	member.UpdatedBy = a.UUID()
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.members.Lock(ctx, cmd.TenantID); err != nil {
+			return err
+		}
+
+		// Plan and quota decreases check usage under the same lock, so the
+		// quota is read again once it is held
+		current, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+		if err != nil {
+			return err
+		}
+		tenant = current
+
+		var before map[string]interface{}
+		existing, err := uc.members.Get(ctx, cmd.TenantID, cmd.UserID)
+		switch {
+		case err == nil:
+			before = existing.Snapshot()
+			if err := existing.Rejoin(cmd.Role, now); err != nil {
+				return err
+			}
+			existing.UpdatedBy = a.UUID()
+			member = existing
+		case !errors.Is(err, domain.ErrMemberNotFound):
+			return err
+		}
+
+		active, err := uc.members.CountActive(ctx, cmd.TenantID, "")
+		if err != nil {
+			return err
+		}
+		if err := tenant.CheckMemberLimit(active, now); err != nil {
+			return err
+		}
+
+		if err := uc.members.LockUser(ctx, cmd.UserID); err != nil {
+			return err
+		}
+		memberships, err := uc.members.ListByUser(ctx, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		member.IsPrimary = len(memberships) == 0 || !memberships[0].IsPrimary
+
+		if before == nil {
+			err = uc.members.Create(ctx, member)
+		} else {
+			err = uc.members.Update(ctx, member)
+		}
+		if err != nil {
+			return err
+		}
+
+		tenantID := cmd.TenantID
+		return uc.audit.Record(ctx, a.Stamp(domain.NewAuditEvent(
+			domain.AuditTenantMemberAdded, &tenantID, before, member.Snapshot(),
+		)))
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrMemberAlreadyExists) ||
+			errors.Is(err, domain.ErrMemberLimitReached) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to add tenant member",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("user_id", cmd.UserID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to add tenant member: %w", err)
+	}
+
+	uc.logger.Info("Tenant member added",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("user_id", cmd.UserID.String()),
+		zap.String("role", string(member.Role)),
+	)
+
+	// Publish event (async) once an accepted invitation's transaction commits
+	uc.tx.AfterCommit(ctx, func() {
+		if err := uc.publisher.PublishTenantMemberAdded(context.Background(), tenant, member); err != nil {
+			uc.logger.Error("Failed to publish tenant.member.added event",
+				zap.String("tenant_id", cmd.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	})
+
+	return member, nil
+}
+
+// List retrieves the active members of a tenant, oldest first
+func (uc *AddMemberUseCase) List(ctx context.Context, tenantID uuid.UUID) ([]*domain.Member, error) {
+	// Distinguish an unknown tenant from one without members
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	members, err := uc.members.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant members: %w", err)
+	}
+
+	return members, nil
+}
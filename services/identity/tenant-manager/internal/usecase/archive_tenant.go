@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BlobStore interface for storing schema archives
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ArchiveTenantCommand represents the input for archiving a tenant
+type ArchiveTenantCommand struct {
+	TenantID uuid.UUID
+	Reason   string
+}
+
+// ArchiveTenantUseCase exports a tenant schema to the blob store and drops
+// the live schema
+type ArchiveTenantUseCase struct {
+	repo        domain.TenantRepository
+	archives    domain.ArchiveRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	provisioner SchemaProvisioner
+	blobs       BlobStore
+	publisher   EventPublisher
+	logger      *zap.Logger
+}
+
+// NewArchiveTenantUseCase creates a new ArchiveTenantUseCase
+func NewArchiveTenantUseCase(
+	repo domain.TenantRepository,
+	archives domain.ArchiveRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	provisioner SchemaProvisioner,
+	blobs BlobStore,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *ArchiveTenantUseCase {
+	return &ArchiveTenantUseCase{
+		repo:        repo,
+		archives:    archives,
+		tx:          tx,
+		audit:       audit,
+		provisioner: provisioner,
+		blobs:       blobs,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// Execute executes the archive tenant use case. Only a suspended tenant,
+// which accepts no writes, is archived, so the export holds all of its data.
+// The archive record and the status change are committed before the schema
+// is dropped, so a failed drop leaves an archived tenant whose data is still
+// recoverable from either copy.
+func (uc *ArchiveTenantUseCase) Execute(ctx context.Context, cmd ArchiveTenantCommand) (*domain.Tenant, *domain.TenantArchive, error) {
+	uc.logger.Warn("Archiving tenant",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("reason", cmd.Reason),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	before := tenant.Snapshot()
+
+	// Validate the transition before doing any work
+	if err := tenant.Archive(cmd.Reason); err != nil {
+		return nil, nil, fmt.Errorf("failed to archive tenant: %w", err)
+	}
+
+	// Export schema to the blob store
+	key := archiveKey(tenant.TenantID, time.Now())
+	checksum, size, err := uc.export(ctx, tenant.TenantID, key)
+	if err != nil {
+		uc.logger.Error("Failed to export tenant schema",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, nil, fmt.Errorf("failed to export schema: %w", err)
+	}
+
+	archive := domain.NewTenantArchive(tenant, key, checksum, size)
+	archive.CreatedBy = actor.FromContext(ctx).UUID()
+
+	// Record archive and status change together
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.archives.Create(ctx, archive); err != nil {
+			return fmt.Errorf("failed to record archive: %w", err)
+		}
+		return saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantArchived, tenant, before)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		if delErr := uc.blobs.Delete(context.Background(), key); delErr != nil {
+			uc.logger.Warn("Failed to remove orphaned archive",
+				zap.String("location", key),
+				zap.Error(delErr),
+			)
+		}
+		return nil, nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	// Drop the live schema
+	if err := uc.provisioner.DeProvisionTenant(ctx, tenant.TenantID); err != nil {
+		uc.logger.Error("Failed to drop archived tenant schema",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, nil, fmt.Errorf("failed to drop schema: %w", err)
+	}
+
+	// Publish event (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantArchived(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.archived event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	uc.logger.Info("Tenant archived",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("location", key),
+		zap.Int64("size_bytes", size),
+	)
+
+	return tenant, archive, nil
+}
+
+// export streams the schema export into the blob store and returns the
+// SHA-256 checksum and size of the stored archive
+func (uc *ArchiveTenantUseCase) export(ctx context.Context, tenantID uuid.UUID, key string) (string, int64, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(uc.provisioner.ExportSchema(ctx, tenantID, pw))
+	}()
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	err := uc.blobs.Put(ctx, key, io.TeeReader(pr, io.MultiWriter(hash, counter)))
+	pr.CloseWithError(err)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), counter.n, nil
+}
+
+// archiveKey returns the blob store key for a new archive of a tenant
+func archiveKey(tenantID uuid.UUID, at time.Time) string {
+	return fmt.Sprintf("tenants/%s/%s.tar.gz", tenantID, at.UTC().Format("20060102T150405Z"))
+}
+
+// countingWriter counts the bytes written to it
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+)
+
+// Transactor runs a unit of work in a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// AfterCommit runs fn in its own goroutine once the transaction ctx runs
+	// in commits, and never if it rolls back; outside a transaction fn
+	// starts at once. Use cases that may run within another's transaction
+	// publish their events through it.
+	AfterCommit(ctx context.Context, fn func())
+}
+
+// tenantAuditEvent builds the audit event for a tenant change made by the
+// actor in ctx. before is the tenant snapshot taken ahead of the change, or
+// nil for a newly created tenant.
+func tenantAuditEvent(ctx context.Context, action domain.AuditAction, tenant *domain.Tenant, before map[string]interface{}) *domain.AuditEvent {
+	tenantID := tenant.TenantID
+	event := domain.NewAuditEvent(action, &tenantID, before, tenant.Snapshot())
+	return actor.FromContext(ctx).Stamp(event)
+}
+
+// saveTenant persists a changed tenant together with its audit event, its
+// status and plan history and its quota overrides
+func saveTenant(
+	ctx context.Context,
+	tx Transactor,
+	repo domain.TenantRepository,
+	audit domain.AuditRepository,
+	action domain.AuditAction,
+	tenant *domain.Tenant,
+	before map[string]interface{},
+) error {
+	a := actor.FromContext(ctx)
+	a.StampTransitions(tenant)
+	a.StampPlanChanges(tenant)
+	a.StampQuotaOverrides(tenant)
+	tenant.UpdatedBy = a.UUID()
+
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := repo.Update(ctx, tenant); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tenantAuditEvent(ctx, action, tenant, before))
+	})
+}
+
+// saveTenantWithinUsage saves a tenant whose quotas went down like
+// saveTenant, after checking that its current usage still fits. The check
+// runs under the member lock in the same transaction as the save, so that no
+// member is added in between.
+func saveTenantWithinUsage(
+	ctx context.Context,
+	tx Transactor,
+	members domain.MemberRepository,
+	usage domain.UsageRepository,
+	repo domain.TenantRepository,
+	audit domain.AuditRepository,
+	action domain.AuditAction,
+	tenant *domain.Tenant,
+	before map[string]interface{},
+) error {
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := members.Lock(ctx, tenant.TenantID); err != nil {
+			return err
+		}
+
+		u, err := usage.GetUsage(ctx, tenant.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant usage: %w", err)
+		}
+		if err := tenant.CheckUsageFits(u); err != nil {
+			return err
+		}
+
+		return saveTenant(ctx, tx, repo, audit, action, tenant, before)
+	})
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CancelOperationCommand represents the input for canceling a scheduled
+// operation
+type CancelOperationCommand struct {
+	TenantID    uuid.UUID
+	OperationID uuid.UUID
+}
+
+// CancelOperationUseCase withdraws a pending scheduled operation
+type CancelOperationUseCase struct {
+	ops    domain.ScheduledOperationRepository
+	tx     Transactor
+	audit  domain.AuditRepository
+	logger *zap.Logger
+}
+
+// NewCancelOperationUseCase creates a new CancelOperationUseCase
+func NewCancelOperationUseCase(
+	ops domain.ScheduledOperationRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *CancelOperationUseCase {
+	return &CancelOperationUseCase{
+		ops:    ops,
+		tx:     tx,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// Execute executes the cancel operation use case
+func (uc *CancelOperationUseCase) Execute(ctx context.Context, cmd CancelOperationCommand) (*domain.ScheduledOperation, error) {
+	op, err := uc.ops.GetByID(ctx, cmd.OperationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled operation: %w", err)
+	}
+	// Another tenant's operation is reported as missing
+	if op.TenantID != cmd.TenantID {
+		return nil, domain.ErrOperationNotFound
+	}
+
+	before := op.Snapshot()
+	if err := op.Cancel(time.Now()); err != nil {
+		return nil, err
+	}
+
+	tenantID := cmd.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantOperationCanceled, &tenantID, before, op.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.ops.Update(ctx, op); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		// The operation ran or was canceled since it was read
+		if errors.Is(err, domain.ErrOperationNotPending) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to cancel scheduled operation",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("operation_id", cmd.OperationID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to cancel scheduled operation: %w", err)
+	}
+
+	uc.logger.Info("Scheduled operation canceled",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("operation_id", cmd.OperationID.String()),
+		zap.String("action", string(op.Action)),
+	)
+
+	return op, nil
+}
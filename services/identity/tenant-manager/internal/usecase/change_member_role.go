@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ChangeMemberRoleCommand represents the input for changing the role of a
+// tenant member
+type ChangeMemberRoleCommand struct {
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+	Role     domain.MemberRole
+}
+
+// ChangeMemberRoleUseCase gives a tenant member another role, keeping the
+// tenant's last admin
+type ChangeMemberRoleUseCase struct {
+	repo      domain.TenantRepository
+	members   domain.MemberRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewChangeMemberRoleUseCase creates a new ChangeMemberRoleUseCase
+func NewChangeMemberRoleUseCase(
+	repo domain.TenantRepository,
+	members domain.MemberRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *ChangeMemberRoleUseCase {
+	return &ChangeMemberRoleUseCase{
+		repo:      repo,
+		members:   members,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the change member role use case
+func (uc *ChangeMemberRoleUseCase) Execute(ctx context.Context, cmd ChangeMemberRoleCommand) (*domain.Member, error) {
+	if !cmd.Role.IsValid() {
+		return nil, domain.ErrInvalidMemberRole
+	}
+
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	a := actor.FromContext(ctx)
+
+	var member *domain.Member
+	var previousRole domain.MemberRole
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.members.Lock(ctx, cmd.TenantID); err != nil {
+			return err
+		}
+		if err := uc.members.LockUser(ctx, cmd.UserID); err != nil {
+			return err
+		}
+
+		m, err := uc.members.Get(ctx, cmd.TenantID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Role != domain.MemberAdmin {
+			admins, err := uc.members.CountActive(ctx, cmd.TenantID, domain.MemberAdmin)
+			if err != nil {
+				return err
+			}
+			if m.IsLastAdmin(admins) {
+				return domain.ErrLastTenantAdmin
+			}
+		}
+
+		before := m.Snapshot()
+		previousRole = m.Role
+		if err := m.ChangeRole(cmd.Role, time.Now()); err != nil {
+			return err
+		}
+		m.UpdatedBy = a.UUID()
+
+		if err := uc.members.Update(ctx, m); err != nil {
+			return err
+		}
+
+		member = m
+		tenantID := cmd.TenantID
+		return uc.audit.Record(ctx, a.Stamp(domain.NewAuditEvent(
+			domain.AuditTenantMemberRoleChanged, &tenantID, before, m.Snapshot(),
+		)))
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrMemberNotFound) ||
+			errors.Is(err, domain.ErrMemberRoleUnchanged) ||
+			errors.Is(err, domain.ErrLastTenantAdmin) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to change tenant member role",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("user_id", cmd.UserID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to change tenant member role: %w", err)
+	}
+
+	uc.logger.Info("Tenant member role changed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("user_id", cmd.UserID.String()),
+		zap.String("from", string(previousRole)),
+		zap.String("to", string(member.Role)),
+	)
+
+	// Publish event (async)
+	go func() {
+		if err := uc.publisher.PublishTenantMemberRoleChanged(context.Background(), tenant, member, previousRole); err != nil {
+			uc.logger.Error("Failed to publish tenant.member.role_changed event",
+				zap.String("tenant_id", cmd.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return member, nil
+}
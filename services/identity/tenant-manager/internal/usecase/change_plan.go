@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ChangePlanCommand represents the input for changing a tenant's plan
+type ChangePlanCommand struct {
+	TenantID uuid.UUID
+	Plan     domain.PlanTier
+}
+
+// ChangePlanUseCase handles moving a tenant to another subscription plan
+type ChangePlanUseCase struct {
+	repo      domain.TenantRepository
+	plans     *PlanCatalog
+	flags     domain.FeatureFlagRepository
+	members   domain.MemberRepository
+	usage     domain.UsageRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewChangePlanUseCase creates a new ChangePlanUseCase
+func NewChangePlanUseCase(
+	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	flags domain.FeatureFlagRepository,
+	members domain.MemberRepository,
+	usage domain.UsageRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *ChangePlanUseCase {
+	return &ChangePlanUseCase{
+		repo:      repo,
+		plans:     plans,
+		flags:     flags,
+		members:   members,
+		usage:     usage,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the change plan use case. A downgrade is refused when the
+// tenant's current usage, its active members and latest storage snapshot,
+// exceeds its effective quotas on the new plan. When
+// the plan's features change the tenant's effective features, a
+// tenant.features.changed event is published as well.
+func (uc *ChangePlanUseCase) Execute(ctx context.Context, cmd ChangePlanCommand) (*domain.Tenant, error) {
+	uc.logger.Info("Changing tenant plan",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("plan", string(cmd.Plan)),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	// Get plan
+	plan, err := uc.plans.Get(ctx, cmd.Plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	registered, err := uc.flags.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	before := tenant.Snapshot()
+	featuresBefore := tenant.EvaluateFeatures(registered)
+	onTrial := tenant.HasActiveTrial()
+
+	// Change plan; a running trial ends as converted
+	if err := tenant.ChangePlan(plan); err != nil {
+		return nil, fmt.Errorf("failed to change plan: %w", err)
+	}
+	changes := tenant.PendingPlanChanges()
+	change := changes[len(changes)-1]
+
+	features := tenant.EvaluateFeatures(registered)
+
+	// Update tenant, validating a downgrade against current usage
+	if change.IsDowngrade() {
+		err = saveTenantWithinUsage(ctx, uc.tx, uc.members, uc.usage, uc.repo, uc.audit, domain.AuditTenantPlanChanged, tenant, before)
+	} else {
+		err = saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantPlanChanged, tenant, before)
+	}
+	if errors.Is(err, domain.ErrPlanLimitExceeded) {
+		return nil, err
+	}
+	if err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	// Publish events (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantPlanChanged(publishCtx, tenant, change); err != nil {
+			uc.logger.Error("Failed to publish tenant.plan.changed event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+		if !onTrial {
+			return
+		}
+		if err := uc.publisher.PublishTenantTrialEnded(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.trial.converted event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+	if !domain.SameFeatures(featuresBefore, features) {
+		publishFeaturesChanged(uc.publisher, uc.logger, tenant, features)
+	}
+
+	uc.logger.Info("Tenant plan changed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("from_plan", string(change.FromPlan)),
+		zap.String("to_plan", string(change.ToPlan)),
+	)
+
+	return tenant, nil
+}
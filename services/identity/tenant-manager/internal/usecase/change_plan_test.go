@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestChangePlan_PublishesChangedFeatures(t *testing.T) {
+	sso, err := domain.NewFeatureFlag("sso", domain.FeatureBoolean, false, "Single sign-on", 0)
+	require.NoError(t, err)
+	flags := &fakeFlagRepo{flags: []*domain.FeatureFlag{sso}}
+
+	enterprise := *testPlans[domain.PlanEnterprise]
+	enterprise.Features = map[string]interface{}{"sso": true}
+
+	tests := []struct {
+		name   string
+		plan   domain.PlanTier
+		events []string
+	}{
+		{"plan granting a feature", domain.PlanEnterprise, []string{"tenant.features.changed", "tenant.plan.changed"}},
+		{"plan with the same features", domain.PlanBasic, []string{"tenant.plan.changed"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := newActiveTenant(domain.PlanProfessional)
+			tenants := newFakeTenantRepo(tenant)
+			publisher := &fakePublisher{}
+			uc := NewChangePlanUseCase(
+				tenants, newTestCatalog(&enterprise), flags, newFakeMemberRepo(), &fakeUsageRepo{},
+				&fakeTx{stores: []fakeStore{tenants}}, &fakeAuditRepo{}, publisher, zap.NewNop(),
+			)
+
+			_, err := uc.Execute(context.Background(), ChangePlanCommand{TenantID: tenant.TenantID, Plan: tt.plan})
+			require.NoError(t, err)
+
+			assert.Eventually(t, func() bool { return len(publisher.published()) == len(tt.events) }, time.Second, time.Millisecond)
+			assert.ElementsMatch(t, tt.events, publisher.published())
+		})
+	}
+}
+
+func TestChangePlan_DowngradeChecksUsageUnderTheMemberLock(t *testing.T) {
+	tests := []struct {
+		name     string
+		usage    domain.TenantUsage
+		resource string
+	}{
+		{"fits", domain.TenantUsage{ActiveUsers: 20, StorageBytes: 50 * domain.BytesPerGB}, ""},
+		{"too many members", domain.TenantUsage{ActiveUsers: 21}, "active users"},
+		{"too much storage", domain.TenantUsage{ActiveUsers: 1, StorageBytes: 60 * domain.BytesPerGB}, "storage GB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := newActiveTenant(domain.PlanProfessional)
+			tenants := newFakeTenantRepo(tenant)
+			uc := NewChangePlanUseCase(
+				tenants, newTestCatalog(), &fakeFlagRepo{}, newFakeMemberRepo(), &fakeUsageRepo{usage: tt.usage},
+				&fakeTx{stores: []fakeStore{tenants}}, &fakeAuditRepo{}, &fakePublisher{}, zap.NewNop(),
+			)
+
+			_, err := uc.Execute(context.Background(), ChangePlanCommand{TenantID: tenant.TenantID, Plan: domain.PlanBasic})
+			if tt.resource == "" {
+				require.NoError(t, err)
+				assert.Equal(t, domain.PlanBasic, tenants.get(tenant.TenantID).PlanTier)
+				return
+			}
+
+			var limitErr *domain.PlanLimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, tt.resource, limitErr.Resource)
+			assert.Equal(t, domain.PlanProfessional, tenants.get(tenant.TenantID).PlanTier)
+		})
+	}
+}
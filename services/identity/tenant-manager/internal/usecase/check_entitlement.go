@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CheckEntitlementCommand represents the input for checking an entitlement
+type CheckEntitlementCommand struct {
+	TenantID uuid.UUID
+	Name     string
+	Amount   int64
+}
+
+// EntitlementCheck is the outcome of an entitlement check
+type EntitlementCheck struct {
+	Usage *domain.EntitlementUsage
+	// Denied is why consuming the amount would fail, or nil if it is allowed
+	Denied error
+}
+
+// Allowed reports whether the amount can be consumed
+func (c *EntitlementCheck) Allowed() bool {
+	return c.Denied == nil
+}
+
+// CheckEntitlementUseCase reports whether a tenant may consume an
+// entitlement, without consuming it
+type CheckEntitlementUseCase struct {
+	repo         domain.TenantRepository
+	entitlements domain.EntitlementRepository
+	resolver     entitlementResolver
+	logger       *zap.Logger
+}
+
+// NewCheckEntitlementUseCase creates a new CheckEntitlementUseCase
+func NewCheckEntitlementUseCase(
+	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	entitlements domain.EntitlementRepository,
+	members domain.MemberRepository,
+	storage domain.StorageRepository,
+	logger *zap.Logger,
+) *CheckEntitlementUseCase {
+	return &CheckEntitlementUseCase{
+		repo:         repo,
+		entitlements: entitlements,
+		resolver:     entitlementResolver{plans: plans, entitlements: entitlements, members: members, storage: storage},
+		logger:       logger,
+	}
+}
+
+// Execute executes the check entitlement use case
+func (uc *CheckEntitlementUseCase) Execute(ctx context.Context, cmd CheckEntitlementCommand) (*EntitlementCheck, error) {
+	if cmd.Amount <= 0 {
+		return nil, domain.ErrInvalidEntitlementAmount
+	}
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	limit, err := uc.resolver.resolve(ctx, tenant, cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := uc.resolver.usage(ctx, tenant, limit, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntitlementCheck{
+		Usage:  usage,
+		Denied: tenant.CheckEntitlement(usage, cmd.Amount),
+	}, nil
+}
+
+// List reports the usage of every entitlement of a tenant
+func (uc *CheckEntitlementUseCase) List(ctx context.Context, tenantID uuid.UUID) ([]*domain.EntitlementUsage, error) {
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	limits, err := uc.resolver.resolveAll(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	usages := make([]*domain.EntitlementUsage, 0, len(limits))
+	for _, limit := range limits {
+		usage, err := uc.resolver.usage(ctx, tenant, limit, now)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCheckEntitlement_MaxUsersCountsActiveMembers(t *testing.T) {
+	ctx := context.Background()
+	tenant := newActiveTenant(domain.PlanFree)
+	tenants := newFakeTenantRepo(tenant)
+	catalog := newTestCatalog(testPlans[domain.PlanFree])
+	entitlements := newFakeEntitlementRepo()
+
+	members := newFakeMemberRepo()
+	var removed *domain.Member
+	for i := 0; i < 3; i++ {
+		m, err := domain.NewMember(tenant.TenantID, uuid.New(), domain.MemberUser, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, members.Create(ctx, m))
+		removed = m
+	}
+	require.NoError(t, removed.Remove(time.Now()))
+	require.NoError(t, members.Update(ctx, removed))
+
+	check := NewCheckEntitlementUseCase(tenants, catalog, entitlements, members, &fakeStorageRepo{}, zap.NewNop())
+	result, err := check.Execute(ctx, CheckEntitlementCommand{TenantID: tenant.TenantID, Name: "max_users", Amount: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.Usage.Used)
+	assert.Equal(t, int64(testPlans[domain.PlanFree].MaxUsers), result.Usage.Limit)
+	assert.True(t, result.Allowed())
+
+	// Seats change only through members, never through the counter
+	consume := NewConsumeEntitlementUseCase(tenants, catalog, entitlements, members, &fakeStorageRepo{}, &fakeTx{}, &fakePublisher{}, zap.NewNop())
+	_, err = consume.Execute(ctx, ConsumeEntitlementCommand{TenantID: tenant.TenantID, Name: "max_users", Amount: 1})
+	assert.ErrorIs(t, err, domain.ErrEntitlementNotCounted)
+
+	release := NewReleaseEntitlementUseCase(tenants, catalog, entitlements, members, &fakeStorageRepo{}, &fakeTx{}, zap.NewNop())
+	_, err = release.Execute(ctx, ReleaseEntitlementCommand{TenantID: tenant.TenantID, Name: "max_users", Amount: 1})
+	assert.ErrorIs(t, err, domain.ErrEntitlementNotCounted)
+
+	assert.Empty(t, entitlements.usage)
+}
+
+func TestCheckEntitlement_MaxStorageMeasuresLatestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	tenant := newActiveTenant(domain.PlanFree)
+	tenants := newFakeTenantRepo(tenant)
+	catalog := newTestCatalog(testPlans[domain.PlanFree])
+	entitlements := newFakeEntitlementRepo()
+	members := newFakeMemberRepo()
+
+	// Without a snapshot nothing is used yet
+	storage := &fakeStorageRepo{}
+	check := NewCheckEntitlementUseCase(tenants, catalog, entitlements, members, storage, zap.NewNop())
+	result, err := check.Execute(ctx, CheckEntitlementCommand{TenantID: tenant.TenantID, Name: "max_storage_gb", Amount: 1})
+	require.NoError(t, err)
+	assert.Zero(t, result.Usage.Used)
+
+	// A schema just over 2 GB uses 3 of them
+	size := domain.SchemaSize{TableBytes: 2 * domain.BytesPerGB, IndexBytes: 1}
+	storage.latest = map[uuid.UUID]*domain.StorageSnapshot{
+		tenant.TenantID: domain.NewStorageSnapshot(tenant, size, time.Now()),
+	}
+	result, err = check.Execute(ctx, CheckEntitlementCommand{TenantID: tenant.TenantID, Name: "max_storage_gb", Amount: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Usage.Used)
+	assert.Equal(t, int64(testPlans[domain.PlanFree].MaxStorageGB), result.Usage.Limit)
+
+	// Storage changes only as the schema does, never through the counter
+	consume := NewConsumeEntitlementUseCase(tenants, catalog, entitlements, members, storage, &fakeTx{}, &fakePublisher{}, zap.NewNop())
+	_, err = consume.Execute(ctx, ConsumeEntitlementCommand{TenantID: tenant.TenantID, Name: "max_storage_gb", Amount: 1})
+	assert.ErrorIs(t, err, domain.ErrEntitlementNotCounted)
+
+	release := NewReleaseEntitlementUseCase(tenants, catalog, entitlements, members, storage, &fakeTx{}, zap.NewNop())
+	_, err = release.Execute(ctx, ReleaseEntitlementCommand{TenantID: tenant.TenantID, Name: "max_storage_gb", Amount: 1})
+	assert.ErrorIs(t, err, domain.ErrEntitlementNotCounted)
+
+	assert.Empty(t, entitlements.usage)
+}
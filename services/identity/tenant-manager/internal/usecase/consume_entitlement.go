@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ConsumeEntitlementCommand represents the input for consuming an entitlement
+type ConsumeEntitlementCommand struct {
+	TenantID uuid.UUID
+	Name     string
+	Amount   int64
+}
+
+// ConsumeEntitlementUseCase counts usage of an entitlement against its limit
+type ConsumeEntitlementUseCase struct {
+	repo         domain.TenantRepository
+	entitlements domain.EntitlementRepository
+	resolver     entitlementResolver
+	tx           Transactor
+	publisher    EventPublisher
+	logger       *zap.Logger
+}
+
+// NewConsumeEntitlementUseCase creates a new ConsumeEntitlementUseCase
+func NewConsumeEntitlementUseCase(
+	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	entitlements domain.EntitlementRepository,
+	members domain.MemberRepository,
+	storage domain.StorageRepository,
+	tx Transactor,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *ConsumeEntitlementUseCase {
+	return &ConsumeEntitlementUseCase{
+		repo:         repo,
+		entitlements: entitlements,
+		resolver:     entitlementResolver{plans: plans, entitlements: entitlements, members: members, storage: storage},
+		tx:           tx,
+		publisher:    publisher,
+		logger:       logger,
+	}
+}
+
+// Execute executes the consume entitlement use case. The check and the
+// increment run under a lock on the counter, so concurrent consumers never
+// exceed the limit together. Seats are taken by adding members, so max_users
+// is refused.
+func (uc *ConsumeEntitlementUseCase) Execute(ctx context.Context, cmd ConsumeEntitlementCommand) (*domain.EntitlementUsage, error) {
+	if cmd.Amount <= 0 {
+		return nil, domain.ErrInvalidEntitlementAmount
+	}
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	limit, err := uc.resolver.resolve(ctx, tenant, cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+	if limit.Measured() {
+		return nil, domain.ErrEntitlementNotCounted
+	}
+
+	var usage *domain.EntitlementUsage
+	var previous int64
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.entitlements.LockUsage(ctx, tenant.TenantID, limit.Name); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		usage, err = uc.resolver.usage(ctx, tenant, limit, now)
+		if err != nil {
+			return err
+		}
+		if err := tenant.CheckEntitlement(usage, cmd.Amount); err != nil {
+			return err
+		}
+
+		if err := uc.entitlements.AddUsage(ctx, tenant.TenantID, limit.Name, limit.Bucket(now), cmd.Amount); err != nil {
+			return err
+		}
+		previous = usage.Used
+		usage.Used += cmd.Amount
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume entitlement: %w", err)
+	}
+
+	for _, threshold := range usage.CrossedThresholds(previous) {
+		uc.publishThreshold(tenant, usage, threshold)
+	}
+
+	uc.logger.Debug("Entitlement consumed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("entitlement", limit.Name),
+		zap.Int64("amount", cmd.Amount),
+		zap.Int64("used", usage.Used),
+		zap.Int64("limit", usage.Limit),
+	)
+
+	return usage, nil
+}
+
+// publishThreshold publishes a tenant.entitlement.threshold_reached event (async)
+func (uc *ConsumeEntitlementUseCase) publishThreshold(tenant *domain.Tenant, usage *domain.EntitlementUsage, threshold int) {
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishEntitlementThresholdReached(publishCtx, tenant, usage, threshold); err != nil {
+			uc.logger.Error("Failed to publish tenant.entitlement.threshold_reached event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.String("entitlement", usage.Name),
+				zap.Int("threshold", threshold),
+				zap.Error(err),
+			)
+		}
+	}()
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ConvertTrialCommand represents the input for converting a trial into a
+// paid subscription
+type ConvertTrialCommand struct {
+	TenantID uuid.UUID
+	// Plan is the paid plan; empty keeps the plan on trial
+	Plan domain.PlanTier
+}
+
+// ConvertTrialUseCase ends a tenant's trial early because the customer paid
+type ConvertTrialUseCase struct {
+	repo      domain.TenantRepository
+	plans     *PlanCatalog
+	flags     domain.FeatureFlagRepository
+	members   domain.MemberRepository
+	usage     domain.UsageRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewConvertTrialUseCase creates a new ConvertTrialUseCase
+func NewConvertTrialUseCase(
+	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	flags domain.FeatureFlagRepository,
+	members domain.MemberRepository,
+	usage domain.UsageRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *ConvertTrialUseCase {
+	return &ConvertTrialUseCase{
+		repo:      repo,
+		plans:     plans,
+		flags:     flags,
+		members:   members,
+		usage:     usage,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the convert trial use case. Converting to another plan
+// changes the plan in the same step, with the usage check of a downgrade.
+func (uc *ConvertTrialUseCase) Execute(ctx context.Context, cmd ConvertTrialCommand) (*domain.Tenant, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	before := tenant.Snapshot()
+
+	if err := tenant.ConvertTrial(time.Now()); err != nil {
+		return nil, err
+	}
+
+	var change *domain.PlanChange
+	var features []*domain.FeatureValue
+	if cmd.Plan != "" && cmd.Plan != tenant.PlanTier {
+		plan, err := uc.plans.Get(ctx, cmd.Plan)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get plan: %w", err)
+		}
+
+		registered, err := uc.flags.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list feature flags: %w", err)
+		}
+		featuresBefore := tenant.EvaluateFeatures(registered)
+
+		if err := tenant.ChangePlan(plan); err != nil {
+			return nil, fmt.Errorf("failed to change plan: %w", err)
+		}
+		changes := tenant.PendingPlanChanges()
+		change = changes[len(changes)-1]
+		if after := tenant.EvaluateFeatures(registered); !domain.SameFeatures(featuresBefore, after) {
+			features = after
+		}
+	}
+
+	if change != nil && change.IsDowngrade() {
+		err = saveTenantWithinUsage(ctx, uc.tx, uc.members, uc.usage, uc.repo, uc.audit, domain.AuditTenantTrialConverted, tenant, before)
+	} else {
+		err = saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantTrialConverted, tenant, before)
+	}
+	if errors.Is(err, domain.ErrPlanLimitExceeded) {
+		return nil, err
+	}
+	if err != nil {
+		uc.logger.Error("Failed to convert tenant trial",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	uc.logger.Info("Tenant trial converted",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("plan", string(tenant.PlanTier)),
+	)
+
+	// Publish events (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantTrialEnded(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.trial.converted event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+		if change == nil {
+			return
+		}
+		if err := uc.publisher.PublishTenantPlanChanged(publishCtx, tenant, change); err != nil {
+			uc.logger.Error("Failed to publish tenant.plan.changed event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+	if features != nil {
+		publishFeaturesChanged(uc.publisher, uc.logger, tenant, features)
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// CreatePlanCommand represents the input for adding a plan to the catalog
+type CreatePlanCommand struct {
+	Tier         domain.PlanTier
+	DisplayName  string
+	Description  string
+	MaxUsers     int
+	MaxStorageGB int
+	Features     map[string]interface{}
+	SortOrder    int
+	Entitlements []EntitlementLimitCommand
+}
+
+// EntitlementLimitCommand represents a named usage limit in a command
+type EntitlementLimitCommand struct {
+	Name   string
+	Limit  int64
+	Window domain.EntitlementWindow
+	// Period is the length of a rolling window
+	Period time.Duration
+}
+
+// toDomain validates the limit and converts it to a domain entitlement limit
+func (c EntitlementLimitCommand) toDomain() (*domain.EntitlementLimit, error) {
+	return domain.NewEntitlementLimit(c.Name, c.Limit, c.Window, c.Period)
+}
+
+// CreatePlanUseCase handles adding plans to the catalog
+type CreatePlanUseCase struct {
+	repo    domain.PlanRepository
+	catalog *PlanCatalog
+	flags   domain.FeatureFlagRepository
+	tx      Transactor
+	logger  *zap.Logger
+}
+
+// NewCreatePlanUseCase creates a new CreatePlanUseCase
+func NewCreatePlanUseCase(repo domain.PlanRepository, catalog *PlanCatalog, flags domain.FeatureFlagRepository, tx Transactor, logger *zap.Logger) *CreatePlanUseCase {
+	return &CreatePlanUseCase{
+		repo:    repo,
+		catalog: catalog,
+		flags:   flags,
+		tx:      tx,
+		logger:  logger,
+	}
+}
+
+// Execute executes the create plan use case. Every feature of the plan must
+// be a registered feature flag.
+func (uc *CreatePlanUseCase) Execute(ctx context.Context, cmd CreatePlanCommand) (*domain.Plan, error) {
+	plan, err := domain.NewPlan(cmd.Tier, cmd.DisplayName, cmd.Description, cmd.MaxUsers, cmd.MaxStorageGB, cmd.Features, cmd.SortOrder)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plan: %w", err)
+	}
+
+	flags, err := uc.flags.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	if err := plan.CheckFeatures(flags); err != nil {
+		return nil, fmt.Errorf("invalid plan features: %w", err)
+	}
+
+	for _, e := range cmd.Entitlements {
+		limit, err := e.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("invalid plan entitlement %q: %w", e.Name, err)
+		}
+		if err := plan.AddEntitlement(limit); err != nil {
+			return nil, fmt.Errorf("invalid plan entitlement %q: %w", e.Name, err)
+		}
+	}
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return uc.repo.Create(ctx, plan)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to create plan",
+			zap.String("plan", string(cmd.Tier)),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to create plan: %w", err)
+	}
+	uc.catalog.Invalidate()
+
+	uc.logger.Info("Plan created",
+		zap.String("plan", string(plan.Tier)),
+		zap.Int("max_users", plan.MaxUsers),
+		zap.Int("max_storage_gb", plan.MaxStorageGB),
+		zap.Int("entitlements", len(plan.Entitlements)),
+	)
+
+	return plan, nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateServiceAccountCommand represents the input for creating a service account
+type CreateServiceAccountCommand struct {
+	Name        string
+	Description string
+	TenantID    *uuid.UUID
+	CreatedBy   *uuid.UUID
+}
+
+// CreateServiceAccountUseCase handles service account creation
+type CreateServiceAccountUseCase struct {
+	repo       domain.ServiceAccountRepository
+	tenantRepo domain.TenantRepository
+	logger     *zap.Logger
+}
+
+// NewCreateServiceAccountUseCase creates a new CreateServiceAccountUseCase
+func NewCreateServiceAccountUseCase(
+	repo domain.ServiceAccountRepository,
+	tenantRepo domain.TenantRepository,
+	logger *zap.Logger,
+) *CreateServiceAccountUseCase {
+	return &CreateServiceAccountUseCase{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		logger:     logger,
+	}
+}
+
+// Execute executes the create service account use case
+func (uc *CreateServiceAccountUseCase) Execute(ctx context.Context, cmd CreateServiceAccountCommand) (*domain.ServiceAccount, error) {
+	// Tenant-scoped accounts must reference an existing tenant
+	if cmd.TenantID != nil {
+		if _, err := uc.tenantRepo.GetByTenantID(ctx, *cmd.TenantID); err != nil {
+			return nil, fmt.Errorf("failed to get tenant: %w", err)
+		}
+	}
+
+	account, err := domain.NewServiceAccount(cmd.Name, cmd.Description, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account: %w", err)
+	}
+	account.CreatedBy = cmd.CreatedBy
+
+	if err := uc.repo.Create(ctx, account); err != nil {
+		uc.logger.Error("Failed to create service account",
+			zap.String("name", cmd.Name),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	uc.logger.Info("Service account created",
+		zap.String("service_account_id", account.ID.String()),
+		zap.String("name", account.Name),
+	)
+
+	return account, nil
+}
@@ -3,9 +3,11 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -17,7 +19,22 @@ type CreateTenantCommand struct {
 	Plan       domain.PlanTier
 	AdminEmail string
 	AdminName  string
-	Settings   map[string]interface{}
+	// Settings is a JSON merge patch applied to the default settings
+	Settings map[string]interface{}
+	// ParentTenantID places the tenant in an organization's hierarchy
+	ParentTenantID *uuid.UUID
+	InheritQuotas  bool
+	// Trial puts the tenant on a trial of Plan
+	Trial *TrialTerms
+}
+
+// TrialTerms are the terms of a trial started with a new tenant
+type TrialTerms struct {
+	Duration time.Duration
+	// FallbackPlan defaults to the free plan
+	FallbackPlan domain.PlanTier
+	// ExpiryPolicy defaults to a downgrade to FallbackPlan
+	ExpiryPolicy domain.TrialExpiryPolicy
 }
 
 // CreateTenantResult represents the output of creating a tenant
@@ -30,15 +47,23 @@ type CreateTenantResult struct {
 // CreateTenantUseCase handles tenant creation with full orchestration
 type CreateTenantUseCase struct {
 	repo        domain.TenantRepository
+	plans       *PlanCatalog
+	tx          Transactor
+	audit       domain.AuditRepository
 	provisioner SchemaProvisioner
 	publisher   EventPublisher
+	slugCooldown time.Duration
 	logger      *zap.Logger
 }
 
 // SchemaProvisioner interface for schema provisioning
 type SchemaProvisioner interface {
 	ProvisionTenant(ctx context.Context, tenantID uuid.UUID) error
+	DeProvisionTenant(ctx context.Context, tenantID uuid.UUID) error
 	SchemaExists(ctx context.Context, tenantID uuid.UUID) (bool, error)
+	ExportSchema(ctx context.Context, tenantID uuid.UUID, w io.Writer) error
+	RestoreSchema(ctx context.Context, tenantID uuid.UUID, r io.Reader) error
+	MeasureSchema(ctx context.Context, tenantID uuid.UUID) (domain.SchemaSize, error)
 }
 
 // EventPublisher interface for publishing events
@@ -48,19 +73,45 @@ type EventPublisher interface {
 	PublishTenantSuspended(ctx context.Context, tenant *domain.Tenant) error
 	PublishTenantActivated(ctx context.Context, tenant *domain.Tenant) error
 	PublishTenantDeleted(ctx context.Context, tenant *domain.Tenant) error
+	PublishTenantArchived(ctx context.Context, tenant *domain.Tenant) error
+	PublishTenantUnarchived(ctx context.Context, tenant *domain.Tenant) error
+	PublishTenantRestored(ctx context.Context, tenant *domain.Tenant) error
+	PublishTenantPurged(ctx context.Context, tenant *domain.Tenant) error
+	PublishTenantPlanChanged(ctx context.Context, tenant *domain.Tenant, change *domain.PlanChange) error
+	PublishTenantQuotaChanged(ctx context.Context, tenant *domain.Tenant) error
+	PublishEntitlementThresholdReached(ctx context.Context, tenant *domain.Tenant, usage *domain.EntitlementUsage, threshold int) error
+	PublishTenantStorageOverQuota(ctx context.Context, tenant *domain.Tenant, snapshot *domain.StorageSnapshot) error
+	PublishTenantFeaturesChanged(ctx context.Context, tenant *domain.Tenant, features []*domain.FeatureValue) error
+	PublishTenantSlugChanged(ctx context.Context, tenant *domain.Tenant, previousSlug string) error
+	PublishTenantDomainChanged(ctx context.Context, tenant *domain.Tenant, d *domain.CustomDomain) error
+	PublishTenantTrialReminder(ctx context.Context, tenant *domain.Tenant, remaining time.Duration) error
+	PublishTenantTrialEnded(ctx context.Context, tenant *domain.Tenant) error
+	PublishTenantOperationFailed(ctx context.Context, tenant *domain.Tenant, op *domain.ScheduledOperation) error
+	PublishTenantMemberAdded(ctx context.Context, tenant *domain.Tenant, m *domain.Member) error
+	PublishTenantMemberRemoved(ctx context.Context, tenant *domain.Tenant, m *domain.Member) error
+	PublishTenantMemberRoleChanged(ctx context.Context, tenant *domain.Tenant, m *domain.Member, previousRole domain.MemberRole) error
 }
 
-// NewCreateTenantUseCase creates a new CreateTenantUseCase
+// NewCreateTenantUseCase creates a new CreateTenantUseCase. A released slug
+// alias can be claimed once slugCooldown has passed.
 func NewCreateTenantUseCase(
 	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	tx Transactor,
+	audit domain.AuditRepository,
 	provisioner SchemaProvisioner,
 	publisher EventPublisher,
+	slugCooldown time.Duration,
 	logger *zap.Logger,
 ) *CreateTenantUseCase {
 	return &CreateTenantUseCase{
 		repo:        repo,
+		plans:       plans,
+		tx:          tx,
+		audit:       audit,
 		provisioner: provisioner,
 		publisher:   publisher,
+		slugCooldown: slugCooldown,
 		logger:      logger,
 	}
 }
@@ -81,18 +132,20 @@ func (uc *CreateTenantUseCase) Execute(ctx context.Context, cmd CreateTenantComm
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
-	// Step 2: Check slug uniqueness
-	exists, err := uc.repo.ExistsBySlug(ctx, cmd.Slug)
+	// Look up the plan; retired plans are refused by NewTenant
+	plan, err := uc.plans.Get(ctx, cmd.Plan)
 	if err != nil {
-		uc.logger.Error("Failed to check slug existence", zap.Error(err))
-		return nil, fmt.Errorf("failed to check slug: %w", err)
+		return nil, fmt.Errorf("failed to get plan: %w", err)
 	}
-	if exists {
-		return nil, domain.ErrSlugAlreadyExists
+
+	// Step 2: Check slug uniqueness, including the aliases of renamed tenants
+	if err := checkSlugAvailable(ctx, uc.repo, uuid.Nil, cmd.Slug, uc.slugCooldown); err != nil {
+		uc.logger.Error("Slug is not available", zap.String("slug", cmd.Slug), zap.Error(err))
+		return nil, err
 	}
 
 	// Step 3: Create tenant entity (status: provisioning)
-	tenant, err := domain.NewTenant(cmd.Name, cmd.Slug, cmd.Plan, cmd.AdminEmail)
+	tenant, err := domain.NewTenant(cmd.Name, cmd.Slug, plan, cmd.AdminEmail)
 	if err != nil {
 		uc.logger.Error("Failed to create tenant entity", zap.Error(err))
 		return nil, fmt.Errorf("failed to create tenant entity: %w", err)
@@ -103,11 +156,34 @@ func (uc *CreateTenantUseCase) Execute(ctx context.Context, cmd CreateTenantComm
 		tenant.PrimaryContactName = cmd.AdminName
 	}
 	if cmd.Settings != nil {
-		tenant.Settings = cmd.Settings
+		if err := tenant.UpdateSettings(cmd.Settings); err != nil {
+			return nil, fmt.Errorf("invalid settings: %w", err)
+		}
 	}
+	if cmd.Trial != nil {
+		if err := uc.startTrial(ctx, tenant, *cmd.Trial); err != nil {
+			return nil, err
+		}
+	}
+	creator := actor.FromContext(ctx)
+	creator.StampTransitions(tenant)
+	tenant.CreatedBy = creator.UUID()
+	tenant.UpdatedBy = tenant.CreatedBy
 
-	// Step 4: Insert tenant record into database
-	if err := uc.repo.Create(ctx, tenant); err != nil {
+	// Step 4: Place the tenant in its hierarchy and insert the tenant record
+	// and its audit event into database
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if cmd.ParentTenantID != nil || cmd.InheritQuotas {
+			if err := placeUnderParent(ctx, uc.repo, tenant, cmd.ParentTenantID, cmd.InheritQuotas); err != nil {
+				return err
+			}
+		}
+		if err := uc.repo.Create(ctx, tenant); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, tenantAuditEvent(ctx, domain.AuditTenantCreated, tenant, nil))
+	})
+	if err != nil {
 		uc.logger.Error("Failed to insert tenant into database",
 			zap.String("tenant_id", tenant.TenantID.String()),
 			zap.Error(err),
@@ -139,13 +215,14 @@ func (uc *CreateTenantUseCase) Execute(ctx context.Context, cmd CreateTenantComm
 	)
 
 	// Step 6: Activate tenant
-	if err := tenant.Activate(); err != nil {
+	before := tenant.Snapshot()
+	if err := tenant.CompleteProvisioning(); err != nil {
 		uc.logger.Error("Failed to activate tenant", zap.Error(err))
 		return nil, fmt.Errorf("failed to activate tenant: %w", err)
 	}
 
 	// Step 7: Update tenant status to active
-	if err := uc.repo.Update(ctx, tenant); err != nil {
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantProvisioned, tenant, before); err != nil {
 		uc.logger.Error("Failed to update tenant status",
 			zap.String("tenant_id", tenant.TenantID.String()),
 			zap.Error(err),
@@ -182,6 +259,21 @@ func (uc *CreateTenantUseCase) Execute(ctx context.Context, cmd CreateTenantComm
 	}, nil
 }
 
+// startTrial puts a new tenant on a trial of its plan
+func (uc *CreateTenantUseCase) startTrial(ctx context.Context, tenant *domain.Tenant, terms TrialTerms) error {
+	fallbackTier := terms.FallbackPlan
+	if fallbackTier == "" {
+		fallbackTier = domain.PlanFree
+	}
+
+	fallback, err := uc.plans.Get(ctx, fallbackTier)
+	if err != nil {
+		return fmt.Errorf("failed to get trial fallback plan: %w", err)
+	}
+
+	return tenant.StartTrial(fallback, terms.Duration, terms.ExpiryPolicy, time.Now())
+}
+
 // validateCommand validates the create tenant command
 func (uc *CreateTenantUseCase) validateCommand(cmd CreateTenantCommand) error {
 	if cmd.Name == "" {
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DeleteServiceAccountUseCase deactivates a service account, disabling all of its keys
+type DeleteServiceAccountUseCase struct {
+	repo   domain.ServiceAccountRepository
+	logger *zap.Logger
+}
+
+// NewDeleteServiceAccountUseCase creates a new DeleteServiceAccountUseCase
+func NewDeleteServiceAccountUseCase(repo domain.ServiceAccountRepository, logger *zap.Logger) *DeleteServiceAccountUseCase {
+	return &DeleteServiceAccountUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute executes the delete service account use case
+func (uc *DeleteServiceAccountUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	account, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	if err := account.Deactivate(); err != nil {
+		return fmt.Errorf("failed to deactivate service account: %w", err)
+	}
+
+	if err := uc.repo.Update(ctx, account); err != nil {
+		uc.logger.Error("Failed to update service account",
+			zap.String("service_account_id", id.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update service account: %w", err)
+	}
+
+	uc.logger.Warn("Service account deactivated",
+		zap.String("service_account_id", id.String()),
+		zap.String("name", account.Name),
+	)
+
+	return nil
+}
@@ -17,6 +17,8 @@ type DeleteTenantCommand struct {
 // DeleteTenantUseCase handles tenant soft deletion
 type DeleteTenantUseCase struct {
 	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
 	publisher EventPublisher
 	logger    *zap.Logger
 }
@@ -24,11 +26,15 @@ type DeleteTenantUseCase struct {
 // NewDeleteTenantUseCase creates a new DeleteTenantUseCase
 func NewDeleteTenantUseCase(
 	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
 	publisher EventPublisher,
 	logger *zap.Logger,
 ) *DeleteTenantUseCase {
 	return &DeleteTenantUseCase{
 		repo:      repo,
+		tx:        tx,
+		audit:     audit,
 		publisher: publisher,
 		logger:    logger,
 	}
@@ -50,6 +56,8 @@ func (uc *DeleteTenantUseCase) Execute(ctx context.Context, cmd DeleteTenantComm
 		return fmt.Errorf("failed to get tenant: %w", err)
 	}
 
+	before := tenant.Snapshot()
+
 	// Soft delete tenant
 	if err := tenant.Delete(); err != nil {
 		uc.logger.Error("Failed to delete tenant",
@@ -60,7 +68,7 @@ func (uc *DeleteTenantUseCase) Execute(ctx context.Context, cmd DeleteTenantComm
 	}
 
 	// Update tenant
-	if err := uc.repo.Update(ctx, tenant); err != nil {
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantDeleted, tenant, before); err != nil {
 		uc.logger.Error("Failed to update tenant",
 			zap.String("tenant_id", cmd.TenantID.String()),
 			zap.Error(err),
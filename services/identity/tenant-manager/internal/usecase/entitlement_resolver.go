@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+)
+
+// entitlementResolver finds the limit of an entitlement that applies to a
+// tenant: its effective quota for the quota names, otherwise its own limit,
+// otherwise the limit of its plan
+type entitlementResolver struct {
+	plans        *PlanCatalog
+	entitlements domain.EntitlementRepository
+	members      domain.MemberRepository
+	storage      domain.StorageRepository
+}
+
+// resolve returns the limit of one entitlement for the tenant
+func (r entitlementResolver) resolve(ctx context.Context, tenant *domain.Tenant, name string) (*domain.EntitlementLimit, error) {
+	limits, err := r.resolveAll(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range limits {
+		if l.Name == name {
+			return l, nil
+		}
+	}
+
+	return nil, domain.ErrEntitlementNotFound
+}
+
+// resolveAll returns the limit of every entitlement of the tenant, quotas first
+func (r entitlementResolver) resolveAll(ctx context.Context, tenant *domain.Tenant) ([]*domain.EntitlementLimit, error) {
+	var limits []*domain.EntitlementLimit
+	for _, quota := range tenant.EffectiveQuotas(time.Now()) {
+		limits = append(limits, domain.QuotaEntitlement(quota))
+	}
+
+	own, err := r.entitlements.ListTenantLimits(ctx, tenant.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant entitlement limits: %w", err)
+	}
+	limits = append(limits, own...)
+
+	// A tenant on a plan missing from the catalog still has its own limits
+	plan, err := r.plans.Get(ctx, tenant.PlanTier)
+	if err != nil && !errors.Is(err, domain.ErrPlanNotFound) {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	if plan != nil {
+		for _, l := range plan.Entitlements {
+			if !hasEntitlement(own, l.Name) {
+				limits = append(limits, l)
+			}
+		}
+	}
+
+	return limits, nil
+}
+
+// hasEntitlement checks if limits contains a limit of the named entitlement
+func hasEntitlement(limits []*domain.EntitlementLimit, name string) bool {
+	for _, l := range limits {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// usage measures a tenant's usage of an entitlement in its current window.
+// The usage of max_users is the tenant's active members, the count that
+// adding a member is checked against. The usage of max_storage_gb is the
+// schema size at the latest storage snapshot in GB, rounded up, or 0 before
+// the first snapshot.
+func (r entitlementResolver) usage(ctx context.Context, tenant *domain.Tenant, limit *domain.EntitlementLimit, now time.Time) (*domain.EntitlementUsage, error) {
+	switch {
+	case limit.CountsMembers():
+		count, err := r.members.CountActive(ctx, tenant.TenantID, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to count tenant members: %w", err)
+		}
+		return domain.NewEntitlementUsage(tenant.TenantID, limit, int64(count), now), nil
+
+	case limit.MeasuresStorage():
+		var usedGB int64
+		snapshot, err := r.storage.GetLatest(ctx, tenant.TenantID)
+		switch {
+		case err == nil:
+			usedGB = (snapshot.TotalBytes() + domain.BytesPerGB - 1) / domain.BytesPerGB
+		case !errors.Is(err, domain.ErrStorageSnapshotNotFound):
+			return nil, fmt.Errorf("failed to get storage snapshot: %w", err)
+		}
+		return domain.NewEntitlementUsage(tenant.TenantID, limit, usedGB, now), nil
+
+	default:
+		return currentUsage(ctx, r.entitlements, tenant, limit, now)
+	}
+}
+
+// currentUsage measures a tenant's usage of an entitlement in its current window
+func currentUsage(ctx context.Context, repo domain.EntitlementRepository, tenant *domain.Tenant, limit *domain.EntitlementLimit, now time.Time) (*domain.EntitlementUsage, error) {
+	used, err := repo.SumUsage(ctx, tenant.TenantID, limit.Name, limit.WindowStart(now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entitlement usage: %w", err)
+	}
+	return domain.NewEntitlementUsage(tenant.TenantID, limit, used, now), nil
+}
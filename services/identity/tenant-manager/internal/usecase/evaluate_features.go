@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// EvaluateFeaturesUseCase resolves the feature flags of a tenant
+type EvaluateFeaturesUseCase struct {
+	repo   domain.TenantRepository
+	flags  domain.FeatureFlagRepository
+	logger *zap.Logger
+}
+
+// NewEvaluateFeaturesUseCase creates a new EvaluateFeaturesUseCase
+func NewEvaluateFeaturesUseCase(repo domain.TenantRepository, flags domain.FeatureFlagRepository, logger *zap.Logger) *EvaluateFeaturesUseCase {
+	return &EvaluateFeaturesUseCase{
+		repo:   repo,
+		flags:  flags,
+		logger: logger,
+	}
+}
+
+// Execute resolves every registered flag for the tenant
+func (uc *EvaluateFeaturesUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]*domain.FeatureValue, error) {
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	return evaluateFeatures(ctx, uc.flags, tenant)
+}
+
+// evaluateFeatures resolves every registered flag for the tenant
+func evaluateFeatures(ctx context.Context, flags domain.FeatureFlagRepository, tenant *domain.Tenant) ([]*domain.FeatureValue, error) {
+	registered, err := flags.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	return tenant.EvaluateFeatures(registered), nil
+}
+
+// publishFeaturesChanged publishes a tenant.features.changed event (async)
+func publishFeaturesChanged(publisher EventPublisher, logger *zap.Logger, tenant *domain.Tenant, features []*domain.FeatureValue) {
+	go func() {
+		publishCtx := context.Background()
+		if err := publisher.PublishTenantFeaturesChanged(publishCtx, tenant, features); err != nil {
+			logger.Error("Failed to publish tenant.features.changed event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+}
@@ -0,0 +1,862 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// testPlans mirrors the plans seeded into the catalog
+var testPlans = map[domain.PlanTier]*domain.Plan{
+	domain.PlanFree:         {Tier: domain.PlanFree, DisplayName: "Free", MaxUsers: 5, MaxStorageGB: 5},
+	domain.PlanBasic:        {Tier: domain.PlanBasic, DisplayName: "Basic", MaxUsers: 20, MaxStorageGB: 50},
+	domain.PlanProfessional: {Tier: domain.PlanProfessional, DisplayName: "Professional", MaxUsers: 100, MaxStorageGB: 500},
+	domain.PlanEnterprise:   {Tier: domain.PlanEnterprise, DisplayName: "Enterprise", MaxUsers: 1000, MaxStorageGB: 5000},
+}
+
+// fakeTx runs units of work without a database. A unit of work that fails
+// rolls back the stores it was given.
+type fakeTx struct {
+	stores  []fakeStore
+	commits int
+}
+
+// fakeStore is an in-memory repository that can be rolled back
+type fakeStore interface {
+	// begin saves the store's state and returns a function restoring it
+	begin() (rollback func())
+}
+
+type fakeTxKey struct{}
+
+// fakeTxState is the state of one fake transaction
+type fakeTxState struct {
+	// locks holds the advisory locks taken in the transaction
+	locks map[string]bool
+	// afterCommit holds the functions to run once the transaction commits
+	afterCommit []func()
+}
+
+// fakeTxFrom returns the fake transaction ctx runs in, or nil
+func fakeTxFrom(ctx context.Context) *fakeTxState {
+	state, _ := ctx.Value(fakeTxKey{}).(*fakeTxState)
+	return state
+}
+
+func (tx *fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(fakeTxKey{}) != nil {
+		return fn(ctx)
+	}
+
+	rollbacks := make([]func(), 0, len(tx.stores))
+	for _, store := range tx.stores {
+		rollbacks = append(rollbacks, store.begin())
+	}
+	state := &fakeTxState{locks: make(map[string]bool)}
+	if err := fn(context.WithValue(ctx, fakeTxKey{}, state)); err != nil {
+		for _, rollback := range rollbacks {
+			rollback()
+		}
+		return err
+	}
+	tx.commits++
+	for _, f := range state.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit runs fn synchronously, so tests see its effects on return
+func (tx *fakeTx) AfterCommit(ctx context.Context, fn func()) {
+	if state := fakeTxFrom(ctx); state != nil {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	fn()
+}
+
+// fakeTenantRepo keeps tenants in memory. Reads return copies, as a database
+// would. Methods a test does not need panic through the nil interface.
+type fakeTenantRepo struct {
+	domain.TenantRepository
+
+	mu      sync.Mutex
+	tenants map[uuid.UUID]domain.Tenant
+	updates int
+
+	// trials is what ListTrialsEndingBefore returns, a snapshot the test
+	// takes before changing the stored tenants
+	trials []*domain.Tenant
+}
+
+func newFakeTenantRepo(tenants ...*domain.Tenant) *fakeTenantRepo {
+	r := &fakeTenantRepo{tenants: make(map[uuid.UUID]domain.Tenant)}
+	for _, t := range tenants {
+		r.tenants[t.TenantID] = *t
+	}
+	return r
+}
+
+func (r *fakeTenantRepo) GetByTenantID(_ context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tenants[tenantID]
+	if !ok {
+		return nil, domain.ErrTenantNotFound
+	}
+	return &t, nil
+}
+
+func (r *fakeTenantRepo) GetByTenantIDForUpdate(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	if fakeTxFrom(ctx) == nil {
+		return nil, errors.New("tenant row locked outside a transaction")
+	}
+	tenant, err := r.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.Trial != nil {
+		trial := *tenant.Trial
+		tenant.Trial = &trial
+	}
+	return tenant, nil
+}
+
+func (r *fakeTenantRepo) Update(_ context.Context, tenant *domain.Tenant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tenants[tenant.TenantID] = *tenant
+	r.updates++
+	return nil
+}
+
+func (r *fakeTenantRepo) ListTrialsEndingBefore(context.Context, time.Time, int) ([]*domain.Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tenants := make([]*domain.Tenant, 0, len(r.trials))
+	for _, t := range r.trials {
+		tenant := *t
+		trial := *t.Trial
+		tenant.Trial = &trial
+		tenants = append(tenants, &tenant)
+	}
+	return tenants, nil
+}
+
+func (r *fakeTenantRepo) UpdateTrialReminder(_ context.Context, tenant *domain.Tenant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.tenants[tenant.TenantID]
+	if !ok || stored.Trial == nil || stored.Trial.EndedAt != nil || !stored.Trial.StartedAt.Equal(tenant.Trial.StartedAt) {
+		return domain.ErrNoActiveTrial
+	}
+	trial := *stored.Trial
+	trial.RemindedAt = tenant.Trial.RemindedAt
+	stored.Trial = &trial
+	r.tenants[tenant.TenantID] = stored
+	return nil
+}
+
+func (r *fakeTenantRepo) ListByStatus(_ context.Context, statuses ...domain.TenantStatus) ([]*domain.Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var tenants []*domain.Tenant
+	for _, t := range r.tenants {
+		for _, status := range statuses {
+			if t.Status == status {
+				tenant := t
+				tenants = append(tenants, &tenant)
+				break
+			}
+		}
+	}
+	return tenants, nil
+}
+
+func (r *fakeTenantRepo) ListPurgeable(_ context.Context, deletedBefore time.Time, limit int) ([]*domain.Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var tenants []*domain.Tenant
+	for _, t := range r.tenants {
+		if t.IsDeleted() && !t.IsPurged() && t.DeletedAt.Before(deletedBefore) && len(tenants) < limit {
+			tenant := t
+			tenants = append(tenants, &tenant)
+		}
+	}
+	return tenants, nil
+}
+
+func (r *fakeTenantRepo) LockDeletion(ctx context.Context, tenantID uuid.UUID) error {
+	tx := fakeTxFrom(ctx)
+	if tx == nil {
+		return errors.New("deletion locked outside a transaction")
+	}
+	tx.locks[tenantID.String()+":deletion"] = true
+	return nil
+}
+
+func (r *fakeTenantRepo) ListStatusHistory(context.Context, uuid.UUID) ([]*domain.StatusTransition, error) {
+	return nil, nil
+}
+
+func (r *fakeTenantRepo) ListSlugAliases(context.Context, uuid.UUID) ([]*domain.SlugAlias, error) {
+	return nil, nil
+}
+
+// errHierarchyNotLocked is returned by the fake hierarchy reads made outside
+// the hierarchy lock
+var errHierarchyNotLocked = errors.New("hierarchy read without the hierarchy lock")
+
+func (r *fakeTenantRepo) LockHierarchy(ctx context.Context) error {
+	tx := fakeTxFrom(ctx)
+	if tx == nil {
+		return errors.New("hierarchy locked outside a transaction")
+	}
+	tx.locks["hierarchy"] = true
+	return nil
+}
+
+func (r *fakeTenantRepo) ListAncestors(ctx context.Context, tenantID uuid.UUID) ([]*domain.Tenant, error) {
+	if tx := fakeTxFrom(ctx); tx == nil || !tx.locks["hierarchy"] {
+		return nil, errHierarchyNotLocked
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ancestors []*domain.Tenant
+	for t := r.tenants[tenantID]; t.ParentTenantID != nil; {
+		t = r.tenants[*t.ParentTenantID]
+		parent := t
+		ancestors = append([]*domain.Tenant{&parent}, ancestors...)
+	}
+	return ancestors, nil
+}
+
+func (r *fakeTenantRepo) ListDescendants(ctx context.Context, tenantID uuid.UUID) ([]*domain.Tenant, error) {
+	if tx := fakeTxFrom(ctx); tx == nil || !tx.locks["hierarchy"] {
+		return nil, errHierarchyNotLocked
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var descendants []*domain.Tenant
+	level := []uuid.UUID{tenantID}
+	for len(level) > 0 {
+		var next []uuid.UUID
+		for _, id := range level {
+			for _, t := range r.tenants {
+				if t.ParentTenantID != nil && *t.ParentTenantID == id {
+					child := t
+					descendants = append(descendants, &child)
+					next = append(next, child.TenantID)
+				}
+			}
+		}
+		level = next
+	}
+	return descendants, nil
+}
+
+func (r *fakeTenantRepo) get(tenantID uuid.UUID) domain.Tenant {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.tenants[tenantID]
+}
+
+func (r *fakeTenantRepo) begin() func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := make(map[uuid.UUID]domain.Tenant, len(r.tenants))
+	for id, t := range r.tenants {
+		saved[id] = t
+	}
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.tenants = saved
+	}
+}
+
+// fakeAuditRepo records audit events
+type fakeAuditRepo struct {
+	domain.AuditRepository
+
+	events []*domain.AuditEvent
+}
+
+func (r *fakeAuditRepo) Record(_ context.Context, event *domain.AuditEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+// fakePlanRepo serves a fixed plan catalog
+type fakePlanRepo struct {
+	domain.PlanRepository
+
+	plans map[domain.PlanTier]*domain.Plan
+}
+
+func (r *fakePlanRepo) List(context.Context) ([]*domain.Plan, error) {
+	plans := make([]*domain.Plan, 0, len(r.plans))
+	for _, p := range r.plans {
+		plans = append(plans, p)
+	}
+	return plans, nil
+}
+
+func (r *fakePlanRepo) GetByTier(_ context.Context, tier domain.PlanTier) (*domain.Plan, error) {
+	if p, ok := r.plans[tier]; ok {
+		return p, nil
+	}
+	return nil, domain.ErrPlanNotFound
+}
+
+// newTestCatalog serves the plans from memory
+func newTestCatalog(plans ...*domain.Plan) *PlanCatalog {
+	repo := &fakePlanRepo{plans: make(map[domain.PlanTier]*domain.Plan)}
+	for _, p := range testPlans {
+		repo.plans[p.Tier] = p
+	}
+	for _, p := range plans {
+		repo.plans[p.Tier] = p
+	}
+	return NewPlanCatalog(repo, time.Minute, zap.NewNop())
+}
+
+// fakeEntitlementRepo keeps usage buckets in memory
+type fakeEntitlementRepo struct {
+	domain.EntitlementRepository
+
+	usage map[string]map[time.Time]int64
+}
+
+func newFakeEntitlementRepo() *fakeEntitlementRepo {
+	return &fakeEntitlementRepo{usage: make(map[string]map[time.Time]int64)}
+}
+
+func (r *fakeEntitlementRepo) ListTenantLimits(context.Context, uuid.UUID) ([]*domain.EntitlementLimit, error) {
+	return nil, nil
+}
+
+func (r *fakeEntitlementRepo) LockUsage(context.Context, uuid.UUID, string) error {
+	return nil
+}
+
+func (r *fakeEntitlementRepo) SumUsage(_ context.Context, tenantID uuid.UUID, name string, since time.Time) (int64, error) {
+	var used int64
+	for start, n := range r.usage[tenantID.String()+":"+name] {
+		if !start.Before(since) {
+			used += n
+		}
+	}
+	if used < 0 {
+		used = 0
+	}
+	return used, nil
+}
+
+func (r *fakeEntitlementRepo) ListUsage(_ context.Context, tenantID uuid.UUID, name string, since time.Time) ([]domain.UsageBucket, error) {
+	var buckets []domain.UsageBucket
+	for start, n := range r.usage[tenantID.String()+":"+name] {
+		if !start.Before(since) {
+			buckets = append(buckets, domain.UsageBucket{Start: start, Used: n})
+		}
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Start.After(buckets[j].Start) })
+	return buckets, nil
+}
+
+func (r *fakeEntitlementRepo) AddUsage(_ context.Context, tenantID uuid.UUID, name string, bucket time.Time, delta int64) error {
+	key := tenantID.String() + ":" + name
+	if r.usage[key] == nil {
+		r.usage[key] = make(map[time.Time]int64)
+	}
+	r.usage[key][bucket] += delta
+	return nil
+}
+
+// fakePublisher records published events. Methods a test does not expect
+// panic through the nil interface.
+type fakePublisher struct {
+	EventPublisher
+
+	mu     sync.Mutex
+	events []string
+}
+
+func (p *fakePublisher) record(event string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+// published returns the events published so far
+func (p *fakePublisher) published() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.events...)
+}
+
+// newActiveTenant creates an active tenant on a test plan
+func newActiveTenant(plan domain.PlanTier) *domain.Tenant {
+	tenant, err := domain.NewTenant("Test Company", "test-company", testPlans[plan], "admin@test.com")
+	if err != nil {
+		panic(err)
+	}
+	if err := tenant.CompleteProvisioning(); err != nil {
+		panic(err)
+	}
+	return tenant
+}
+
+func (p *fakePublisher) PublishTenantMemberAdded(context.Context, *domain.Tenant, *domain.Member) error {
+	return p.record("tenant.member.added")
+}
+
+func (p *fakePublisher) PublishTenantMemberRemoved(context.Context, *domain.Tenant, *domain.Member) error {
+	return p.record("tenant.member.removed")
+}
+
+// fakeMemberRepo keeps memberships in memory
+type fakeMemberRepo struct {
+	domain.MemberRepository
+
+	mu      sync.Mutex
+	members map[[2]uuid.UUID]domain.Member
+}
+
+func newFakeMemberRepo(members ...*domain.Member) *fakeMemberRepo {
+	r := &fakeMemberRepo{members: make(map[[2]uuid.UUID]domain.Member)}
+	for _, m := range members {
+		r.members[[2]uuid.UUID{m.TenantID, m.UserID}] = *m
+	}
+	return r
+}
+
+func (r *fakeMemberRepo) begin() func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := make(map[[2]uuid.UUID]domain.Member, len(r.members))
+	for k, m := range r.members {
+		saved[k] = m
+	}
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.members = saved
+	}
+}
+
+func (r *fakeMemberRepo) Create(_ context.Context, member *domain.Member) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := [2]uuid.UUID{member.TenantID, member.UserID}
+	if _, ok := r.members[key]; ok {
+		return domain.ErrMemberAlreadyExists
+	}
+	r.members[key] = *member
+	return nil
+}
+
+func (r *fakeMemberRepo) Get(_ context.Context, tenantID, userID uuid.UUID) (*domain.Member, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.members[[2]uuid.UUID{tenantID, userID}]
+	if !ok {
+		return nil, domain.ErrMemberNotFound
+	}
+	return &m, nil
+}
+
+func (r *fakeMemberRepo) ListByUser(_ context.Context, userID uuid.UUID) ([]*domain.Member, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var members []*domain.Member
+	for _, m := range r.members {
+		if m.UserID == userID && m.IsActive {
+			m := m
+			members = append(members, &m)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].IsPrimary != members[j].IsPrimary {
+			return members[i].IsPrimary
+		}
+		return members[i].CreatedAt.Before(members[j].CreatedAt)
+	})
+	return members, nil
+}
+
+func (r *fakeMemberRepo) CountActive(_ context.Context, tenantID uuid.UUID, role domain.MemberRole) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, m := range r.members {
+		if m.TenantID == tenantID && m.IsActive && (role == "" || m.Role == role) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *fakeMemberRepo) Lock(ctx context.Context, tenantID uuid.UUID) error {
+	if tx := fakeTxFrom(ctx); tx != nil {
+		tx.locks[tenantID.String()+":members"] = true
+	}
+	return nil
+}
+
+func (r *fakeMemberRepo) LockUser(ctx context.Context, userID uuid.UUID) error {
+	if tx := fakeTxFrom(ctx); tx != nil {
+		tx.locks[userID.String()+":memberships"] = true
+	}
+	return nil
+}
+
+func (r *fakeMemberRepo) Update(_ context.Context, member *domain.Member) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members[[2]uuid.UUID{member.TenantID, member.UserID}] = *member
+	return nil
+}
+
+// fakeInvitationRepo keeps invitations in memory
+type fakeInvitationRepo struct {
+	domain.InvitationRepository
+
+	mu          sync.Mutex
+	invitations map[uuid.UUID]domain.Invitation
+}
+
+func newFakeInvitationRepo(invitations ...*domain.Invitation) *fakeInvitationRepo {
+	r := &fakeInvitationRepo{invitations: make(map[uuid.UUID]domain.Invitation)}
+	for _, inv := range invitations {
+		r.invitations[inv.ID] = *inv
+	}
+	return r
+}
+
+func (r *fakeInvitationRepo) begin() func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := make(map[uuid.UUID]domain.Invitation, len(r.invitations))
+	for id, inv := range r.invitations {
+		saved[id] = inv
+	}
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.invitations = saved
+	}
+}
+
+func (r *fakeInvitationRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.Invitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	inv, ok := r.invitations[id]
+	if !ok {
+		return nil, domain.ErrInvitationNotFound
+	}
+	return &inv, nil
+}
+
+func (r *fakeInvitationRepo) Update(_ context.Context, inv *domain.Invitation, readHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.invitations[inv.ID]
+	if !ok || !stored.IsPending() || stored.TokenHash != readHash {
+		return domain.ErrInvitationNotPending
+	}
+	r.invitations[inv.ID] = *inv
+	return nil
+}
+
+func (r *fakeInvitationRepo) DeleteByTenant(_ context.Context, tenantID uuid.UUID) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deleted int64
+	for id, inv := range r.invitations {
+		if inv.TenantID == tenantID {
+			delete(r.invitations, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (p *fakePublisher) PublishTenantUpdated(context.Context, *domain.Tenant) error {
+	return p.record("tenant.updated")
+}
+
+func (p *fakePublisher) PublishTenantSuspended(context.Context, *domain.Tenant) error {
+	return p.record("tenant.suspended")
+}
+
+func (p *fakePublisher) PublishTenantActivated(context.Context, *domain.Tenant) error {
+	return p.record("tenant.activated")
+}
+
+func (p *fakePublisher) PublishTenantOperationFailed(context.Context, *domain.Tenant, *domain.ScheduledOperation) error {
+	return p.record("tenant.operation.failed")
+}
+
+// fakeOperationRepo keeps scheduled operations in memory. ListDue returns
+// the operations in due, as they were when a run listed them.
+type fakeOperationRepo struct {
+	domain.ScheduledOperationRepository
+
+	mu  sync.Mutex
+	ops map[uuid.UUID]domain.ScheduledOperation
+	due []*domain.ScheduledOperation
+}
+
+func newFakeOperationRepo(ops ...*domain.ScheduledOperation) *fakeOperationRepo {
+	r := &fakeOperationRepo{ops: make(map[uuid.UUID]domain.ScheduledOperation)}
+	for _, op := range ops {
+		r.ops[op.ID] = *op
+		listed := *op
+		r.due = append(r.due, &listed)
+	}
+	return r
+}
+
+func (r *fakeOperationRepo) begin() func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := make(map[uuid.UUID]domain.ScheduledOperation, len(r.ops))
+	for id, op := range r.ops {
+		saved[id] = op
+	}
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.ops = saved
+	}
+}
+
+func (r *fakeOperationRepo) ListDue(context.Context, time.Time, int) ([]*domain.ScheduledOperation, error) {
+	var due []*domain.ScheduledOperation
+	for _, op := range r.due {
+		if op.IsPending() {
+			due = append(due, op)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeOperationRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.ScheduledOperation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	op, ok := r.ops[id]
+	if !ok {
+		return nil, domain.ErrOperationNotFound
+	}
+	return &op, nil
+}
+
+func (r *fakeOperationRepo) Create(_ context.Context, op *domain.ScheduledOperation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ops[op.ID] = *op
+	return nil
+}
+
+func (r *fakeOperationRepo) Update(_ context.Context, op *domain.ScheduledOperation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.ops[op.ID]; !ok || !stored.IsPending() {
+		return domain.ErrOperationNotPending
+	}
+	r.ops[op.ID] = *op
+	return nil
+}
+
+func (r *fakeOperationRepo) get(id uuid.UUID) domain.ScheduledOperation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ops[id]
+}
+
+// fakeDomainRepo keeps custom domains in memory
+type fakeDomainRepo struct {
+	domain.CustomDomainRepository
+
+	mu      sync.Mutex
+	domains map[uuid.UUID]domain.CustomDomain
+}
+
+func newFakeDomainRepo(domains ...*domain.CustomDomain) *fakeDomainRepo {
+	r := &fakeDomainRepo{domains: make(map[uuid.UUID]domain.CustomDomain)}
+	for _, d := range domains {
+		r.domains[d.ID] = *d
+	}
+	return r
+}
+
+func (r *fakeDomainRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.CustomDomain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.domains[id]
+	if !ok {
+		return nil, domain.ErrDomainNotFound
+	}
+	return &d, nil
+}
+
+func (r *fakeDomainRepo) Update(_ context.Context, d *domain.CustomDomain, readStatus domain.DomainStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.domains[d.ID]; !ok || stored.Status != readStatus {
+		return domain.ErrDomainChanged
+	}
+	r.domains[d.ID] = *d
+	return nil
+}
+
+func (r *fakeDomainRepo) ListByTenant(_ context.Context, tenantID uuid.UUID) ([]*domain.CustomDomain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var domains []*domain.CustomDomain
+	for _, d := range r.domains {
+		if d.TenantID == tenantID {
+			listed := d
+			domains = append(domains, &listed)
+		}
+	}
+	return domains, nil
+}
+
+func (r *fakeDomainRepo) get(id uuid.UUID) domain.CustomDomain {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.domains[id]
+}
+
+func (r *fakeDomainRepo) begin() func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := make(map[uuid.UUID]domain.CustomDomain, len(r.domains))
+	for id, d := range r.domains {
+		saved[id] = d
+	}
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.domains = saved
+	}
+}
+
+func (p *fakePublisher) PublishTenantPlanChanged(context.Context, *domain.Tenant, *domain.PlanChange) error {
+	return p.record("tenant.plan.changed")
+}
+
+func (p *fakePublisher) PublishTenantTrialEnded(context.Context, *domain.Tenant) error {
+	return p.record("tenant.trial.ended")
+}
+
+func (p *fakePublisher) PublishTenantTrialReminder(context.Context, *domain.Tenant, time.Duration) error {
+	return p.record("tenant.trial.reminder")
+}
+
+func (p *fakePublisher) PublishTenantFeaturesChanged(context.Context, *domain.Tenant, []*domain.FeatureValue) error {
+	return p.record("tenant.features.changed")
+}
+
+// fakeFlagRepo serves a fixed feature flag registry
+type fakeFlagRepo struct {
+	domain.FeatureFlagRepository
+
+	flags []*domain.FeatureFlag
+}
+
+func (r *fakeFlagRepo) List(context.Context) ([]*domain.FeatureFlag, error) {
+	return r.flags, nil
+}
+
+// fakeUsageRepo reports a fixed usage for every tenant, and fails when read
+// outside the tenant's member lock
+type fakeUsageRepo struct {
+	usage domain.TenantUsage
+}
+
+func (r *fakeUsageRepo) GetUsage(ctx context.Context, tenantID uuid.UUID) (*domain.TenantUsage, error) {
+	if tx := fakeTxFrom(ctx); tx == nil || !tx.locks[tenantID.String()+":members"] {
+		return nil, errors.New("usage read without the member lock")
+	}
+	usage := r.usage
+	return &usage, nil
+}
+
+// fakeStorageRepo holds the latest storage snapshot of each tenant
+type fakeStorageRepo struct {
+	domain.StorageRepository
+
+	latest map[uuid.UUID]*domain.StorageSnapshot
+}
+
+func (r *fakeStorageRepo) GetLatest(_ context.Context, tenantID uuid.UUID) (*domain.StorageSnapshot, error) {
+	snapshot, ok := r.latest[tenantID]
+	if !ok {
+		return nil, domain.ErrStorageSnapshotNotFound
+	}
+	return snapshot, nil
+}
+
+// fakeProvisioner keeps track of the dropped tenant schemas. It fails when
+// a schema is dropped or checked outside the tenant's deletion lock.
+type fakeProvisioner struct {
+	SchemaProvisioner
+
+	mu      sync.Mutex
+	dropped map[uuid.UUID]bool
+}
+
+// errDeletionNotLocked is returned by the fake schema changes and checks
+// made outside the deletion lock
+var errDeletionNotLocked = errors.New("schema used without the deletion lock")
+
+func (p *fakeProvisioner) DeProvisionTenant(ctx context.Context, tenantID uuid.UUID) error {
+	if tx := fakeTxFrom(ctx); tx == nil || !tx.locks[tenantID.String()+":deletion"] {
+		return errDeletionNotLocked
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.dropped == nil {
+		p.dropped = make(map[uuid.UUID]bool)
+	}
+	p.dropped[tenantID] = true
+	return nil
+}
+
+func (p *fakeProvisioner) SchemaExists(ctx context.Context, tenantID uuid.UUID) (bool, error) {
+	if tx := fakeTxFrom(ctx); tx == nil || !tx.locks[tenantID.String()+":deletion"] {
+		return false, errDeletionNotLocked
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !p.dropped[tenantID], nil
+}
+
+// fakeArchiveRepo holds no archives
+type fakeArchiveRepo struct {
+	domain.ArchiveRepository
+}
+
+func (r *fakeArchiveRepo) ListByTenant(context.Context, uuid.UUID) ([]*domain.TenantArchive, error) {
+	return nil, nil
+}
+
+func (p *fakePublisher) PublishTenantRestored(context.Context, *domain.Tenant) error {
+	return p.record("tenant.restored")
+}
+
+func (p *fakePublisher) PublishTenantPurged(context.Context, *domain.Tenant) error {
+	return p.record("tenant.purged")
+}
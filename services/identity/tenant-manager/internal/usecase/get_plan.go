@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPlanUseCase handles retrieving plans of the catalog
+type GetPlanUseCase struct {
+	plans  *PlanCatalog
+	logger *zap.Logger
+}
+
+// NewGetPlanUseCase creates a new GetPlanUseCase
+func NewGetPlanUseCase(plans *PlanCatalog, logger *zap.Logger) *GetPlanUseCase {
+	return &GetPlanUseCase{
+		plans:  plans,
+		logger: logger,
+	}
+}
+
+// Execute retrieves a plan, retired or not
+func (uc *GetPlanUseCase) Execute(ctx context.Context, tier domain.PlanTier) (*domain.Plan, error) {
+	plan, err := uc.plans.Get(ctx, tier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+// List retrieves the plans of the catalog in display order
+func (uc *GetPlanUseCase) List(ctx context.Context, includeRetired bool) ([]*domain.Plan, error) {
+	plans, err := uc.plans.List(ctx, includeRetired)
+	if err != nil {
+		uc.logger.Error("Failed to list plans", zap.Error(err))
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetPlanHistoryUseCase handles retrieving the plan changes of a tenant
+type GetPlanHistoryUseCase struct {
+	repo   domain.TenantRepository
+	logger *zap.Logger
+}
+
+// NewGetPlanHistoryUseCase creates a new GetPlanHistoryUseCase
+func NewGetPlanHistoryUseCase(repo domain.TenantRepository, logger *zap.Logger) *GetPlanHistoryUseCase {
+	return &GetPlanHistoryUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute retrieves the plan changes of a tenant, oldest first
+func (uc *GetPlanHistoryUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]*domain.PlanChange, error) {
+	// Distinguish an unknown tenant from one without history
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	changes, err := uc.repo.ListPlanHistory(ctx, tenantID)
+	if err != nil {
+		uc.logger.Error("Failed to get tenant plan history",
+			zap.String("tenant_id", tenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get plan history: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ServiceAccountDetails is a service account with its API keys
+type ServiceAccountDetails struct {
+	Account *domain.ServiceAccount
+	Keys    []*domain.APIKey
+}
+
+// GetServiceAccountUseCase handles retrieving service accounts
+type GetServiceAccountUseCase struct {
+	repo   domain.ServiceAccountRepository
+	logger *zap.Logger
+}
+
+// NewGetServiceAccountUseCase creates a new GetServiceAccountUseCase
+func NewGetServiceAccountUseCase(repo domain.ServiceAccountRepository, logger *zap.Logger) *GetServiceAccountUseCase {
+	return &GetServiceAccountUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute retrieves a service account and its API keys
+func (uc *GetServiceAccountUseCase) Execute(ctx context.Context, id uuid.UUID) (*ServiceAccountDetails, error) {
+	account, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	keys, err := uc.repo.ListAPIKeys(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to list API keys",
+			zap.String("service_account_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	return &ServiceAccountDetails{Account: account, Keys: keys}, nil
+}
+
+// List retrieves all service accounts
+func (uc *GetServiceAccountUseCase) List(ctx context.Context) ([]*domain.ServiceAccount, error) {
+	accounts, err := uc.repo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list service accounts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultStorageHistoryRange is how far back the history goes without a from time
+	defaultStorageHistoryRange = 30 * 24 * time.Hour
+	// maxStorageHistoryLimit caps the snapshots returned by one query
+	maxStorageHistoryLimit = 1000
+)
+
+// GetStorageUsageQuery represents the input for reading a tenant's storage usage
+type GetStorageUsageQuery struct {
+	TenantID uuid.UUID
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+}
+
+// StorageUsage is a tenant's measured storage and its history
+type StorageUsage struct {
+	// Current is the latest snapshot, nil until the tenant is first measured
+	Current *domain.StorageSnapshot
+	// QuotaBytes is the tenant's effective storage quota now
+	QuotaBytes int64
+	// History holds the snapshots of the queried range, newest first
+	History []*domain.StorageSnapshot
+}
+
+// GetStorageUsageUseCase handles retrieving the storage snapshots of a tenant
+type GetStorageUsageUseCase struct {
+	repo    domain.TenantRepository
+	storage domain.StorageRepository
+	logger  *zap.Logger
+}
+
+// NewGetStorageUsageUseCase creates a new GetStorageUsageUseCase
+func NewGetStorageUsageUseCase(repo domain.TenantRepository, storage domain.StorageRepository, logger *zap.Logger) *GetStorageUsageUseCase {
+	return &GetStorageUsageUseCase{
+		repo:    repo,
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Execute retrieves the storage usage of a tenant. The history defaults to
+// the last 30 days and to at most maxStorageHistoryLimit snapshots.
+func (uc *GetStorageUsageUseCase) Execute(ctx context.Context, query GetStorageUsageQuery) (*StorageUsage, error) {
+	now := time.Now()
+
+	to := now
+	if query.To != nil {
+		to = *query.To
+	}
+	from := to.Add(-defaultStorageHistoryRange)
+	if query.From != nil {
+		from = *query.From
+	}
+	if !from.Before(to) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > maxStorageHistoryLimit {
+		limit = maxStorageHistoryLimit
+	}
+
+	tenant, err := uc.repo.GetByTenantID(ctx, query.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	usage := &StorageUsage{
+		QuotaBytes: int64(tenant.EffectiveQuota(domain.QuotaMaxStorageGB, now).Effective) * domain.BytesPerGB,
+	}
+
+	usage.Current, err = uc.storage.GetLatest(ctx, query.TenantID)
+	if err != nil && !errors.Is(err, domain.ErrStorageSnapshotNotFound) {
+		return nil, fmt.Errorf("failed to get storage snapshot: %w", err)
+	}
+
+	usage.History, err = uc.storage.List(ctx, query.TenantID, from, to, limit)
+	if err != nil {
+		uc.logger.Error("Failed to get tenant storage history",
+			zap.String("tenant_id", query.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get storage history: %w", err)
+	}
+
+	return usage, nil
+}
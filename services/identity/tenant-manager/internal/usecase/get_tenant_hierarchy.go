@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetTenantHierarchyUseCase retrieves a tenant with its ancestors and
+// descendants
+type GetTenantHierarchyUseCase struct {
+	repo   domain.TenantRepository
+	logger *zap.Logger
+}
+
+// NewGetTenantHierarchyUseCase creates a new GetTenantHierarchyUseCase
+func NewGetTenantHierarchyUseCase(repo domain.TenantRepository, logger *zap.Logger) *GetTenantHierarchyUseCase {
+	return &GetTenantHierarchyUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute executes the get tenant hierarchy use case
+func (uc *GetTenantHierarchyUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (*domain.TenantHierarchy, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	ancestors, err := uc.repo.ListAncestors(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant ancestors: %w", err)
+	}
+
+	descendants, err := uc.repo.ListDescendants(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant descendants: %w", err)
+	}
+
+	return &domain.TenantHierarchy{
+		Tenant:      tenant,
+		Ancestors:   ancestors,
+		Descendants: descendants,
+	}, nil
+}
+
+// IsAncestor checks if ancestorID is a strict ancestor of tenantID. IDs that
+// do not parse are never ancestors.
+func (uc *GetTenantHierarchyUseCase) IsAncestor(ctx context.Context, ancestorID, tenantID string) (bool, error) {
+	ancestor, err := uuid.Parse(ancestorID)
+	if err != nil {
+		return false, nil
+	}
+	id, err := uuid.Parse(tenantID)
+	if err != nil {
+		return false, nil
+	}
+
+	ancestors, err := uc.repo.ListAncestors(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to get tenant ancestors: %w", err)
+	}
+
+	for _, a := range ancestors {
+		if a.TenantID == ancestor {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetTenantHistoryUseCase handles retrieving the status history of a tenant
+type GetTenantHistoryUseCase struct {
+	repo   domain.TenantRepository
+	logger *zap.Logger
+}
+
+// NewGetTenantHistoryUseCase creates a new GetTenantHistoryUseCase
+func NewGetTenantHistoryUseCase(repo domain.TenantRepository, logger *zap.Logger) *GetTenantHistoryUseCase {
+	return &GetTenantHistoryUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute retrieves the status transitions of a tenant, oldest first
+func (uc *GetTenantHistoryUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]*domain.StatusTransition, error) {
+	// Distinguish an unknown tenant from one without history
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	transitions, err := uc.repo.ListStatusHistory(ctx, tenantID)
+	if err != nil {
+		uc.logger.Error("Failed to get tenant status history",
+			zap.String("tenant_id", tenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get status history: %w", err)
+	}
+
+	return transitions, nil
+}
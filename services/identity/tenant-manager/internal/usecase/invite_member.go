@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/pkg/invitetoken"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MailSender interface for delivering plain text email
+type MailSender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// InviteMemberCommand represents the input for inviting a person to a tenant
+type InviteMemberCommand struct {
+	TenantID uuid.UUID
+	Email    string
+	Role     domain.MemberRole
+}
+
+// InviteMemberUseCase invites a person, by email, to join a tenant
+type InviteMemberUseCase struct {
+	repo        domain.TenantRepository
+	invitations domain.InvitationRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	mailer      *invitationMailer
+	ttl         time.Duration
+	logger      *zap.Logger
+}
+
+// NewInviteMemberUseCase creates a new InviteMemberUseCase. Invitations are
+// valid for ttl, and their links point to acceptURL.
+func NewInviteMemberUseCase(
+	repo domain.TenantRepository,
+	invitations domain.InvitationRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	signer *invitetoken.Signer,
+	sender MailSender,
+	ttl time.Duration,
+	acceptURL string,
+	logger *zap.Logger,
+) *InviteMemberUseCase {
+	return &InviteMemberUseCase{
+		repo:        repo,
+		invitations: invitations,
+		tx:          tx,
+		audit:       audit,
+		mailer:      newInvitationMailer(signer, sender, acceptURL),
+		ttl:         ttl,
+		logger:      logger,
+	}
+}
+
+// Execute executes the invite member use case. The invitation email is sent
+// within the transaction, so an invitation that could not be sent is not
+// kept. The max users quota is only checked when the invitation is accepted.
+func (uc *InviteMemberUseCase) Execute(ctx context.Context, cmd InviteMemberCommand) (*domain.Invitation, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	inv, err := domain.NewInvitation(cmd.TenantID, cmd.Email, cmd.Role, uc.ttl, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	a := actor.FromContext(ctx)
+	inv.InvitedBy = a.UUID()
+
+	token, err := uc.mailer.issue(inv)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := cmd.TenantID
+	event := a.Stamp(domain.NewAuditEvent(
+		domain.AuditTenantMemberInvited, &tenantID, nil, inv.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.invitations.Create(ctx, inv); err != nil {
+			return err
+		}
+		if err := uc.audit.Record(ctx, event); err != nil {
+			return err
+		}
+		return uc.mailer.send(ctx, tenant, inv, token)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvitationAlreadyExists) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to invite tenant member",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to invite tenant member: %w", err)
+	}
+
+	uc.logger.Info("Tenant member invited",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("invitation_id", inv.ID.String()),
+		zap.String("role", string(inv.Role)),
+	)
+
+	return inv, nil
+}
+
+// List retrieves the invitations of a tenant, optionally of one stored
+// status, newest first
+func (uc *InviteMemberUseCase) List(ctx context.Context, tenantID uuid.UUID, status domain.InvitationStatus) ([]*domain.Invitation, error) {
+	// Distinguish an unknown tenant from one without invitations
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	invitations, err := uc.invitations.ListByTenant(ctx, tenantID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// invitationMailer signs invitation tokens and mails the links carrying them
+type invitationMailer struct {
+	signer    *invitetoken.Signer
+	sender    MailSender
+	acceptURL string
+}
+
+// newInvitationMailer creates an invitation mailer
+func newInvitationMailer(signer *invitetoken.Signer, sender MailSender, acceptURL string) *invitationMailer {
+	return &invitationMailer{
+		signer:    signer,
+		sender:    sender,
+		acceptURL: acceptURL,
+	}
+}
+
+// issue signs a new token for an invitation and stores its hash on the
+// invitation, replacing the previous token
+func (m *invitationMailer) issue(inv *domain.Invitation) (string, error) {
+	token, hash, err := m.signer.Sign(inv.ID, inv.ExpiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	inv.TokenHash = hash
+	return token, nil
+}
+
+// send mails the invitation link carrying a token to the invitee
+func (m *invitationMailer) send(ctx context.Context, tenant *domain.Tenant, inv *domain.Invitation, token string) error {
+	link, err := url.Parse(m.acceptURL)
+	if err != nil {
+		return fmt.Errorf("invalid invitation accept URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	subject := fmt.Sprintf("You're invited to join %s", tenant.TenantName)
+	body := fmt.Sprintf(
+		"You have been invited to join %s as %s.\n\n"+
+			"Accept the invitation by opening this link:\n%s\n\n"+
+			"The link can be used once and expires on %s.\n",
+		tenant.TenantName, inv.Role, link.String(), inv.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	)
+
+	if err := m.sender.Send(ctx, inv.Email, subject, body); err != nil {
+		return fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/apikey"
+	"github.com/cotai/tenant-manager/internal/pkg/rbac"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// IssueAPIKeyCommand represents the input for issuing an API key
+type IssueAPIKeyCommand struct {
+	ServiceAccountID uuid.UUID
+	Name             string
+	Permissions      []string
+	ExpiresAt        *time.Time
+	CreatedBy        *uuid.UUID
+}
+
+// IssueAPIKeyResult carries the plaintext key, which is never stored
+type IssueAPIKeyResult struct {
+	Key       *domain.APIKey
+	Plaintext string
+}
+
+// IssueAPIKeyUseCase handles issuing API keys for service accounts
+type IssueAPIKeyUseCase struct {
+	repo   domain.ServiceAccountRepository
+	logger *zap.Logger
+}
+
+// NewIssueAPIKeyUseCase creates a new IssueAPIKeyUseCase
+func NewIssueAPIKeyUseCase(repo domain.ServiceAccountRepository, logger *zap.Logger) *IssueAPIKeyUseCase {
+	return &IssueAPIKeyUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute executes the issue API key use case
+func (uc *IssueAPIKeyUseCase) Execute(ctx context.Context, cmd IssueAPIKeyCommand) (*IssueAPIKeyResult, error) {
+	for _, perm := range cmd.Permissions {
+		if !rbac.Permission(perm).IsValid() {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidPermission, perm)
+		}
+	}
+
+	account, err := uc.repo.GetByID(ctx, cmd.ServiceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	if !account.IsActive {
+		return nil, domain.ErrServiceAccountInactive
+	}
+
+	plaintext, prefix, hash, err := apikey.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := domain.NewAPIKey(account.ID, cmd.Name, prefix, hash, cmd.Permissions, cmd.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API key: %w", err)
+	}
+	key.CreatedBy = cmd.CreatedBy
+
+	if err := uc.repo.CreateAPIKey(ctx, key); err != nil {
+		uc.logger.Error("Failed to store API key",
+			zap.String("service_account_id", account.ID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	uc.logger.Info("API key issued",
+		zap.String("service_account_id", account.ID.String()),
+		zap.String("key_prefix", key.KeyPrefix),
+		zap.Strings("permissions", key.Permissions),
+	)
+
+	return &IssueAPIKeyResult{Key: key, Plaintext: plaintext}, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ListAuditEventsResult represents the result of listing audit events
+type ListAuditEventsResult struct {
+	Events     []*domain.AuditEvent
+	Total      int
+	Page       int
+	PerPage    int
+	TotalPages int
+}
+
+// ListAuditEventsUseCase handles querying the audit log
+type ListAuditEventsUseCase struct {
+	repo   domain.AuditRepository
+	logger *zap.Logger
+}
+
+// NewListAuditEventsUseCase creates a new ListAuditEventsUseCase
+func NewListAuditEventsUseCase(repo domain.AuditRepository, logger *zap.Logger) *ListAuditEventsUseCase {
+	return &ListAuditEventsUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute executes the list audit events use case
+func (uc *ListAuditEventsUseCase) Execute(ctx context.Context, filter domain.AuditFilter) (*ListAuditEventsResult, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	events, total, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to list audit events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	return &ListAuditEventsResult{
+		Events:     events,
+		Total:      total,
+		Page:       filter.Page,
+		PerPage:    filter.Limit(),
+		TotalPages: (total + filter.Limit() - 1) / filter.Limit(),
+	}, nil
+}
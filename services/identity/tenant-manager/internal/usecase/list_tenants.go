@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -15,6 +16,10 @@ type ListTenantsQuery struct {
 	Status   domain.TenantStatus
 	PlanTier domain.PlanTier
 	Search   string
+	// SuspensionReason restricts the list to tenants suspended for a reason
+	SuspensionReason domain.SuspensionReason
+	// AncestorID restricts the list to the descendants of a tenant
+	AncestorID *uuid.UUID
 }
 
 // ListTenantsResult represents the result of listing tenants
@@ -44,11 +49,13 @@ func NewListTenantsUseCase(repo domain.TenantRepository, logger *zap.Logger) *Li
 func (uc *ListTenantsUseCase) Execute(ctx context.Context, query ListTenantsQuery) (*ListTenantsResult, error) {
 	// Build filter from query
 	filter := domain.ListFilter{
-		Page:     query.Page,
-		PerPage:  query.PerPage,
-		Status:   query.Status,
-		PlanTier: query.PlanTier,
-		Search:   query.Search,
+		Page:             query.Page,
+		PerPage:          query.PerPage,
+		Status:           query.Status,
+		PlanTier:         query.PlanTier,
+		Search:           query.Search,
+		SuspensionReason: query.SuspensionReason,
+		AncestorID:       query.AncestorID,
 	}
 
 	// Retrieve tenants
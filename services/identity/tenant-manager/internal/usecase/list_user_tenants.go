@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ListUserTenantsUseCase retrieves the tenants a user belongs to, for the
+// auth service to populate token claims
+type ListUserTenantsUseCase struct {
+	repo    domain.TenantRepository
+	members domain.MemberRepository
+	logger  *zap.Logger
+}
+
+// NewListUserTenantsUseCase creates a new ListUserTenantsUseCase
+func NewListUserTenantsUseCase(repo domain.TenantRepository, members domain.MemberRepository, logger *zap.Logger) *ListUserTenantsUseCase {
+	return &ListUserTenantsUseCase{
+		repo:    repo,
+		members: members,
+		logger:  logger,
+	}
+}
+
+// Execute retrieves the active memberships of a user with their tenants,
+// primary first. Memberships of deleted tenants are left out.
+func (uc *ListUserTenantsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]*domain.UserTenant, error) {
+	memberships, err := uc.members.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user memberships: %w", err)
+	}
+
+	tenants := make([]*domain.UserTenant, 0, len(memberships))
+	for _, m := range memberships {
+		tenant, err := uc.repo.GetByTenantID(ctx, m.TenantID)
+		if err != nil {
+			// The mapping has no foreign key to the registry
+			if errors.Is(err, domain.ErrTenantNotFound) {
+				uc.logger.Warn("Membership of unknown tenant skipped",
+					zap.String("tenant_id", m.TenantID.String()),
+					zap.String("user_id", userID.String()),
+				)
+				continue
+			}
+			return nil, fmt.Errorf("failed to get tenant: %w", err)
+		}
+		if tenant.IsDeleted() {
+			continue
+		}
+		tenants = append(tenants, &domain.UserTenant{Member: m, Tenant: tenant})
+	}
+
+	return tenants, nil
+}
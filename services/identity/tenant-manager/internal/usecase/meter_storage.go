@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// meteredStatuses are the statuses of tenants whose schema is live and counts
+// against their storage quota
+var meteredStatuses = []domain.TenantStatus{domain.StatusActive, domain.StatusSuspended}
+
+// MeterStorageCommand represents the input for a storage metering run
+type MeterStorageCommand struct {
+	// Retention is how long snapshots are kept; zero keeps them forever
+	Retention time.Duration
+}
+
+// MeterStorageReport summarizes a storage metering run
+type MeterStorageReport struct {
+	MeasuredAt time.Time
+	Snapshots  []*domain.StorageSnapshot
+	OverQuota  int
+	Failed     int
+	Pruned     int64
+}
+
+// MeterStorageUseCase measures the schema of every live tenant and records
+// the sizes as storage snapshots
+type MeterStorageUseCase struct {
+	repo        domain.TenantRepository
+	storage     domain.StorageRepository
+	provisioner SchemaProvisioner
+	publisher   EventPublisher
+	logger      *zap.Logger
+}
+
+// NewMeterStorageUseCase creates a new MeterStorageUseCase
+func NewMeterStorageUseCase(
+	repo domain.TenantRepository,
+	storage domain.StorageRepository,
+	provisioner SchemaProvisioner,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *MeterStorageUseCase {
+	return &MeterStorageUseCase{
+		repo:        repo,
+		storage:     storage,
+		provisioner: provisioner,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// Execute executes a storage metering run. A failure to measure one tenant is
+// counted in the report and does not stop the run.
+func (uc *MeterStorageUseCase) Execute(ctx context.Context, cmd MeterStorageCommand) (*MeterStorageReport, error) {
+	if cmd.Retention < 0 {
+		return nil, fmt.Errorf("storage snapshot retention cannot be negative, got %s", cmd.Retention)
+	}
+
+	report := &MeterStorageReport{MeasuredAt: time.Now()}
+
+	tenants, err := uc.repo.ListByStatus(ctx, meteredStatuses...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	for _, tenant := range tenants {
+		snapshot, err := uc.meter(ctx, tenant, report.MeasuredAt)
+		if err != nil {
+			report.Failed++
+			uc.logger.Error("Failed to meter tenant storage",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		report.Snapshots = append(report.Snapshots, snapshot)
+		if snapshot.OverQuota() {
+			report.OverQuota++
+		}
+	}
+
+	if cmd.Retention > 0 {
+		report.Pruned, err = uc.storage.DeleteBefore(ctx, report.MeasuredAt.Add(-cmd.Retention))
+		if err != nil {
+			return report, fmt.Errorf("failed to prune storage snapshots: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+// meter measures and records the storage of one tenant. The over-quota event
+// is raised only by the measurement that crosses the quota, not by every
+// measurement above it.
+func (uc *MeterStorageUseCase) meter(ctx context.Context, tenant *domain.Tenant, now time.Time) (*domain.StorageSnapshot, error) {
+	size, err := uc.provisioner.MeasureSchema(ctx, tenant.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure schema: %w", err)
+	}
+
+	previous, err := uc.storage.GetLatest(ctx, tenant.TenantID)
+	if err != nil && !errors.Is(err, domain.ErrStorageSnapshotNotFound) {
+		return nil, fmt.Errorf("failed to get previous snapshot: %w", err)
+	}
+
+	snapshot := domain.NewStorageSnapshot(tenant, size, now)
+	if err := uc.storage.Record(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to record snapshot: %w", err)
+	}
+
+	if snapshot.WentOverQuota(previous) {
+		uc.logger.Warn("Tenant storage over quota",
+			zap.String("tenant_id", tenant.TenantID.String()),
+			zap.Int64("total_bytes", snapshot.TotalBytes()),
+			zap.Int64("quota_bytes", snapshot.QuotaBytes),
+		)
+
+		// Publish event (async)
+		go func() {
+			publishCtx := context.Background()
+			if err := uc.publisher.PublishTenantStorageOverQuota(publishCtx, tenant, snapshot); err != nil {
+				uc.logger.Error("Failed to publish tenant.storage.over_quota event",
+					zap.String("tenant_id", tenant.TenantID.String()),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// PlanCatalog serves the plan catalog from memory. The catalog is reloaded
+// from the repository once it is older than the TTL, so plans created or
+// retired on another replica are picked up within one TTL.
+type PlanCatalog struct {
+	repo   domain.PlanRepository
+	ttl    time.Duration
+	logger *zap.Logger
+
+	mu       sync.RWMutex
+	plans    map[domain.PlanTier]*domain.Plan
+	loadedAt time.Time
+}
+
+// NewPlanCatalog creates a new plan catalog
+func NewPlanCatalog(repo domain.PlanRepository, ttl time.Duration, logger *zap.Logger) *PlanCatalog {
+	return &PlanCatalog{
+		repo:   repo,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// Get retrieves a plan, retired or not. An unknown plan forces a reload
+// before ErrPlanNotFound is returned.
+func (c *PlanCatalog) Get(ctx context.Context, tier domain.PlanTier) (*domain.Plan, error) {
+	plans, err := c.snapshot(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if plan, ok := plans[tier]; ok {
+		return plan, nil
+	}
+
+	plans, err = c.snapshot(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if plan, ok := plans[tier]; ok {
+		return plan, nil
+	}
+
+	return nil, domain.ErrPlanNotFound
+}
+
+// List retrieves every plan in display order, optionally including retired plans
+func (c *PlanCatalog) List(ctx context.Context, includeRetired bool) ([]*domain.Plan, error) {
+	plans, err := c.snapshot(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*domain.Plan, 0, len(plans))
+	for _, plan := range plans {
+		if plan.IsRetired() && !includeRetired {
+			continue
+		}
+		list = append(list, plan)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].SortOrder != list[j].SortOrder {
+			return list[i].SortOrder < list[j].SortOrder
+		}
+		return list[i].Tier < list[j].Tier
+	})
+
+	return list, nil
+}
+
+// Invalidate forces the next lookup to reload the catalog
+func (c *PlanCatalog) Invalidate() {
+	c.mu.Lock()
+	c.loadedAt = time.Time{}
+	c.mu.Unlock()
+}
+
+// snapshot returns the cached plans, reloading them when stale or when forced
+func (c *PlanCatalog) snapshot(ctx context.Context, force bool) (map[domain.PlanTier]*domain.Plan, error) {
+	c.mu.RLock()
+	plans, loadedAt := c.plans, c.loadedAt
+	c.mu.RUnlock()
+
+	if plans != nil && !force && time.Since(loadedAt) < c.ttl {
+		return plans, nil
+	}
+
+	list, err := c.repo.List(ctx)
+	if err != nil {
+		// Serve a stale catalog rather than failing every tenant operation
+		if plans != nil && !errors.Is(err, context.Canceled) {
+			c.logger.Warn("Failed to reload plan catalog, serving cached plans", zap.Error(err))
+			return plans, nil
+		}
+		return nil, fmt.Errorf("failed to load plan catalog: %w", err)
+	}
+
+	plans = make(map[domain.PlanTier]*domain.Plan, len(list))
+	for _, plan := range list {
+		plans[plan.Tier] = plan
+	}
+
+	c.mu.Lock()
+	c.plans = plans
+	c.loadedAt = time.Now()
+	c.mu.Unlock()
+
+	return plans, nil
+}
@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ProcessTrialsCommand represents the input for a trial run
+type ProcessTrialsCommand struct {
+	// ReminderOffsets are how long before a trial ends reminders are sent
+	ReminderOffsets []time.Duration
+	Limit           int
+}
+
+// ProcessTrialsReport summarizes a trial run
+type ProcessTrialsReport struct {
+	Reminded   int
+	Downgraded int
+	Suspended  int
+	// Errors counts trials that could not be processed
+	Errors int
+}
+
+// ProcessTrialsUseCase sends trial expiry reminders and ends expired trials
+// according to their expiry policy
+type ProcessTrialsUseCase struct {
+	repo      domain.TenantRepository
+	plans     *PlanCatalog
+	flags     domain.FeatureFlagRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewProcessTrialsUseCase creates a new ProcessTrialsUseCase
+func NewProcessTrialsUseCase(
+	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	flags domain.FeatureFlagRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *ProcessTrialsUseCase {
+	return &ProcessTrialsUseCase{
+		repo:      repo,
+		plans:     plans,
+		flags:     flags,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute processes the running trials that end within the largest reminder
+// offset. A failure on one tenant is counted in the report and does not stop
+// the run.
+func (uc *ProcessTrialsUseCase) Execute(ctx context.Context, cmd ProcessTrialsCommand) (*ProcessTrialsReport, error) {
+	var horizon time.Duration
+	for _, offset := range cmd.ReminderOffsets {
+		if offset <= 0 {
+			return nil, fmt.Errorf("trial reminder offset must be positive, got %s", offset)
+		}
+		if offset > horizon {
+			horizon = offset
+		}
+	}
+
+	now := time.Now()
+	tenants, err := uc.repo.ListTrialsEndingBefore(ctx, now.Add(horizon), cmd.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trials: %w", err)
+	}
+
+	report := &ProcessTrialsReport{}
+	for _, tenant := range tenants {
+		if tenant.TrialExpired(now) {
+			outcome, err := uc.expire(ctx, tenant, now)
+			if err != nil {
+				report.Errors++
+				uc.logger.Warn("Failed to expire tenant trial",
+					zap.String("tenant_id", tenant.TenantID.String()),
+					zap.Error(err),
+				)
+				continue
+			}
+			if outcome == domain.TrialSuspended {
+				report.Suspended++
+			} else {
+				report.Downgraded++
+			}
+			continue
+		}
+
+		offset, due := tenant.TrialReminderDue(cmd.ReminderOffsets, now)
+		if !due {
+			continue
+		}
+		if err := uc.remind(ctx, tenant, now); err != nil {
+			report.Errors++
+			uc.logger.Warn("Failed to send tenant trial reminder",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Duration("offset", offset),
+				zap.Error(err),
+			)
+			continue
+		}
+		report.Reminded++
+	}
+
+	return report, nil
+}
+
+// expire ends an expired trial, saves the tenant and publishes the outcome,
+// with the tenant's features when a downgrade changed them. The tenant is
+// re-read under its row lock, so the trial ends from the tenant's latest
+// state: one converted or changed since the run listed it is not
+// overwritten.
+func (uc *ProcessTrialsUseCase) expire(ctx context.Context, listed *domain.Tenant, now time.Time) (domain.TrialOutcome, error) {
+	var tenant *domain.Tenant
+	var change *domain.PlanChange
+	var suspended bool
+	var featuresBefore, features []*domain.FeatureValue
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		tenant, err = uc.repo.GetByTenantIDForUpdate(ctx, listed.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant: %w", err)
+		}
+		if !tenant.HasActiveTrial() {
+			return domain.ErrNoActiveTrial
+		}
+
+		fallback, err := uc.plans.Get(ctx, tenant.Trial.FallbackPlan)
+		if err != nil {
+			return fmt.Errorf("failed to get trial fallback plan: %w", err)
+		}
+
+		registered, err := uc.flags.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list feature flags: %w", err)
+		}
+
+		before := tenant.Snapshot()
+		featuresBefore = tenant.EvaluateFeatures(registered)
+		wasActive := tenant.IsActive()
+
+		if err := tenant.ExpireTrial(fallback, now); err != nil {
+			return err
+		}
+
+		if changes := tenant.PendingPlanChanges(); len(changes) > 0 {
+			change = changes[len(changes)-1]
+		}
+		suspended = wasActive && tenant.IsSuspended()
+		features = tenant.EvaluateFeatures(registered)
+
+		if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantTrialExpired, tenant, before); err != nil {
+			return fmt.Errorf("failed to update tenant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	uc.logger.Info("Tenant trial expired",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.String("outcome", string(tenant.Trial.Outcome)),
+		zap.String("plan", string(tenant.PlanTier)),
+	)
+
+	// Publish events (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantTrialEnded(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.trial.expired event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+		if change != nil {
+			if err := uc.publisher.PublishTenantPlanChanged(publishCtx, tenant, change); err != nil {
+				uc.logger.Error("Failed to publish tenant.plan.changed event",
+					zap.String("tenant_id", tenant.TenantID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+		if suspended {
+			if err := uc.publisher.PublishTenantSuspended(publishCtx, tenant); err != nil {
+				uc.logger.Error("Failed to publish tenant.suspended event",
+					zap.String("tenant_id", tenant.TenantID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+	}()
+	if !domain.SameFeatures(featuresBefore, features) {
+		publishFeaturesChanged(uc.publisher, uc.logger, tenant, features)
+	}
+
+	return tenant.Trial.Outcome, nil
+}
+
+// remind records and publishes a trial expiry reminder. Reminders are not
+// audited; the trial's reminder timestamp is bookkeeping, and is the only
+// column written, so changes made since the run listed the tenant are kept.
+func (uc *ProcessTrialsUseCase) remind(ctx context.Context, tenant *domain.Tenant, now time.Time) error {
+	tenant.MarkTrialReminded(now)
+	if err := uc.repo.UpdateTrialReminder(ctx, tenant); err != nil {
+		return fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	remaining := tenant.Trial.Remaining(now)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantTrialReminder(publishCtx, tenant, remaining); err != nil {
+			uc.logger.Error("Failed to publish tenant.trial.reminder event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// listedTrial copies a tenant on trial as a trial run lists it
+func listedTrial(tenant *domain.Tenant) *domain.Tenant {
+	listed := *tenant
+	trial := *tenant.Trial
+	listed.Trial = &trial
+	return &listed
+}
+
+func TestProcessTrials_ReminderKeepsChangesMadeSinceListing(t *testing.T) {
+	ctx := context.Background()
+	tenant := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, tenant.StartTrial(testPlans[domain.PlanFree], 7*24*time.Hour, domain.TrialExpiryDowngrade, time.Now().Add(-6*24*time.Hour)))
+
+	tenants := newFakeTenantRepo(tenant)
+	tenants.trials = []*domain.Tenant{listedTrial(tenant)}
+
+	// The tenant is renamed after the run listed it
+	renamed := tenants.get(tenant.TenantID)
+	renamed.TenantName = "Renamed Company"
+	require.NoError(t, tenants.Update(ctx, &renamed))
+
+	publisher := &fakePublisher{}
+	uc := NewProcessTrialsUseCase(tenants, newTestCatalog(testPlans[domain.PlanFree]), &fakeFlagRepo{}, &fakeTx{}, &fakeAuditRepo{}, publisher, zap.NewNop())
+
+	report, err := uc.Execute(ctx, ProcessTrialsCommand{ReminderOffsets: []time.Duration{3 * 24 * time.Hour}, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Reminded)
+	assert.Zero(t, report.Errors)
+
+	stored := tenants.get(tenant.TenantID)
+	assert.Equal(t, "Renamed Company", stored.TenantName)
+	require.NotNil(t, stored.Trial.RemindedAt)
+	assert.Eventually(t, func() bool { return len(publisher.published()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"tenant.trial.reminder"}, publisher.published())
+}
+
+func TestProcessTrials_ReminderForEndedTrialFails(t *testing.T) {
+	ctx := context.Background()
+	tenant := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, tenant.StartTrial(testPlans[domain.PlanFree], 7*24*time.Hour, domain.TrialExpiryDowngrade, time.Now().Add(-6*24*time.Hour)))
+
+	tenants := newFakeTenantRepo(tenant)
+	tenants.trials = []*domain.Tenant{listedTrial(tenant)}
+
+	// The trial is converted after the run listed it
+	converted := tenants.get(tenant.TenantID)
+	require.NoError(t, converted.ChangePlan(testPlans[domain.PlanEnterprise]))
+	require.NoError(t, tenants.Update(ctx, &converted))
+
+	uc := NewProcessTrialsUseCase(tenants, newTestCatalog(testPlans[domain.PlanFree]), &fakeFlagRepo{}, &fakeTx{}, &fakeAuditRepo{}, &fakePublisher{}, zap.NewNop())
+
+	report, err := uc.Execute(ctx, ProcessTrialsCommand{ReminderOffsets: []time.Duration{3 * 24 * time.Hour}, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, report.Reminded)
+	assert.Equal(t, 1, report.Errors)
+
+	stored := tenants.get(tenant.TenantID)
+	assert.Nil(t, stored.Trial.RemindedAt)
+	assert.NotNil(t, stored.Trial.EndedAt)
+}
+
+func TestProcessTrials_ExpiryKeepsChangesMadeSinceListing(t *testing.T) {
+	ctx := context.Background()
+	tenant := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, tenant.StartTrial(testPlans[domain.PlanFree], 7*24*time.Hour, domain.TrialExpiryDowngrade, time.Now().Add(-8*24*time.Hour)))
+
+	tenants := newFakeTenantRepo(tenant)
+	tenants.trials = []*domain.Tenant{listedTrial(tenant)}
+
+	// The tenant is renamed after the run listed it
+	renamed := tenants.get(tenant.TenantID)
+	renamed.TenantName = "Renamed Company"
+	require.NoError(t, tenants.Update(ctx, &renamed))
+
+	uc := NewProcessTrialsUseCase(tenants, newTestCatalog(testPlans[domain.PlanFree]), &fakeFlagRepo{}, &fakeTx{stores: []fakeStore{tenants}}, &fakeAuditRepo{}, &fakePublisher{}, zap.NewNop())
+
+	report, err := uc.Execute(ctx, ProcessTrialsCommand{ReminderOffsets: []time.Duration{3 * 24 * time.Hour}, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Downgraded)
+	assert.Zero(t, report.Errors)
+
+	stored := tenants.get(tenant.TenantID)
+	assert.Equal(t, "Renamed Company", stored.TenantName)
+	assert.Equal(t, domain.PlanFree, stored.PlanTier)
+	assert.Equal(t, domain.TrialDowngraded, stored.Trial.Outcome)
+}
+
+func TestProcessTrials_ExpiryOfConvertedTrialFails(t *testing.T) {
+	ctx := context.Background()
+	tenant := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, tenant.StartTrial(testPlans[domain.PlanFree], 7*24*time.Hour, domain.TrialExpiryDowngrade, time.Now().Add(-8*24*time.Hour)))
+
+	tenants := newFakeTenantRepo(tenant)
+	tenants.trials = []*domain.Tenant{listedTrial(tenant)}
+
+	// The trial is converted after the run listed it
+	converted := tenants.get(tenant.TenantID)
+	converted.Trial = listedTrial(tenant).Trial
+	require.NoError(t, converted.ChangePlan(testPlans[domain.PlanEnterprise]))
+	require.NoError(t, tenants.Update(ctx, &converted))
+
+	uc := NewProcessTrialsUseCase(tenants, newTestCatalog(testPlans[domain.PlanFree]), &fakeFlagRepo{}, &fakeTx{stores: []fakeStore{tenants}}, &fakeAuditRepo{}, &fakePublisher{}, zap.NewNop())
+
+	report, err := uc.Execute(ctx, ProcessTrialsCommand{ReminderOffsets: []time.Duration{3 * 24 * time.Hour}, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, report.Downgraded)
+	assert.Equal(t, 1, report.Errors)
+
+	stored := tenants.get(tenant.TenantID)
+	assert.Equal(t, domain.PlanEnterprise, stored.PlanTier)
+	assert.Equal(t, domain.TrialConverted, stored.Trial.Outcome)
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PurgeTenantsCommand represents the input for a purge run
+type PurgeTenantsCommand struct {
+	// Retention is how long a tenant stays deleted before it is purged
+	Retention time.Duration
+	// Limit caps how many tenants a single run purges
+	Limit int
+	// DryRun reports the tenants that would be purged without changing anything
+	DryRun bool
+}
+
+// PurgeCandidate is a deleted tenant selected by a purge run
+type PurgeCandidate struct {
+	TenantID  uuid.UUID
+	Slug      string
+	Schema    string
+	DeletedAt time.Time
+	// Error is set when purging this tenant failed
+	Error error
+}
+
+// PurgeReport summarizes a purge run
+type PurgeReport struct {
+	DryRun     bool
+	Cutoff     time.Time
+	Candidates []*PurgeCandidate
+	Purged     int
+	Failed     int
+}
+
+// PurgeTenantsUseCase drops the schemas of tenants deleted longer than the
+// retention period and scrubs their personal data from the registry
+type PurgeTenantsUseCase struct {
+	repo        domain.TenantRepository
+	archives    domain.ArchiveRepository
+	domains     domain.CustomDomainRepository
+	invitations domain.InvitationRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	provisioner SchemaProvisioner
+	blobs       BlobStore
+	publisher   EventPublisher
+	logger      *zap.Logger
+}
+
+// NewPurgeTenantsUseCase creates a new PurgeTenantsUseCase
+func NewPurgeTenantsUseCase(
+	repo domain.TenantRepository,
+	archives domain.ArchiveRepository,
+	domains domain.CustomDomainRepository,
+	invitations domain.InvitationRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	provisioner SchemaProvisioner,
+	blobs BlobStore,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *PurgeTenantsUseCase {
+	return &PurgeTenantsUseCase{
+		repo:        repo,
+		archives:    archives,
+		domains:     domains,
+		invitations: invitations,
+		tx:          tx,
+		audit:       audit,
+		provisioner: provisioner,
+		blobs:       blobs,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// Execute executes a purge run. A failure to purge one tenant is recorded in
+// the report and does not stop the run.
+func (uc *PurgeTenantsUseCase) Execute(ctx context.Context, cmd PurgeTenantsCommand) (*PurgeReport, error) {
+	if cmd.Retention <= 0 {
+		return nil, fmt.Errorf("purge retention must be positive, got %s", cmd.Retention)
+	}
+	if cmd.Limit <= 0 {
+		return nil, fmt.Errorf("purge limit must be positive, got %d", cmd.Limit)
+	}
+
+	report := &PurgeReport{
+		DryRun: cmd.DryRun,
+		Cutoff: time.Now().Add(-cmd.Retention),
+	}
+
+	tenants, err := uc.repo.ListPurgeable(ctx, report.Cutoff, cmd.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list purgeable tenants: %w", err)
+	}
+
+	for _, tenant := range tenants {
+		candidate := &PurgeCandidate{
+			TenantID: tenant.TenantID,
+			Slug:     tenant.TenantSlug,
+			Schema:   tenant.DatabaseSchema,
+		}
+		if tenant.DeletedAt != nil {
+			candidate.DeletedAt = *tenant.DeletedAt
+		}
+		report.Candidates = append(report.Candidates, candidate)
+
+		if cmd.DryRun {
+			continue
+		}
+
+		if err := uc.purge(ctx, tenant); err != nil {
+			candidate.Error = err
+			report.Failed++
+			uc.logger.Error("Failed to purge tenant",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		report.Purged++
+	}
+
+	return report, nil
+}
+
+// purge drops a tenant's schema and archives, then scrubs the registry row.
+// It holds the tenant's deletion lock from the re-read to the scrub, so a
+// restore cannot bring the tenant back while its schema is dropped. Every
+// step is idempotent, so a tenant that failed halfway is picked up again by
+// the next run.
+func (uc *PurgeTenantsUseCase) purge(ctx context.Context, listed *domain.Tenant) error {
+	var tenant *domain.Tenant
+	var archives []*domain.TenantArchive
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockDeletion(ctx, listed.TenantID); err != nil {
+			return err
+		}
+
+		// Re-read the tenant so one restored since it was listed is left alone
+		var err error
+		tenant, err = uc.repo.GetByTenantID(ctx, listed.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant: %w", err)
+		}
+		if !tenant.IsDeleted() || tenant.IsPurged() {
+			return fmt.Errorf("tenant is no longer purgeable (status %s)", tenant.Status)
+		}
+
+		// Drop schema
+		if err := uc.provisioner.DeProvisionTenant(ctx, tenant.TenantID); err != nil {
+			return fmt.Errorf("failed to drop schema: %w", err)
+		}
+
+		// Delete archives left over from an earlier archive
+		archives, err = uc.archives.ListByTenant(ctx, tenant.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to list archives: %w", err)
+		}
+		for _, archive := range archives {
+			if err := uc.blobs.Delete(ctx, archive.Location); err != nil {
+				return fmt.Errorf("failed to delete archive %s: %w", archive.Location, err)
+			}
+		}
+
+		// Scrub personal data
+		if err := tenant.Purge(); err != nil {
+			return fmt.Errorf("failed to purge tenant: %w", err)
+		}
+		tenant.UpdatedBy = actor.FromContext(ctx).UUID()
+
+		// The audit event carries no diff: the scrubbed values must not be
+		// copied into the audit log
+		tenantID := tenant.TenantID
+		event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(domain.AuditTenantPurged, &tenantID, nil, nil))
+
+		// Release the former slugs so that other tenants can claim them
+		// after the cooldown
+		aliases, err := uc.repo.ListSlugAliases(ctx, tenant.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to list slug aliases: %w", err)
+		}
+
+		// Revoke the custom domains so that they stop resolving and can be
+		// registered by other tenants
+		domains, err := uc.domains.ListByTenant(ctx, tenant.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to list custom domains: %w", err)
+		}
+
+		if err := uc.repo.Update(ctx, tenant); err != nil {
+			return fmt.Errorf("failed to update tenant: %w", err)
+		}
+		for _, alias := range aliases {
+			if alias.Release() != nil {
+				continue
+			}
+			if err := uc.repo.UpdateSlugAlias(ctx, alias); err != nil {
+				return fmt.Errorf("failed to update tenant: %w", err)
+			}
+		}
+		for _, d := range domains {
+			previous := d.Status
+			if d.Revoke() != nil {
+				continue
+			}
+			if err := uc.domains.Update(ctx, d, previous); err != nil {
+				return fmt.Errorf("failed to update tenant: %w", err)
+			}
+		}
+		// Invitations hold the invitees' email addresses; the registry row
+		// is kept, so the cascade from the tenant never removes them
+		if _, err := uc.invitations.DeleteByTenant(ctx, tenant.TenantID); err != nil {
+			return fmt.Errorf("failed to update tenant: %w", err)
+		}
+		if err := uc.audit.Record(ctx, event); err != nil {
+			return fmt.Errorf("failed to update tenant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish event (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantPurged(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.purged event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	uc.logger.Warn("Tenant purged",
+		zap.String("tenant_id", tenant.TenantID.String()),
+		zap.Int("archives_deleted", len(archives)),
+	)
+
+	return nil
+}
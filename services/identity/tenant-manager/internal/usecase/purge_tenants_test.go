@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// deletedTenant returns an active tenant deleted at deletedAt
+func deletedTenant(t *testing.T, deletedAt time.Time) *domain.Tenant {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, tenant.Delete())
+	tenant.DeletedAt = &deletedAt
+	return tenant
+}
+
+func TestPurgeTenants_DropsSchemaUnderDeletionLock(t *testing.T) {
+	ctx := context.Background()
+	tenant := deletedTenant(t, time.Now().Add(-60*24*time.Hour))
+	tenants := newFakeTenantRepo(tenant)
+	provisioner := &fakeProvisioner{}
+
+	uc := NewPurgeTenantsUseCase(tenants, &fakeArchiveRepo{}, newFakeDomainRepo(), newFakeInvitationRepo(), &fakeTx{stores: []fakeStore{tenants}}, &fakeAuditRepo{}, provisioner, nil, &fakePublisher{}, zap.NewNop())
+
+	report, err := uc.Execute(ctx, PurgeTenantsCommand{Retention: 30 * 24 * time.Hour, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Purged)
+	assert.Zero(t, report.Failed)
+
+	assert.True(t, provisioner.dropped[tenant.TenantID])
+	stored := tenants.get(tenant.TenantID)
+	assert.True(t, stored.IsPurged())
+	assert.Empty(t, stored.PrimaryContactEmail)
+}
+
+func TestRestoreTenant_IsNotPurgedAfterwards(t *testing.T) {
+	ctx := context.Background()
+	tenant := deletedTenant(t, time.Now().Add(-60*24*time.Hour))
+	tenants := newFakeTenantRepo(tenant)
+	provisioner := &fakeProvisioner{}
+	tx := &fakeTx{stores: []fakeStore{tenants}}
+
+	// The purge run lists the tenant before it is restored
+	listed, err := tenants.ListPurgeable(ctx, time.Now().Add(-30*24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	restore := NewRestoreTenantUseCase(tenants, &fakeArchiveRepo{}, tx, &fakeAuditRepo{}, provisioner, &fakePublisher{}, 90*24*time.Hour, zap.NewNop())
+	restored, err := restore.Execute(ctx, RestoreTenantCommand{TenantID: tenant.TenantID, Reason: "Deleted by mistake"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusSuspended, restored.Status)
+
+	purge := NewPurgeTenantsUseCase(tenants, &fakeArchiveRepo{}, newFakeDomainRepo(), newFakeInvitationRepo(), tx, &fakeAuditRepo{}, provisioner, nil, &fakePublisher{}, zap.NewNop())
+	assert.Error(t, purge.purge(ctx, listed[0]))
+
+	assert.False(t, provisioner.dropped[tenant.TenantID])
+	stored := tenants.get(tenant.TenantID)
+	assert.Equal(t, domain.StatusSuspended, stored.Status)
+	assert.False(t, stored.IsPurged())
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReleaseEntitlementCommand represents the input for releasing consumed usage
+type ReleaseEntitlementCommand struct {
+	TenantID uuid.UUID
+	Name     string
+	Amount   int64
+}
+
+// ReleaseEntitlementUseCase gives back usage of an entitlement, for example
+// when a user is removed or a consumption is rolled back
+type ReleaseEntitlementUseCase struct {
+	repo         domain.TenantRepository
+	entitlements domain.EntitlementRepository
+	resolver     entitlementResolver
+	tx           Transactor
+	logger       *zap.Logger
+}
+
+// NewReleaseEntitlementUseCase creates a new ReleaseEntitlementUseCase
+func NewReleaseEntitlementUseCase(
+	repo domain.TenantRepository,
+	plans *PlanCatalog,
+	entitlements domain.EntitlementRepository,
+	members domain.MemberRepository,
+	storage domain.StorageRepository,
+	tx Transactor,
+	logger *zap.Logger,
+) *ReleaseEntitlementUseCase {
+	return &ReleaseEntitlementUseCase{
+		repo:         repo,
+		entitlements: entitlements,
+		resolver:     entitlementResolver{plans: plans, entitlements: entitlements, members: members, storage: storage},
+		tx:           tx,
+		logger:       logger,
+	}
+}
+
+// Execute executes the release entitlement use case. At most the usage of
+// the current window is released, newest bucket first, so usage never drops
+// below zero. Seats are freed by removing members, so max_users is refused.
+func (uc *ReleaseEntitlementUseCase) Execute(ctx context.Context, cmd ReleaseEntitlementCommand) (*domain.EntitlementUsage, error) {
+	if cmd.Amount <= 0 {
+		return nil, domain.ErrInvalidEntitlementAmount
+	}
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	limit, err := uc.resolver.resolve(ctx, tenant, cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+	if limit.Measured() {
+		return nil, domain.ErrEntitlementNotCounted
+	}
+
+	var usage *domain.EntitlementUsage
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.entitlements.LockUsage(ctx, tenant.TenantID, limit.Name); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		usage, err = uc.resolver.usage(ctx, tenant, limit, now)
+		if err != nil {
+			return err
+		}
+
+		buckets, err := uc.entitlements.ListUsage(ctx, tenant.TenantID, limit.Name, limit.WindowStart(now))
+		if err != nil {
+			return err
+		}
+
+		// Take the release out of the buckets still inside the window
+		for _, delta := range domain.ReleaseFromBuckets(buckets, cmd.Amount) {
+			if err := uc.entitlements.AddUsage(ctx, tenant.TenantID, limit.Name, delta.Start, delta.Used); err != nil {
+				return err
+			}
+			usage.Used += delta.Used
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to release entitlement: %w", err)
+	}
+
+	uc.logger.Debug("Entitlement released",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("entitlement", limit.Name),
+		zap.Int64("amount", cmd.Amount),
+		zap.Int64("used", usage.Used),
+	)
+
+	return usage, nil
+}
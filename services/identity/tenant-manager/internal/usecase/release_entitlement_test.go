@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReleaseEntitlement_RollingWindowReleasesNewestBucketsFirst(t *testing.T) {
+	plan, err := domain.NewPlan("metered", "Metered", "", 10, 10, nil, 0)
+	require.NoError(t, err)
+	limit, err := domain.NewEntitlementLimit("api_calls", 100, domain.WindowRolling, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, plan.AddEntitlement(limit))
+
+	tenant := newActiveTenant(domain.PlanFree)
+	tenant.PlanTier = plan.Tier
+
+	entitlements := newFakeEntitlementRepo()
+	now := time.Now()
+	older := limit.Bucket(now.Add(-40 * time.Minute))
+	newer := limit.Bucket(now.Add(-10 * time.Minute))
+	ctx := context.Background()
+	require.NoError(t, entitlements.AddUsage(ctx, tenant.TenantID, "api_calls", older, 10))
+	require.NoError(t, entitlements.AddUsage(ctx, tenant.TenantID, "api_calls", newer, 3))
+
+	uc := NewReleaseEntitlementUseCase(newFakeTenantRepo(tenant), newTestCatalog(plan), entitlements, newFakeMemberRepo(), &fakeStorageRepo{}, &fakeTx{}, zap.NewNop())
+
+	usage, err := uc.Execute(ctx, ReleaseEntitlementCommand{TenantID: tenant.TenantID, Name: "api_calls", Amount: 5})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), usage.Used)
+
+	buckets := entitlements.usage[tenant.TenantID.String()+":api_calls"]
+	assert.Equal(t, int64(0), buckets[newer])
+	assert.Equal(t, int64(8), buckets[older])
+	assert.NotContains(t, buckets, limit.Bucket(now), "no negative bucket is opened for the release")
+
+	// Once the older bucket ages out, the usage left is zero, not negative
+	used, err := entitlements.SumUsage(ctx, tenant.TenantID, "api_calls", older.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), used)
+
+	// Releasing more than was consumed stops at zero
+	usage, err = uc.Execute(ctx, ReleaseEntitlementCommand{TenantID: tenant.TenantID, Name: "api_calls", Amount: 50})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage.Used)
+	for _, n := range buckets {
+		assert.GreaterOrEqual(t, n, int64(0))
+	}
+}
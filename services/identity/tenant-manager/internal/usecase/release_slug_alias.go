@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReleaseSlugAliasCommand represents the input for releasing a slug alias
+type ReleaseSlugAliasCommand struct {
+	TenantID uuid.UUID
+	Slug     string
+}
+
+// ReleaseSlugAliasUseCase stops a former slug from resolving to its tenant,
+// so that another tenant can claim it after the cooldown
+type ReleaseSlugAliasUseCase struct {
+	repo   domain.TenantRepository
+	tx     Transactor
+	audit  domain.AuditRepository
+	logger *zap.Logger
+}
+
+// NewReleaseSlugAliasUseCase creates a new ReleaseSlugAliasUseCase
+func NewReleaseSlugAliasUseCase(
+	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *ReleaseSlugAliasUseCase {
+	return &ReleaseSlugAliasUseCase{
+		repo:   repo,
+		tx:     tx,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// Execute executes the release slug alias use case
+func (uc *ReleaseSlugAliasUseCase) Execute(ctx context.Context, cmd ReleaseSlugAliasCommand) (*domain.SlugAlias, error) {
+	alias, err := uc.repo.GetSlugAlias(ctx, cmd.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slug alias: %w", err)
+	}
+	// Another tenant's alias is reported as missing
+	if alias.TenantID != cmd.TenantID {
+		return nil, domain.ErrSlugAliasNotFound
+	}
+
+	if err := alias.Release(); err != nil {
+		return nil, err
+	}
+
+	tenantID := cmd.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantSlugAliasReleased, &tenantID,
+		map[string]interface{}{"slug_alias": alias.Slug}, nil,
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.UpdateSlugAlias(ctx, alias); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to release slug alias",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("slug", cmd.Slug),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to release slug alias: %w", err)
+	}
+
+	uc.logger.Info("Slug alias released",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("slug", cmd.Slug),
+	)
+
+	return alias, nil
+}
+
+// List retrieves the slug aliases of a tenant, released or not, oldest first
+func (uc *ReleaseSlugAliasUseCase) List(ctx context.Context, tenantID uuid.UUID) ([]*domain.SlugAlias, error) {
+	// Distinguish an unknown tenant from one without aliases
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	aliases, err := uc.repo.ListSlugAliases(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list slug aliases: %w", err)
+	}
+
+	return aliases, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RemoveEntitlementLimitUseCase removes a tenant's own limit of an
+// entitlement, so the limit of its plan applies again
+type RemoveEntitlementLimitUseCase struct {
+	repo         domain.TenantRepository
+	entitlements domain.EntitlementRepository
+	tx           Transactor
+	audit        domain.AuditRepository
+	logger       *zap.Logger
+}
+
+// NewRemoveEntitlementLimitUseCase creates a new RemoveEntitlementLimitUseCase
+func NewRemoveEntitlementLimitUseCase(
+	repo domain.TenantRepository,
+	entitlements domain.EntitlementRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *RemoveEntitlementLimitUseCase {
+	return &RemoveEntitlementLimitUseCase{
+		repo:         repo,
+		entitlements: entitlements,
+		tx:           tx,
+		audit:        audit,
+		logger:       logger,
+	}
+}
+
+// Execute executes the remove entitlement limit use case
+func (uc *RemoveEntitlementLimitUseCase) Execute(ctx context.Context, tenantID uuid.UUID, name string) error {
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	own, err := uc.entitlements.ListTenantLimits(ctx, tenant.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant entitlement limits: %w", err)
+	}
+	var previous *domain.EntitlementLimit
+	for _, l := range own {
+		if l.Name == name {
+			previous = l
+		}
+	}
+	if previous == nil {
+		return domain.ErrEntitlementNotFound
+	}
+
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantEntitlementChanged, &tenantID,
+		previous.Snapshot(name), (*domain.EntitlementLimit)(nil).Snapshot(name),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.entitlements.DeleteTenantLimit(ctx, tenant.TenantID, name); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove entitlement limit: %w", err)
+	}
+
+	uc.logger.Info("Tenant entitlement limit removed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("entitlement", name),
+	)
+
+	return nil
+}
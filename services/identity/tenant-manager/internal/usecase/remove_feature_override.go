@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RemoveFeatureOverrideCommand represents the input for removing a tenant's
+// feature flag override
+type RemoveFeatureOverrideCommand struct {
+	TenantID uuid.UUID
+	Key      string
+}
+
+// RemoveFeatureOverrideUseCase returns a tenant's feature flag to the value
+// of its plan
+type RemoveFeatureOverrideUseCase struct {
+	repo      domain.TenantRepository
+	flags     domain.FeatureFlagRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewRemoveFeatureOverrideUseCase creates a new RemoveFeatureOverrideUseCase
+func NewRemoveFeatureOverrideUseCase(
+	repo domain.TenantRepository,
+	flags domain.FeatureFlagRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *RemoveFeatureOverrideUseCase {
+	return &RemoveFeatureOverrideUseCase{
+		repo:      repo,
+		flags:     flags,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the remove feature override use case and returns the
+// tenant's feature flags as evaluated afterwards
+func (uc *RemoveFeatureOverrideUseCase) Execute(ctx context.Context, cmd RemoveFeatureOverrideCommand) ([]*domain.FeatureValue, error) {
+	uc.logger.Info("Removing tenant feature override",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("feature", cmd.Key),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	before := tenant.Snapshot()
+
+	// Remove override
+	if err := tenant.RemoveFeatureOverride(cmd.Key); err != nil {
+		return nil, fmt.Errorf("failed to remove feature override: %w", err)
+	}
+
+	// Update tenant
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantFeaturesChanged, tenant, before); err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	features, err := evaluateFeatures(ctx, uc.flags, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	publishFeaturesChanged(uc.publisher, uc.logger, tenant, features)
+
+	uc.logger.Info("Tenant feature override removed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("feature", cmd.Key),
+	)
+
+	return features, nil
+}
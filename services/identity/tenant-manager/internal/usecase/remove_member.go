@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RemoveMemberCommand represents the input for removing a user from a tenant
+type RemoveMemberCommand struct {
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// RemoveMemberUseCase removes a user from a tenant, keeping its last admin
+type RemoveMemberUseCase struct {
+	repo      domain.TenantRepository
+	members   domain.MemberRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewRemoveMemberUseCase creates a new RemoveMemberUseCase
+func NewRemoveMemberUseCase(
+	repo domain.TenantRepository,
+	members domain.MemberRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{
+		repo:      repo,
+		members:   members,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the remove member use case. Removing a user's primary
+// membership makes their oldest remaining one primary.
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, cmd RemoveMemberCommand) (*domain.Member, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	a := actor.FromContext(ctx)
+
+	var member *domain.Member
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.members.Lock(ctx, cmd.TenantID); err != nil {
+			return err
+		}
+		if err := uc.members.LockUser(ctx, cmd.UserID); err != nil {
+			return err
+		}
+
+		m, err := uc.members.Get(ctx, cmd.TenantID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		admins, err := uc.members.CountActive(ctx, cmd.TenantID, domain.MemberAdmin)
+		if err != nil {
+			return err
+		}
+		if m.IsLastAdmin(admins) {
+			return domain.ErrLastTenantAdmin
+		}
+
+		now := time.Now()
+		before := m.Snapshot()
+		wasPrimary := m.IsPrimary
+		if err := m.Remove(now); err != nil {
+			return err
+		}
+		m.UpdatedBy = a.UUID()
+
+		if err := uc.members.Update(ctx, m); err != nil {
+			return err
+		}
+
+		if wasPrimary {
+			if err := uc.promoteOldestMembership(ctx, cmd.UserID, a.UUID(), now); err != nil {
+				return err
+			}
+		}
+
+		member = m
+		tenantID := cmd.TenantID
+		return uc.audit.Record(ctx, a.Stamp(domain.NewAuditEvent(
+			domain.AuditTenantMemberRemoved, &tenantID, before, m.Snapshot(),
+		)))
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrMemberNotFound) ||
+			errors.Is(err, domain.ErrLastTenantAdmin) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to remove tenant member",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("user_id", cmd.UserID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to remove tenant member: %w", err)
+	}
+
+	uc.logger.Info("Tenant member removed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("user_id", cmd.UserID.String()),
+	)
+
+	// Publish event (async)
+	go func() {
+		if err := uc.publisher.PublishTenantMemberRemoved(context.Background(), tenant, member); err != nil {
+			uc.logger.Error("Failed to publish tenant.member.removed event",
+				zap.String("tenant_id", cmd.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return member, nil
+}
+
+// promoteOldestMembership makes the user's oldest remaining membership, if
+// any, primary once their primary one was removed
+func (uc *RemoveMemberUseCase) promoteOldestMembership(ctx context.Context, userID uuid.UUID, by *uuid.UUID, now time.Time) error {
+	memberships, err := uc.members.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(memberships) == 0 || memberships[0].IsPrimary {
+		return nil
+	}
+
+	oldest := memberships[0]
+	if err := oldest.MakePrimary(now); err != nil {
+		return err
+	}
+	oldest.UpdatedBy = by
+
+	return uc.members.Update(ctx, oldest)
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRemoveMember_PromotesOldestRemainingMembership(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+
+	// The user belongs to three tenants, joined in order; each keeps an admin
+	var tenants []*domain.Tenant
+	var members []*domain.Member
+	for i := 0; i < 3; i++ {
+		tenant := newActiveTenant(domain.PlanProfessional)
+		tenants = append(tenants, tenant)
+
+		m, err := domain.NewMember(tenant.TenantID, userID, domain.MemberUser, now.Add(time.Duration(i)*time.Hour))
+		require.NoError(t, err)
+		admin, err := domain.NewMember(tenant.TenantID, uuid.New(), domain.MemberAdmin, now)
+		require.NoError(t, err)
+		members = append(members, m, admin)
+	}
+	members[0].IsPrimary = true
+
+	tenantRepo := newFakeTenantRepo(tenants...)
+	memberRepo := newFakeMemberRepo(members...)
+	uc := NewRemoveMemberUseCase(
+		tenantRepo, memberRepo, &fakeTx{stores: []fakeStore{memberRepo}},
+		&fakeAuditRepo{}, &fakePublisher{}, zap.NewNop(),
+	)
+
+	_, err := uc.Execute(context.Background(), RemoveMemberCommand{TenantID: tenants[0].TenantID, UserID: userID})
+	require.NoError(t, err)
+
+	memberships, err := memberRepo.ListByUser(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, memberships, 2)
+	assert.Equal(t, tenants[1].TenantID, memberships[0].TenantID)
+	assert.True(t, memberships[0].IsPrimary)
+	assert.False(t, memberships[1].IsPrimary)
+
+	// Removing a membership that is not primary promotes nothing
+	_, err = uc.Execute(context.Background(), RemoveMemberCommand{TenantID: tenants[2].TenantID, UserID: userID})
+	require.NoError(t, err)
+
+	memberships, err = memberRepo.ListByUser(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	assert.True(t, memberships[0].IsPrimary)
+}
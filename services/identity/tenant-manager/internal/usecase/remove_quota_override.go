@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RemoveQuotaOverrideCommand represents the input for removing a quota override
+type RemoveQuotaOverrideCommand struct {
+	TenantID uuid.UUID
+	Name     domain.QuotaName
+}
+
+// RemoveQuotaOverrideUseCase returns a tenant quota to its plan default
+type RemoveQuotaOverrideUseCase struct {
+	repo      domain.TenantRepository
+	members   domain.MemberRepository
+	usage     domain.UsageRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewRemoveQuotaOverrideUseCase creates a new RemoveQuotaOverrideUseCase
+func NewRemoveQuotaOverrideUseCase(
+	repo domain.TenantRepository,
+	members domain.MemberRepository,
+	usage domain.UsageRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *RemoveQuotaOverrideUseCase {
+	return &RemoveQuotaOverrideUseCase{
+		repo:      repo,
+		members:   members,
+		usage:     usage,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the remove quota override use case. Removing an override
+// that raised a quota is refused when current usage exceeds the plan default.
+func (uc *RemoveQuotaOverrideUseCase) Execute(ctx context.Context, cmd RemoveQuotaOverrideCommand) (*domain.Tenant, error) {
+	uc.logger.Info("Removing tenant quota override",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("quota", string(cmd.Name)),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	before := tenant.Snapshot()
+	previous := tenant.EffectiveQuota(cmd.Name, time.Now()).Effective
+
+	// Remove override
+	if err := tenant.RemoveQuotaOverride(cmd.Name); err != nil {
+		return nil, fmt.Errorf("failed to remove quota override: %w", err)
+	}
+
+	// Update tenant, validating a decrease against current usage
+	if tenant.EffectiveQuota(cmd.Name, time.Now()).Effective < previous {
+		err = saveTenantWithinUsage(ctx, uc.tx, uc.members, uc.usage, uc.repo, uc.audit, domain.AuditTenantQuotaChanged, tenant, before)
+	} else {
+		err = saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantQuotaChanged, tenant, before)
+	}
+	if errors.Is(err, domain.ErrPlanLimitExceeded) {
+		return nil, err
+	}
+	if err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	publishQuotaChanged(uc.publisher, uc.logger, tenant)
+
+	uc.logger.Info("Tenant quota override removed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("quota", string(cmd.Name)),
+	)
+
+	return tenant, nil
+}
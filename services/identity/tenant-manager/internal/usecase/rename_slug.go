@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RenameSlugCommand represents the input for renaming a tenant slug
+type RenameSlugCommand struct {
+	TenantID uuid.UUID
+	Slug     string
+}
+
+// RenameSlugUseCase changes the slug of a tenant. The former slug becomes an
+// alias that keeps resolving to the tenant.
+type RenameSlugUseCase struct {
+	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	cooldown  time.Duration
+	logger    *zap.Logger
+}
+
+// NewRenameSlugUseCase creates a new RenameSlugUseCase. A released alias
+// can be claimed by another tenant once cooldown has passed.
+func NewRenameSlugUseCase(
+	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	cooldown time.Duration,
+	logger *zap.Logger,
+) *RenameSlugUseCase {
+	return &RenameSlugUseCase{
+		repo:      repo,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		cooldown:  cooldown,
+		logger:    logger,
+	}
+}
+
+// Execute executes the rename slug use case
+func (uc *RenameSlugUseCase) Execute(ctx context.Context, cmd RenameSlugCommand) (*domain.Tenant, error) {
+	uc.logger.Info("Renaming tenant slug",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("slug", cmd.Slug),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	before := tenant.Snapshot()
+	previousSlug := tenant.TenantSlug
+
+	// Rename
+	if err := tenant.RenameSlug(cmd.Slug); err != nil {
+		return nil, fmt.Errorf("failed to rename slug: %w", err)
+	}
+
+	if err := checkSlugAvailable(ctx, uc.repo, tenant.TenantID, cmd.Slug, uc.cooldown); err != nil {
+		return nil, err
+	}
+
+	// Update tenant
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantSlugChanged, tenant, before); err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	// Publish event (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantSlugChanged(publishCtx, tenant, previousSlug); err != nil {
+			uc.logger.Error("Failed to publish tenant.slug.changed event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	uc.logger.Info("Tenant slug renamed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("from", previousSlug),
+		zap.String("to", tenant.TenantSlug),
+	)
+
+	return tenant, nil
+}
+
+// checkSlugAvailable checks that a tenant may take a slug: no other tenant
+// has it, and it is not an alias of another tenant, unless that alias was
+// released longer than cooldown ago. Pass uuid.Nil for a new tenant.
+func checkSlugAvailable(ctx context.Context, repo domain.TenantRepository, tenantID uuid.UUID, slug string, cooldown time.Duration) error {
+	exists, err := repo.ExistsBySlug(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("failed to check slug: %w", err)
+	}
+	if exists {
+		return domain.ErrSlugAlreadyExists
+	}
+
+	alias, err := repo.GetSlugAlias(ctx, slug)
+	if errors.Is(err, domain.ErrSlugAliasNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check slug alias: %w", err)
+	}
+
+	return alias.CheckClaimable(tenantID, cooldown, time.Now())
+}
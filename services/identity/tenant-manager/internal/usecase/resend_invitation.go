@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/pkg/invitetoken"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ResendInvitationCommand represents the input for resending an invitation
+type ResendInvitationCommand struct {
+	TenantID     uuid.UUID
+	InvitationID uuid.UUID
+}
+
+// ResendInvitationUseCase mails a pending invitation again with a new link
+type ResendInvitationUseCase struct {
+	repo        domain.TenantRepository
+	invitations domain.InvitationRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	mailer      *invitationMailer
+	ttl         time.Duration
+	logger      *zap.Logger
+}
+
+// NewResendInvitationUseCase creates a new ResendInvitationUseCase
+func NewResendInvitationUseCase(
+	repo domain.TenantRepository,
+	invitations domain.InvitationRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	signer *invitetoken.Signer,
+	sender MailSender,
+	ttl time.Duration,
+	acceptURL string,
+	logger *zap.Logger,
+) *ResendInvitationUseCase {
+	return &ResendInvitationUseCase{
+		repo:        repo,
+		invitations: invitations,
+		tx:          tx,
+		audit:       audit,
+		mailer:      newInvitationMailer(signer, sender, acceptURL),
+		ttl:         ttl,
+		logger:      logger,
+	}
+}
+
+// Execute executes the resend invitation use case. The invitation, expired
+// or not, is renewed for another ttl under a new token, so links sent before
+// stop working.
+func (uc *ResendInvitationUseCase) Execute(ctx context.Context, cmd ResendInvitationCommand) (*domain.Invitation, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	inv, err := uc.invitations.GetByID(ctx, cmd.InvitationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	// Another tenant's invitation is reported as missing
+	if inv.TenantID != cmd.TenantID {
+		return nil, domain.ErrInvitationNotFound
+	}
+
+	before := inv.Snapshot()
+	readHash := inv.TokenHash
+	if err := inv.Resend(uc.ttl, time.Now()); err != nil {
+		return nil, err
+	}
+
+	token, err := uc.mailer.issue(inv)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := cmd.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantInvitationResent, &tenantID, before, inv.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.invitations.Update(ctx, inv, readHash); err != nil {
+			return err
+		}
+		if err := uc.audit.Record(ctx, event); err != nil {
+			return err
+		}
+		return uc.mailer.send(ctx, tenant, inv, token)
+	})
+	if err != nil {
+		// The invitation was accepted, revoked or resent since it was read
+		if errors.Is(err, domain.ErrInvitationNotPending) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to resend invitation",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("invitation_id", cmd.InvitationID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to resend invitation: %w", err)
+	}
+
+	uc.logger.Info("Invitation resent",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("invitation_id", cmd.InvitationID.String()),
+		zap.Int("send_count", inv.SendCount),
+	)
+
+	return inv, nil
+}
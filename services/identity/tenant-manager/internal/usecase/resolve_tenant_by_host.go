@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ResolveTenantByHostUseCase finds the tenant a request host belongs to,
+// through the tenant's verified custom domains
+type ResolveTenantByHostUseCase struct {
+	repo    domain.TenantRepository
+	domains domain.CustomDomainRepository
+	logger  *zap.Logger
+}
+
+// NewResolveTenantByHostUseCase creates a new ResolveTenantByHostUseCase
+func NewResolveTenantByHostUseCase(repo domain.TenantRepository, domains domain.CustomDomainRepository, logger *zap.Logger) *ResolveTenantByHostUseCase {
+	return &ResolveTenantByHostUseCase{
+		repo:    repo,
+		domains: domains,
+		logger:  logger,
+	}
+}
+
+// Execute resolves a host, with or without a port. Pending, failed and
+// revoked domains do not resolve.
+func (uc *ResolveTenantByHostUseCase) Execute(ctx context.Context, host string) (*domain.Tenant, error) {
+	hostname, err := domain.NormalizeHostname(host)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := uc.domains.GetVerified(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom domain: %w", err)
+	}
+
+	tenant, err := uc.repo.GetByTenantID(ctx, d.TenantID)
+	if err != nil {
+		uc.logger.Error("Failed to get tenant of custom domain",
+			zap.String("hostname", hostname),
+			zap.String("tenant_id", d.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RestoreTenantCommand represents the input for restoring a deleted tenant
+type RestoreTenantCommand struct {
+	TenantID uuid.UUID
+	Reason   string
+}
+
+// RestoreTenantUseCase returns a soft-deleted tenant to its pre-deletion
+// status while it is still within the purge retention window
+type RestoreTenantUseCase struct {
+	repo        domain.TenantRepository
+	archives    domain.ArchiveRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	provisioner SchemaProvisioner
+	publisher   EventPublisher
+	retention   time.Duration
+	logger      *zap.Logger
+}
+
+// NewRestoreTenantUseCase creates a new RestoreTenantUseCase. retention is
+// the purge retention period: tenants deleted longer ago cannot be restored.
+func NewRestoreTenantUseCase(
+	repo domain.TenantRepository,
+	archives domain.ArchiveRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	provisioner SchemaProvisioner,
+	publisher EventPublisher,
+	retention time.Duration,
+	logger *zap.Logger,
+) *RestoreTenantUseCase {
+	return &RestoreTenantUseCase{
+		repo:        repo,
+		archives:    archives,
+		tx:          tx,
+		audit:       audit,
+		provisioner: provisioner,
+		publisher:   publisher,
+		retention:   retention,
+		logger:      logger,
+	}
+}
+
+// Execute executes the restore tenant use case
+func (uc *RestoreTenantUseCase) Execute(ctx context.Context, cmd RestoreTenantCommand) (*domain.Tenant, error) {
+	uc.logger.Info("Restoring tenant",
+		zap.String("tenant_id", cmd.TenantID.String()),
+	)
+
+	// Hold the tenant's deletion lock from the read to the save, so a purge
+	// cannot drop the schema between the check that it exists and the restore
+	var tenant *domain.Tenant
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockDeletion(ctx, cmd.TenantID); err != nil {
+			return err
+		}
+
+		// Get tenant
+		var err error
+		tenant, err = uc.repo.GetByTenantID(ctx, cmd.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant: %w", err)
+		}
+
+		if !tenant.IsDeleted() {
+			return domain.ErrTenantNotDeleted
+		}
+
+		if tenant.IsPurged() {
+			return domain.ErrTenantAlreadyPurged
+		}
+
+		// Check retention window
+		if tenant.DeletedAt != nil && time.Since(*tenant.DeletedAt) >= uc.retention {
+			return domain.ErrRestoreWindowExpired
+		}
+
+		// Find the status before deletion. Tenants deleted before the status
+		// history existed come back suspended, so an operator activates them.
+		history, err := uc.repo.ListStatusHistory(ctx, cmd.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get status history: %w", err)
+		}
+		previous := domain.StatusBeforeDeletion(history)
+		if previous == "" {
+			previous = domain.StatusSuspended
+		}
+
+		// The tenant data must still be there: the live schema, or the
+		// archive of an archived tenant
+		if err := uc.checkData(ctx, tenant.TenantID, previous); err != nil {
+			return err
+		}
+
+		before := tenant.Snapshot()
+
+		// Restore tenant
+		if err := tenant.Restore(previous, cmd.Reason); err != nil {
+			return fmt.Errorf("failed to restore tenant: %w", err)
+		}
+
+		// Update tenant
+		if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantRestored, tenant, before); err != nil {
+			uc.logger.Error("Failed to update tenant",
+				zap.String("tenant_id", cmd.TenantID.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to update tenant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish event (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantRestored(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.restored event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	uc.logger.Info("Tenant restored",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("status", string(tenant.Status)),
+	)
+
+	return tenant, nil
+}
+
+// checkData verifies that the data of a tenant restored to the given status
+// still exists
+func (uc *RestoreTenantUseCase) checkData(ctx context.Context, tenantID uuid.UUID, status domain.TenantStatus) error {
+	if status == domain.StatusArchived {
+		if _, err := uc.archives.GetLatest(ctx, tenantID); err != nil {
+			if errors.Is(err, domain.ErrArchiveNotFound) {
+				return domain.ErrTenantSchemaMissing
+			}
+			return fmt.Errorf("failed to get archive: %w", err)
+		}
+		return nil
+	}
+
+	exists, err := uc.provisioner.SchemaExists(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to check schema: %w", err)
+	}
+	if !exists {
+		return domain.ErrTenantSchemaMissing
+	}
+
+	return nil
+}
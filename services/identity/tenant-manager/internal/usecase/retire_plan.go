@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"go.uber.org/zap"
+)
+
+// RetirePlanUseCase stops a plan from being assigned. Tenants already on the
+// plan keep it until they change plans.
+type RetirePlanUseCase struct {
+	repo    domain.PlanRepository
+	catalog *PlanCatalog
+	logger  *zap.Logger
+}
+
+// NewRetirePlanUseCase creates a new RetirePlanUseCase
+func NewRetirePlanUseCase(repo domain.PlanRepository, catalog *PlanCatalog, logger *zap.Logger) *RetirePlanUseCase {
+	return &RetirePlanUseCase{
+		repo:    repo,
+		catalog: catalog,
+		logger:  logger,
+	}
+}
+
+// Execute executes the retire plan use case
+func (uc *RetirePlanUseCase) Execute(ctx context.Context, tier domain.PlanTier) (*domain.Plan, error) {
+	// Read through the repository: cached plans are shared and must not be modified
+	plan, err := uc.repo.GetByTier(ctx, tier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	if err := plan.Retire(); err != nil {
+		return nil, fmt.Errorf("failed to retire plan: %w", err)
+	}
+
+	if err := uc.repo.Update(ctx, plan); err != nil {
+		uc.logger.Error("Failed to update plan",
+			zap.String("plan", string(tier)),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update plan: %w", err)
+	}
+	uc.catalog.Invalidate()
+
+	uc.logger.Warn("Plan retired",
+		zap.String("plan", string(tier)),
+	)
+
+	return plan, nil
+}
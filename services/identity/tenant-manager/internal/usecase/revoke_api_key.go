@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RevokeAPIKeyUseCase handles API key revocation
+type RevokeAPIKeyUseCase struct {
+	repo   domain.ServiceAccountRepository
+	logger *zap.Logger
+}
+
+// NewRevokeAPIKeyUseCase creates a new RevokeAPIKeyUseCase
+func NewRevokeAPIKeyUseCase(repo domain.ServiceAccountRepository, logger *zap.Logger) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute executes the revoke API key use case
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, serviceAccountID, keyID uuid.UUID) error {
+	key, err := uc.repo.GetAPIKey(ctx, serviceAccountID, keyID)
+	if err != nil {
+		return fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if err := key.Revoke(); err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	if err := uc.repo.UpdateAPIKey(ctx, key); err != nil {
+		uc.logger.Error("Failed to update API key",
+			zap.String("key_id", keyID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update API key: %w", err)
+	}
+
+	uc.logger.Warn("API key revoked",
+		zap.String("service_account_id", serviceAccountID.String()),
+		zap.String("key_prefix", key.KeyPrefix),
+	)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RevokeCustomDomainCommand represents the input for revoking a custom domain
+type RevokeCustomDomainCommand struct {
+	TenantID uuid.UUID
+	DomainID uuid.UUID
+}
+
+// RevokeCustomDomainUseCase removes a custom domain from its tenant, so that
+// it stops resolving
+type RevokeCustomDomainUseCase struct {
+	repo      domain.TenantRepository
+	domains   domain.CustomDomainRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewRevokeCustomDomainUseCase creates a new RevokeCustomDomainUseCase
+func NewRevokeCustomDomainUseCase(
+	repo domain.TenantRepository,
+	domains domain.CustomDomainRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *RevokeCustomDomainUseCase {
+	return &RevokeCustomDomainUseCase{
+		repo:      repo,
+		domains:   domains,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the revoke custom domain use case
+func (uc *RevokeCustomDomainUseCase) Execute(ctx context.Context, cmd RevokeCustomDomainCommand) (*domain.CustomDomain, error) {
+	d, err := uc.domains.GetByID(ctx, cmd.DomainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom domain: %w", err)
+	}
+	// Another tenant's domain is reported as missing
+	if d.TenantID != cmd.TenantID {
+		return nil, domain.ErrDomainNotFound
+	}
+
+	before := d.Snapshot()
+	previous := d.Status
+	if err := d.Revoke(); err != nil {
+		return nil, err
+	}
+
+	tenantID := cmd.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantDomainRevoked, &tenantID, before, d.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.domains.Update(ctx, d, previous); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to revoke custom domain",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("hostname", d.Hostname),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to revoke custom domain: %w", err)
+	}
+
+	publishDomainChanged(uc.repo, uc.publisher, uc.logger, d)
+
+	uc.logger.Info("Custom domain revoked",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("hostname", d.Hostname),
+	)
+
+	return d, nil
+}
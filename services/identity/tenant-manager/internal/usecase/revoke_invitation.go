@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RevokeInvitationCommand represents the input for revoking an invitation
+type RevokeInvitationCommand struct {
+	TenantID     uuid.UUID
+	InvitationID uuid.UUID
+}
+
+// RevokeInvitationUseCase withdraws a pending invitation, so its link stops
+// working
+type RevokeInvitationUseCase struct {
+	invitations domain.InvitationRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	logger      *zap.Logger
+}
+
+// NewRevokeInvitationUseCase creates a new RevokeInvitationUseCase
+func NewRevokeInvitationUseCase(
+	invitations domain.InvitationRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{
+		invitations: invitations,
+		tx:          tx,
+		audit:       audit,
+		logger:      logger,
+	}
+}
+
+// Execute executes the revoke invitation use case
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, cmd RevokeInvitationCommand) (*domain.Invitation, error) {
+	inv, err := uc.invitations.GetByID(ctx, cmd.InvitationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	// Another tenant's invitation is reported as missing
+	if inv.TenantID != cmd.TenantID {
+		return nil, domain.ErrInvitationNotFound
+	}
+
+	before := inv.Snapshot()
+	if err := inv.Revoke(time.Now()); err != nil {
+		return nil, err
+	}
+
+	tenantID := cmd.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantInvitationRevoked, &tenantID, before, inv.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.invitations.Update(ctx, inv, inv.TokenHash); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		// The invitation was accepted, revoked or resent since it was read
+		if errors.Is(err, domain.ErrInvitationNotPending) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to revoke invitation",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("invitation_id", cmd.InvitationID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	uc.logger.Info("Invitation revoked",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("invitation_id", cmd.InvitationID.String()),
+	)
+
+	return inv, nil
+}
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"go.uber.org/zap"
+)
+
+// RunScheduledOperationsCommand represents the input for a scheduled
+// operations run
+type RunScheduledOperationsCommand struct {
+	Limit int
+}
+
+// RunScheduledOperationsReport summarizes a scheduled operations run
+type RunScheduledOperationsReport struct {
+	Completed int
+	// Failed counts operations that were illegal by the time they ran
+	Failed int
+	// Errors counts operations left pending after an error, such as a lost
+	// database connection, to be retried on the next run
+	Errors int
+}
+
+// RunScheduledOperationsUseCase applies the scheduled operations that are
+// due through the suspend and activate use cases
+type RunScheduledOperationsUseCase struct {
+	repo       domain.TenantRepository
+	ops        domain.ScheduledOperationRepository
+	suspendUC  *SuspendTenantUseCase
+	activateUC *ActivateTenantUseCase
+	tx         Transactor
+	audit      domain.AuditRepository
+	publisher  EventPublisher
+	logger     *zap.Logger
+}
+
+// NewRunScheduledOperationsUseCase creates a new RunScheduledOperationsUseCase
+func NewRunScheduledOperationsUseCase(
+	repo domain.TenantRepository,
+	ops domain.ScheduledOperationRepository,
+	suspendUC *SuspendTenantUseCase,
+	activateUC *ActivateTenantUseCase,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *RunScheduledOperationsUseCase {
+	return &RunScheduledOperationsUseCase{
+		repo:       repo,
+		ops:        ops,
+		suspendUC:  suspendUC,
+		activateUC: activateUC,
+		tx:         tx,
+		audit:      audit,
+		publisher:  publisher,
+		logger:     logger,
+	}
+}
+
+// Execute runs the pending operations whose run time has come, earliest
+// first. An operation that turns out to be illegal is marked failed with the
+// reason; one that hits any other error stays pending and is retried.
+func (uc *RunScheduledOperationsUseCase) Execute(ctx context.Context, cmd RunScheduledOperationsCommand) (*RunScheduledOperationsReport, error) {
+	due, err := uc.ops.ListDue(ctx, time.Now(), cmd.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due scheduled operations: %w", err)
+	}
+
+	report := &RunScheduledOperationsReport{}
+	for _, op := range due {
+		err := uc.run(ctx, op)
+		if err == nil {
+			report.Completed++
+			continue
+		}
+
+		if errors.Is(err, domain.ErrOperationNotPending) {
+			uc.logger.Info("Scheduled operation canceled while running, skipped",
+				zap.String("tenant_id", op.TenantID.String()),
+				zap.String("operation_id", op.ID.String()),
+			)
+			continue
+		}
+
+		cause := domain.OperationFailure(err)
+		if cause == nil {
+			report.Errors++
+			uc.logger.Warn("Failed to run scheduled operation",
+				zap.String("tenant_id", op.TenantID.String()),
+				zap.String("operation_id", op.ID.String()),
+				zap.String("action", string(op.Action)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if err := uc.fail(ctx, op, cause); err != nil {
+			report.Errors++
+			uc.logger.Warn("Failed to record scheduled operation failure",
+				zap.String("tenant_id", op.TenantID.String()),
+				zap.String("operation_id", op.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		report.Failed++
+	}
+
+	return report, nil
+}
+
+// run applies an operation and marks it completed, scheduling the
+// reactivation of a time-boxed suspension, in one transaction
+func (uc *RunScheduledOperationsUseCase) run(ctx context.Context, op *domain.ScheduledOperation) error {
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.apply(ctx, op); err != nil {
+			return err
+		}
+
+		if err := op.Complete(time.Now()); err != nil {
+			return err
+		}
+		if err := uc.ops.Update(ctx, op); err != nil {
+			return err
+		}
+
+		if next := op.FollowUp(); next != nil {
+			if err := uc.ops.Create(ctx, next); err != nil {
+				return err
+			}
+			uc.logger.Info("Tenant reactivation scheduled",
+				zap.String("tenant_id", op.TenantID.String()),
+				zap.Time("run_at", next.RunAt),
+			)
+		}
+
+		uc.logger.Info("Scheduled operation completed",
+			zap.String("tenant_id", op.TenantID.String()),
+			zap.String("operation_id", op.ID.String()),
+			zap.String("action", string(op.Action)),
+		)
+		return nil
+	})
+}
+
+// apply runs the lifecycle action of an operation
+func (uc *RunScheduledOperationsUseCase) apply(ctx context.Context, op *domain.ScheduledOperation) error {
+	var err error
+	switch op.Action {
+	case domain.ActionSuspend:
+		_, err = uc.suspendUC.Execute(ctx, SuspendTenantCommand{
+			TenantID: op.TenantID,
+			Reason:   op.SuspensionReason,
+			Notes:    op.Notes,
+		})
+	case domain.ActionActivate:
+		cmd := ActivateTenantCommand{TenantID: op.TenantID}
+		if op.FollowsID != nil {
+			// A reactivation only ends the suspension that scheduled it
+			cmd.Ends, err = uc.ops.GetByID(ctx, *op.FollowsID)
+			if err != nil {
+				return fmt.Errorf("failed to get followed suspension: %w", err)
+			}
+		}
+		_, err = uc.activateUC.Execute(ctx, cmd)
+	default:
+		err = fmt.Errorf("%w: %s", domain.ErrInvalidOperationAction, op.Action)
+	}
+	return err
+}
+
+// fail marks an operation failed with its cause, audits and publishes it
+func (uc *RunScheduledOperationsUseCase) fail(ctx context.Context, op *domain.ScheduledOperation, cause error) error {
+	before := op.Snapshot()
+	if err := op.Fail(cause, time.Now()); err != nil {
+		return err
+	}
+
+	tenantID := op.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantOperationFailed, &tenantID, before, op.Snapshot(),
+	))
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.ops.Update(ctx, op); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled operation: %w", err)
+	}
+
+	uc.logger.Warn("Scheduled operation failed",
+		zap.String("tenant_id", op.TenantID.String()),
+		zap.String("operation_id", op.ID.String()),
+		zap.String("action", string(op.Action)),
+		zap.String("error", op.Error),
+	)
+
+	// Publish event (async); a tenant that no longer exists gets none
+	go func() {
+		publishCtx := context.Background()
+		tenant, err := uc.repo.GetByTenantID(publishCtx, op.TenantID)
+		if err != nil {
+			return
+		}
+		if err := uc.publisher.PublishTenantOperationFailed(publishCtx, tenant, op); err != nil {
+			uc.logger.Error("Failed to publish tenant.operation.failed event",
+				zap.String("tenant_id", op.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return nil
+}
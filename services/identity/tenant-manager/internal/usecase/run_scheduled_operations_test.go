@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// dueSuspension is a billing suspension of tenant that is due now
+func dueSuspension(t *testing.T, tenant *domain.Tenant, reactivateAfter time.Duration) *domain.ScheduledOperation {
+	t.Helper()
+	now := time.Now()
+	op, err := domain.NewScheduledOperation(tenant.TenantID, domain.ActionSuspend, domain.SuspensionBilling, "", now, reactivateAfter, now.Add(-time.Minute))
+	require.NoError(t, err)
+	return op
+}
+
+func newRunScheduledOperationsUseCase(tenants *fakeTenantRepo, ops *fakeOperationRepo, publisher *fakePublisher) *RunScheduledOperationsUseCase {
+	tx := &fakeTx{stores: []fakeStore{tenants, ops}}
+	audit := &fakeAuditRepo{}
+	return NewRunScheduledOperationsUseCase(
+		tenants, ops,
+		NewSuspendTenantUseCase(tenants, tx, audit, publisher, zap.NewNop()),
+		NewActivateTenantUseCase(tenants, tx, audit, publisher, zap.NewNop()),
+		tx, audit, publisher, zap.NewNop(),
+	)
+}
+
+func TestRunScheduledOperations(t *testing.T) {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	op := dueSuspension(t, tenant, time.Hour)
+	tenants := newFakeTenantRepo(tenant)
+	ops := newFakeOperationRepo(op)
+	publisher := &fakePublisher{}
+
+	report, err := newRunScheduledOperationsUseCase(tenants, ops, publisher).Execute(context.Background(), RunScheduledOperationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Completed)
+
+	assert.Equal(t, domain.StatusSuspended, tenants.get(tenant.TenantID).Status)
+	assert.Equal(t, domain.OperationCompleted, ops.get(op.ID).Status)
+	assert.Equal(t, []string{"tenant.suspended"}, publisher.published())
+}
+
+func TestRunScheduledOperations_CanceledWhileRunningPublishesNothing(t *testing.T) {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	op := dueSuspension(t, tenant, 0)
+	tenants := newFakeTenantRepo(tenant)
+	ops := newFakeOperationRepo(op)
+	publisher := &fakePublisher{}
+
+	// Canceled after the run listed it
+	canceled := *op
+	require.NoError(t, canceled.Cancel(time.Now()))
+	ops.ops[op.ID] = canceled
+
+	report, err := newRunScheduledOperationsUseCase(tenants, ops, publisher).Execute(context.Background(), RunScheduledOperationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, report.Completed)
+	assert.Zero(t, report.Errors)
+
+	// The suspension rolled back with the operation, and was never announced
+	assert.Equal(t, domain.StatusActive, tenants.get(tenant.TenantID).Status)
+	assert.Equal(t, domain.OperationCanceled, ops.get(op.ID).Status)
+	assert.Empty(t, publisher.published())
+}
+
+// dueReactivation is the reactivation of a completed time-boxed billing
+// suspension of tenant, due now, with the suspension it follows
+func dueReactivation(t *testing.T, tenant *domain.Tenant) (suspension, reactivation *domain.ScheduledOperation) {
+	t.Helper()
+	suspension = dueSuspension(t, tenant, time.Hour)
+	require.NoError(t, tenant.Suspend(domain.SuspensionBilling, ""))
+	require.NoError(t, suspension.Complete(*tenant.SuspendedAt))
+	reactivation = suspension.FollowUp()
+	require.NotNil(t, reactivation)
+	return suspension, reactivation
+}
+
+func TestRunScheduledOperations_ReactivationEndsItsSuspension(t *testing.T) {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	suspension, reactivation := dueReactivation(t, tenant)
+	tenants := newFakeTenantRepo(tenant)
+	ops := newFakeOperationRepo(suspension, reactivation)
+
+	report, err := newRunScheduledOperationsUseCase(tenants, ops, &fakePublisher{}).Execute(context.Background(), RunScheduledOperationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Completed)
+	assert.Equal(t, domain.StatusActive, tenants.get(tenant.TenantID).Status)
+	assert.Equal(t, domain.OperationCompleted, ops.get(reactivation.ID).Status)
+}
+
+func TestRunScheduledOperations_ReactivationLeavesLaterSuspension(t *testing.T) {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	suspension, reactivation := dueReactivation(t, tenant)
+
+	// Lifted early by an operator, then suspended again for billing
+	require.NoError(t, tenant.Reinstate())
+	require.NoError(t, tenant.Suspend(domain.SuspensionBilling, "Invoice overdue"))
+
+	tenants := newFakeTenantRepo(tenant)
+	ops := newFakeOperationRepo(suspension, reactivation)
+
+	report, err := newRunScheduledOperationsUseCase(tenants, ops, &fakePublisher{}).Execute(context.Background(), RunScheduledOperationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Failed)
+
+	assert.Equal(t, domain.StatusSuspended, tenants.get(tenant.TenantID).Status)
+	failed := ops.get(reactivation.ID)
+	assert.Equal(t, domain.OperationFailed, failed.Status)
+	assert.Equal(t, domain.ErrSuspensionSuperseded.Error(), failed.Error)
+}
+
+func TestRunScheduledOperations_RestrictedSuspensionReplacesSuspension(t *testing.T) {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	suspension, reactivation := dueReactivation(t, tenant)
+
+	// An abuse suspension comes due while the billing suspension is in force
+	now := time.Now()
+	abuse, err := domain.NewScheduledOperation(tenant.TenantID, domain.ActionSuspend, domain.SuspensionAbuse, "Spam reports", now, 0, now.Add(-time.Minute))
+	require.NoError(t, err)
+
+	tenants := newFakeTenantRepo(tenant)
+	ops := newFakeOperationRepo(suspension, abuse, reactivation)
+	publisher := &fakePublisher{}
+
+	report, err := newRunScheduledOperationsUseCase(tenants, ops, publisher).Execute(context.Background(), RunScheduledOperationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Completed)
+	assert.Equal(t, 1, report.Failed)
+
+	// The abuse suspension is in force, and the billing reactivation no
+	// longer lifts it
+	stored := tenants.get(tenant.TenantID)
+	assert.Equal(t, domain.StatusSuspended, stored.Status)
+	assert.Equal(t, domain.SuspensionAbuse, stored.SuspensionReason)
+	assert.Equal(t, domain.OperationCompleted, ops.get(abuse.ID).Status)
+	failed := ops.get(reactivation.ID)
+	assert.Equal(t, domain.OperationFailed, failed.Status)
+	assert.Equal(t, domain.ErrSuspensionSuperseded.Error(), failed.Error)
+	assert.Contains(t, publisher.published(), "tenant.suspended")
+}
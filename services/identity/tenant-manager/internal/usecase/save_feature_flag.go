@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"go.uber.org/zap"
+)
+
+// SaveFeatureFlagCommand represents the input for registering a feature flag
+type SaveFeatureFlagCommand struct {
+	Key            string
+	Type           domain.FeatureType
+	Default        interface{}
+	Description    string
+	RolloutPercent int
+}
+
+// SaveFeatureFlagUseCase registers a feature flag, or replaces the
+// registered flag with the same key
+type SaveFeatureFlagUseCase struct {
+	flags     domain.FeatureFlagRepository
+	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewSaveFeatureFlagUseCase creates a new SaveFeatureFlagUseCase
+func NewSaveFeatureFlagUseCase(
+	flags domain.FeatureFlagRepository,
+	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *SaveFeatureFlagUseCase {
+	return &SaveFeatureFlagUseCase{
+		flags:     flags,
+		repo:      repo,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the save feature flag use case. Every tenant whose value
+// of the flag changes, through its default or its rollout, is published a
+// tenant.features.changed event.
+func (uc *SaveFeatureFlagUseCase) Execute(ctx context.Context, cmd SaveFeatureFlagCommand) (*domain.FeatureFlag, error) {
+	flag, err := domain.NewFeatureFlag(cmd.Key, cmd.Type, cmd.Default, cmd.Description, cmd.RolloutPercent)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feature flag: %w", err)
+	}
+
+	previous, err := uc.flags.GetByKey(ctx, cmd.Key)
+	if err != nil && !errors.Is(err, domain.ErrFeatureNotFound) {
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+	if previous != nil {
+		flag.CreatedAt = previous.CreatedAt
+	}
+
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditFeatureFlagChanged, nil, previous.Snapshot(), flag.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.flags.Save(ctx, flag); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to save feature flag",
+			zap.String("feature", cmd.Key),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to save feature flag: %w", err)
+	}
+
+	uc.logger.Info("Feature flag saved",
+		zap.String("feature", flag.Key),
+		zap.String("type", string(flag.Type)),
+		zap.Int("rollout_percent", flag.RolloutPercent),
+	)
+
+	// Publish events (async); a run over every tenant outlives the request
+	go uc.publishFeaturesChanged(context.Background(), previous, flag)
+
+	return flag, nil
+}
+
+// featureStatuses are the statuses of tenants whose features are evaluated
+var featureStatuses = []domain.TenantStatus{
+	domain.StatusProvisioning, domain.StatusActive, domain.StatusSuspended, domain.StatusArchived,
+}
+
+// publishFeaturesChanged publishes the features of every tenant, not
+// deleted, whose value of the flag differs from its value of previous, the
+// flag's former registration or nil for a new flag
+func (uc *SaveFeatureFlagUseCase) publishFeaturesChanged(ctx context.Context, previous, flag *domain.FeatureFlag) {
+	tenants, err := uc.repo.ListByStatus(ctx, featureStatuses...)
+	if err != nil {
+		uc.logger.Error("Failed to list tenants for tenant.features.changed events",
+			zap.String("feature", flag.Key),
+			zap.Error(err),
+		)
+		return
+	}
+
+	registered, err := uc.flags.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list feature flags for tenant.features.changed events",
+			zap.String("feature", flag.Key),
+			zap.Error(err),
+		)
+		return
+	}
+
+	for _, tenant := range tenants {
+		var before []*domain.FeatureValue
+		if previous != nil {
+			before = tenant.EvaluateFeatures([]*domain.FeatureFlag{previous})
+		}
+		if domain.SameFeatures(before, tenant.EvaluateFeatures([]*domain.FeatureFlag{flag})) {
+			continue
+		}
+
+		if err := uc.publisher.PublishTenantFeaturesChanged(ctx, tenant, tenant.EvaluateFeatures(registered)); err != nil {
+			uc.logger.Error("Failed to publish tenant.features.changed event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// List retrieves every registered flag, by key
+func (uc *SaveFeatureFlagUseCase) List(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	flags, err := uc.flags.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list feature flags", zap.Error(err))
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	return flags, nil
+}
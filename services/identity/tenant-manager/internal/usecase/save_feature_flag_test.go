@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSaveFeatureFlag_PublishesTenantsWhoseValueChanged(t *testing.T) {
+	previous, err := domain.NewFeatureFlag("beta", domain.FeatureBoolean, false, "Beta program", 0)
+	require.NoError(t, err)
+	flag, err := domain.NewFeatureFlag("beta", domain.FeatureBoolean, false, "Beta program", 100)
+	require.NoError(t, err)
+
+	rolledOut := newActiveTenant(domain.PlanProfessional)
+	overridden := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, overridden.SetFeatureOverride(previous, false))
+	deleted := newActiveTenant(domain.PlanProfessional)
+	require.NoError(t, deleted.Delete())
+
+	tenants := newFakeTenantRepo(rolledOut, overridden, deleted)
+	publisher := &fakePublisher{}
+	uc := NewSaveFeatureFlagUseCase(
+		&fakeFlagRepo{flags: []*domain.FeatureFlag{flag}}, tenants,
+		&fakeTx{}, &fakeAuditRepo{}, publisher, zap.NewNop(),
+	)
+
+	// Only the tenant the rollout turns the flag on for
+	uc.publishFeaturesChanged(context.Background(), previous, flag)
+	assert.Equal(t, []string{"tenant.features.changed"}, publisher.published())
+
+	// A new flag changes the features of every tenant not deleted
+	publisher = &fakePublisher{}
+	uc.publisher = publisher
+	uc.publishFeaturesChanged(context.Background(), nil, flag)
+	assert.Len(t, publisher.published(), 2)
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ScheduleOperationCommand represents the input for scheduling a lifecycle
+// operation
+type ScheduleOperationCommand struct {
+	TenantID uuid.UUID
+	Action   domain.LifecycleAction
+	// Reason is why a scheduled suspension suspends the tenant
+	Reason domain.SuspensionReason
+	Notes  string
+	RunAt  time.Time
+	// ReactivateAfter, on a suspension, reactivates the tenant that long
+	// after it was suspended
+	ReactivateAfter time.Duration
+}
+
+// ScheduleOperationUseCase schedules a suspension or activation of a tenant
+// for a later time
+type ScheduleOperationUseCase struct {
+	repo   domain.TenantRepository
+	ops    domain.ScheduledOperationRepository
+	tx     Transactor
+	audit  domain.AuditRepository
+	logger *zap.Logger
+}
+
+// NewScheduleOperationUseCase creates a new ScheduleOperationUseCase
+func NewScheduleOperationUseCase(
+	repo domain.TenantRepository,
+	ops domain.ScheduledOperationRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *ScheduleOperationUseCase {
+	return &ScheduleOperationUseCase{
+		repo:   repo,
+		ops:    ops,
+		tx:     tx,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// Execute executes the schedule operation use case. Whether the operation is
+// legal for the tenant's status is only checked when it runs.
+func (uc *ScheduleOperationUseCase) Execute(ctx context.Context, cmd ScheduleOperationCommand) (*domain.ScheduledOperation, error) {
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	op, err := domain.NewScheduledOperation(cmd.TenantID, cmd.Action, cmd.Reason, cmd.Notes, cmd.RunAt, cmd.ReactivateAfter, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	a := actor.FromContext(ctx)
+	op.CreatedBy = a.UUID()
+
+	tenantID := cmd.TenantID
+	event := a.Stamp(domain.NewAuditEvent(
+		domain.AuditTenantOperationScheduled, &tenantID, nil, op.Snapshot(),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.ops.Create(ctx, op); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to schedule tenant operation",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.String("action", string(cmd.Action)),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to schedule operation: %w", err)
+	}
+
+	return op, nil
+}
+
+// List retrieves the scheduled operations of a tenant, optionally of one
+// status, latest run time first
+func (uc *ScheduleOperationUseCase) List(ctx context.Context, tenantID uuid.UUID, status domain.OperationStatus) ([]*domain.ScheduledOperation, error) {
+	// Distinguish an unknown tenant from one without operations
+	if _, err := uc.repo.GetByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	ops, err := uc.ops.ListByTenant(ctx, tenantID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled operations: %w", err)
+	}
+
+	return ops, nil
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SetEntitlementLimitCommand represents the input for setting a tenant's own
+// limit of an entitlement
+type SetEntitlementLimitCommand struct {
+	TenantID uuid.UUID
+	EntitlementLimitCommand
+}
+
+// SetEntitlementLimitUseCase gives a tenant its own limit of an entitlement,
+// replacing the limit of its plan
+type SetEntitlementLimitUseCase struct {
+	repo         domain.TenantRepository
+	entitlements domain.EntitlementRepository
+	tx           Transactor
+	audit        domain.AuditRepository
+	logger       *zap.Logger
+}
+
+// NewSetEntitlementLimitUseCase creates a new SetEntitlementLimitUseCase
+func NewSetEntitlementLimitUseCase(
+	repo domain.TenantRepository,
+	entitlements domain.EntitlementRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	logger *zap.Logger,
+) *SetEntitlementLimitUseCase {
+	return &SetEntitlementLimitUseCase{
+		repo:         repo,
+		entitlements: entitlements,
+		tx:           tx,
+		audit:        audit,
+		logger:       logger,
+	}
+}
+
+// Execute executes the set entitlement limit use case
+func (uc *SetEntitlementLimitUseCase) Execute(ctx context.Context, cmd SetEntitlementLimitCommand) (*domain.EntitlementUsage, error) {
+	limit, err := cmd.toDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	own, err := uc.entitlements.ListTenantLimits(ctx, tenant.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant entitlement limits: %w", err)
+	}
+	var previous *domain.EntitlementLimit
+	for _, l := range own {
+		if l.Name == limit.Name {
+			previous = l
+		}
+	}
+
+	tenantID := tenant.TenantID
+	event := actor.FromContext(ctx).Stamp(domain.NewAuditEvent(
+		domain.AuditTenantEntitlementChanged, &tenantID,
+		previous.Snapshot(limit.Name), limit.Snapshot(limit.Name),
+	))
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.entitlements.SetTenantLimit(ctx, tenant.TenantID, limit); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set entitlement limit: %w", err)
+	}
+
+	uc.logger.Info("Tenant entitlement limit set",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("entitlement", limit.Name),
+		zap.Int64("limit", limit.Limit),
+		zap.String("window", string(limit.Window)),
+	)
+
+	return currentUsage(ctx, uc.entitlements, tenant, limit, time.Now())
+}
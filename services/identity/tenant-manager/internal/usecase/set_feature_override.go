@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SetFeatureOverrideCommand represents the input for overriding a tenant's
+// feature flag
+type SetFeatureOverrideCommand struct {
+	TenantID uuid.UUID
+	Key      string
+	Value    interface{}
+}
+
+// SetFeatureOverrideUseCase sets the value of a feature flag for one tenant,
+// whatever its plan
+type SetFeatureOverrideUseCase struct {
+	repo      domain.TenantRepository
+	flags     domain.FeatureFlagRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewSetFeatureOverrideUseCase creates a new SetFeatureOverrideUseCase
+func NewSetFeatureOverrideUseCase(
+	repo domain.TenantRepository,
+	flags domain.FeatureFlagRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *SetFeatureOverrideUseCase {
+	return &SetFeatureOverrideUseCase{
+		repo:      repo,
+		flags:     flags,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the set feature override use case and returns the
+// tenant's feature flags as evaluated afterwards
+func (uc *SetFeatureOverrideUseCase) Execute(ctx context.Context, cmd SetFeatureOverrideCommand) ([]*domain.FeatureValue, error) {
+	uc.logger.Info("Setting tenant feature override",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("feature", cmd.Key),
+	)
+
+	flag, err := uc.flags.GetByKey(ctx, cmd.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.IsDeleted() {
+		return nil, domain.ErrTenantDeleted
+	}
+
+	before := tenant.Snapshot()
+
+	// Set override
+	if err := tenant.SetFeatureOverride(flag, cmd.Value); err != nil {
+		return nil, fmt.Errorf("failed to set feature override: %w", err)
+	}
+
+	// Update tenant
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantFeaturesChanged, tenant, before); err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	features, err := evaluateFeatures(ctx, uc.flags, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	publishFeaturesChanged(uc.publisher, uc.logger, tenant, features)
+
+	uc.logger.Info("Tenant feature override set",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("feature", cmd.Key),
+	)
+
+	return features, nil
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SetQuotaOverrideCommand represents the input for overriding a tenant quota
+type SetQuotaOverrideCommand struct {
+	TenantID  uuid.UUID
+	Name      domain.QuotaName
+	Value     int
+	Reason    string
+	ExpiresAt *time.Time
+}
+
+// SetQuotaOverrideUseCase grants a tenant a quota that differs from its plan
+type SetQuotaOverrideUseCase struct {
+	repo      domain.TenantRepository
+	members   domain.MemberRepository
+	usage     domain.UsageRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewSetQuotaOverrideUseCase creates a new SetQuotaOverrideUseCase
+func NewSetQuotaOverrideUseCase(
+	repo domain.TenantRepository,
+	members domain.MemberRepository,
+	usage domain.UsageRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *SetQuotaOverrideUseCase {
+	return &SetQuotaOverrideUseCase{
+		repo:      repo,
+		members:   members,
+		usage:     usage,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the set quota override use case. An override that lowers
+// the effective quota below the tenant's current usage is refused.
+func (uc *SetQuotaOverrideUseCase) Execute(ctx context.Context, cmd SetQuotaOverrideCommand) (*domain.Tenant, error) {
+	uc.logger.Info("Setting tenant quota override",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("quota", string(cmd.Name)),
+		zap.Int("value", cmd.Value),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, cmd.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	before := tenant.Snapshot()
+	previous := tenant.EffectiveQuota(cmd.Name, time.Now()).Effective
+
+	// Set override
+	if err := tenant.SetQuotaOverride(cmd.Name, cmd.Value, cmd.Reason, cmd.ExpiresAt); err != nil {
+		return nil, fmt.Errorf("failed to set quota override: %w", err)
+	}
+
+	// Update tenant, validating a decrease against current usage
+	if tenant.EffectiveQuota(cmd.Name, time.Now()).Effective < previous {
+		err = saveTenantWithinUsage(ctx, uc.tx, uc.members, uc.usage, uc.repo, uc.audit, domain.AuditTenantQuotaChanged, tenant, before)
+	} else {
+		err = saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantQuotaChanged, tenant, before)
+	}
+	if errors.Is(err, domain.ErrPlanLimitExceeded) {
+		return nil, err
+	}
+	if err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", cmd.TenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	publishQuotaChanged(uc.publisher, uc.logger, tenant)
+
+	uc.logger.Info("Tenant quota override set",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("quota", string(cmd.Name)),
+	)
+
+	return tenant, nil
+}
+
+// publishQuotaChanged publishes a tenant.quota.changed event (async)
+func publishQuotaChanged(publisher EventPublisher, logger *zap.Logger, tenant *domain.Tenant) {
+	go func() {
+		publishCtx := context.Background()
+		if err := publisher.PublishTenantQuotaChanged(publishCtx, tenant); err != nil {
+			logger.Error("Failed to publish tenant.quota.changed event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+}
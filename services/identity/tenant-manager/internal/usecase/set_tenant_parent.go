@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SetTenantParentCommand represents the input for moving a tenant in the
+// hierarchy
+type SetTenantParentCommand struct {
+	TenantID uuid.UUID
+	// ParentTenantID is the new parent; nil makes the tenant a root
+	ParentTenantID *uuid.UUID
+	InheritQuotas  bool
+}
+
+// SetTenantParentUseCase moves a tenant, with its descendants, under another
+// tenant or out of its hierarchy
+type SetTenantParentUseCase struct {
+	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewSetTenantParentUseCase creates a new SetTenantParentUseCase
+func NewSetTenantParentUseCase(
+	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *SetTenantParentUseCase {
+	return &SetTenantParentUseCase{
+		repo:      repo,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute executes the set tenant parent use case. The placement is checked
+// and saved under the hierarchy lock, so concurrent moves cannot together
+// create a cycle or exceed the maximum depth.
+func (uc *SetTenantParentUseCase) Execute(ctx context.Context, cmd SetTenantParentCommand) (*domain.Tenant, error) {
+	var tenant *domain.Tenant
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		tenant, err = uc.repo.GetByTenantID(ctx, cmd.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant: %w", err)
+		}
+
+		before := tenant.Snapshot()
+
+		if err := placeUnderParent(ctx, uc.repo, tenant, cmd.ParentTenantID, cmd.InheritQuotas); err != nil {
+			return err
+		}
+
+		if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantParentChanged, tenant, before); err != nil {
+			uc.logger.Error("Failed to update tenant parent",
+				zap.String("tenant_id", cmd.TenantID.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to update tenant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parent := ""
+	if tenant.ParentTenantID != nil {
+		parent = tenant.ParentTenantID.String()
+	}
+	uc.logger.Info("Tenant parent changed",
+		zap.String("tenant_id", cmd.TenantID.String()),
+		zap.String("parent_tenant_id", parent),
+		zap.Bool("inherit_quotas", tenant.InheritQuotas),
+	)
+
+	// Publish event (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantUpdated(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.updated event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return tenant, nil
+}
+
+// placeUnderParent places a tenant under the tenant with parentID, or makes it
+// a root for a nil parentID, and loads the quotas it inherits. Call it within
+// the transaction that saves the tenant: it locks the hierarchy before
+// reading the ancestors and descendants it checks.
+func placeUnderParent(ctx context.Context, repo domain.TenantRepository, tenant *domain.Tenant, parentID *uuid.UUID, inheritQuotas bool) error {
+	if parentID == nil {
+		return tenant.SetParent(nil, nil, 0, inheritQuotas)
+	}
+
+	if err := repo.LockHierarchy(ctx); err != nil {
+		return err
+	}
+
+	parent, err := repo.GetByTenantID(ctx, *parentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTenantNotFound) {
+			return domain.ErrParentTenantNotFound
+		}
+		return fmt.Errorf("failed to get parent tenant: %w", err)
+	}
+
+	ancestors, err := repo.ListAncestors(ctx, parent.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get parent tenant ancestors: %w", err)
+	}
+
+	descendants, err := repo.ListDescendants(ctx, tenant.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant descendants: %w", err)
+	}
+
+	height := domain.HierarchyHeight(tenant.TenantID, descendants)
+	if err := tenant.SetParent(parent, ancestors, height, inheritQuotas); err != nil {
+		return err
+	}
+	tenant.InheritQuotasFrom(parent, time.Now())
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newSetParentUseCase(tenants *fakeTenantRepo) *SetTenantParentUseCase {
+	tx := &fakeTx{stores: []fakeStore{tenants}}
+	return NewSetTenantParentUseCase(tenants, tx, &fakeAuditRepo{}, &fakePublisher{}, zap.NewNop())
+}
+
+func TestSetTenantParent_RefusesCycles(t *testing.T) {
+	a := newActiveTenant(domain.PlanProfessional)
+	b := newActiveTenant(domain.PlanProfessional)
+	tenants := newFakeTenantRepo(a, b)
+	uc := newSetParentUseCase(tenants)
+
+	_, err := uc.Execute(context.Background(), SetTenantParentCommand{TenantID: a.TenantID, ParentTenantID: &b.TenantID})
+	require.NoError(t, err)
+
+	_, err = uc.Execute(context.Background(), SetTenantParentCommand{TenantID: b.TenantID, ParentTenantID: &a.TenantID})
+	assert.ErrorIs(t, err, domain.ErrHierarchyCycle)
+	assert.Nil(t, tenants.get(b.TenantID).ParentTenantID)
+}
+
+func TestSetTenantParent_RefusesTooDeepHierarchies(t *testing.T) {
+	chain := make([]*domain.Tenant, domain.MaxHierarchyDepth+1)
+	for i := range chain {
+		chain[i] = newActiveTenant(domain.PlanProfessional)
+	}
+	tenants := newFakeTenantRepo(chain...)
+	uc := newSetParentUseCase(tenants)
+
+	for i := 1; i < domain.MaxHierarchyDepth; i++ {
+		_, err := uc.Execute(context.Background(), SetTenantParentCommand{TenantID: chain[i].TenantID, ParentTenantID: &chain[i-1].TenantID})
+		require.NoError(t, err)
+	}
+
+	last := chain[domain.MaxHierarchyDepth]
+	_, err := uc.Execute(context.Background(), SetTenantParentCommand{TenantID: last.TenantID, ParentTenantID: &chain[domain.MaxHierarchyDepth-1].TenantID})
+	assert.ErrorIs(t, err, domain.ErrHierarchyTooDeep)
+	assert.Nil(t, tenants.get(last.TenantID).ParentTenantID)
+}
+
+func TestPlaceUnderParent_ReadsTheHierarchyUnderItsLock(t *testing.T) {
+	child := newActiveTenant(domain.PlanProfessional)
+	parent := newActiveTenant(domain.PlanProfessional)
+	tenants := newFakeTenantRepo(child, parent)
+
+	// Outside a transaction the lock cannot be held until the save
+	err := placeUnderParent(context.Background(), tenants, child, &parent.TenantID, false)
+	assert.Error(t, err)
+
+	tx := &fakeTx{stores: []fakeStore{tenants}}
+	err = tx.WithinTx(context.Background(), func(ctx context.Context) error {
+		return placeUnderParent(ctx, tenants, child, &parent.TenantID, false)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, parent.TenantID, *child.ParentTenantID)
+
+	missing := uuid.New()
+	err = tx.WithinTx(context.Background(), func(ctx context.Context) error {
+		return placeUnderParent(ctx, tenants, child, &missing, false)
+	})
+	assert.ErrorIs(t, err, domain.ErrParentTenantNotFound)
+}
@@ -12,12 +12,15 @@ import (
 // SuspendTenantCommand represents the input for suspending a tenant
 type SuspendTenantCommand struct {
 	TenantID uuid.UUID
-	Reason   string
+	Reason   domain.SuspensionReason
+	Notes    string
 }
 
 // SuspendTenantUseCase handles tenant suspension
 type SuspendTenantUseCase struct {
 	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
 	publisher EventPublisher
 	logger    *zap.Logger
 }
@@ -25,11 +28,15 @@ type SuspendTenantUseCase struct {
 // NewSuspendTenantUseCase creates a new SuspendTenantUseCase
 func NewSuspendTenantUseCase(
 	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
 	publisher EventPublisher,
 	logger *zap.Logger,
 ) *SuspendTenantUseCase {
 	return &SuspendTenantUseCase{
 		repo:      repo,
+		tx:        tx,
+		audit:     audit,
 		publisher: publisher,
 		logger:    logger,
 	}
@@ -39,7 +46,7 @@ func NewSuspendTenantUseCase(
 func (uc *SuspendTenantUseCase) Execute(ctx context.Context, cmd SuspendTenantCommand) (*domain.Tenant, error) {
 	uc.logger.Warn("Suspending tenant",
 		zap.String("tenant_id", cmd.TenantID.String()),
-		zap.String("reason", cmd.Reason),
+		zap.String("reason", string(cmd.Reason)),
 	)
 
 	// Get tenant
@@ -52,8 +59,10 @@ func (uc *SuspendTenantUseCase) Execute(ctx context.Context, cmd SuspendTenantCo
 		return nil, fmt.Errorf("failed to get tenant: %w", err)
 	}
 
+	before := tenant.Snapshot()
+
 	// Suspend tenant
-	if err := tenant.Suspend(cmd.Reason); err != nil {
+	if err := tenant.Suspend(cmd.Reason, cmd.Notes); err != nil {
 		uc.logger.Error("Failed to suspend tenant",
 			zap.String("tenant_id", cmd.TenantID.String()),
 			zap.Error(err),
@@ -62,7 +71,7 @@ func (uc *SuspendTenantUseCase) Execute(ctx context.Context, cmd SuspendTenantCo
 	}
 
 	// Update tenant
-	if err := uc.repo.Update(ctx, tenant); err != nil {
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantSuspended, tenant, before); err != nil {
 		uc.logger.Error("Failed to update tenant",
 			zap.String("tenant_id", cmd.TenantID.String()),
 			zap.Error(err),
@@ -70,8 +79,8 @@ func (uc *SuspendTenantUseCase) Execute(ctx context.Context, cmd SuspendTenantCo
 		return nil, fmt.Errorf("failed to update tenant: %w", err)
 	}
 
-	// Publish event (async)
-	go func() {
+	// Publish event (async) once a scheduled operation's transaction commits
+	uc.tx.AfterCommit(ctx, func() {
 		publishCtx := context.Background()
 		if err := uc.publisher.PublishTenantSuspended(publishCtx, tenant); err != nil {
 			uc.logger.Error("Failed to publish tenant.suspended event",
@@ -79,7 +88,7 @@ func (uc *SuspendTenantUseCase) Execute(ctx context.Context, cmd SuspendTenantCo
 				zap.Error(err),
 			)
 		}
-	}()
+	})
 
 	uc.logger.Info("Tenant suspended",
 		zap.String("tenant_id", cmd.TenantID.String()),
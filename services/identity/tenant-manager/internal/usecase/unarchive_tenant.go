@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// UnarchiveTenantUseCase restores an archived tenant's schema from its most
+// recent archive and reactivates the tenant
+type UnarchiveTenantUseCase struct {
+	repo        domain.TenantRepository
+	archives    domain.ArchiveRepository
+	tx          Transactor
+	audit       domain.AuditRepository
+	provisioner SchemaProvisioner
+	blobs       BlobStore
+	publisher   EventPublisher
+	logger      *zap.Logger
+}
+
+// NewUnarchiveTenantUseCase creates a new UnarchiveTenantUseCase
+func NewUnarchiveTenantUseCase(
+	repo domain.TenantRepository,
+	archives domain.ArchiveRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
+	provisioner SchemaProvisioner,
+	blobs BlobStore,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *UnarchiveTenantUseCase {
+	return &UnarchiveTenantUseCase{
+		repo:        repo,
+		archives:    archives,
+		tx:          tx,
+		audit:       audit,
+		provisioner: provisioner,
+		blobs:       blobs,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// Execute executes the unarchive tenant use case
+func (uc *UnarchiveTenantUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	uc.logger.Info("Unarchiving tenant",
+		zap.String("tenant_id", tenantID.String()),
+	)
+
+	// Get tenant
+	tenant, err := uc.repo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	before := tenant.Snapshot()
+
+	// Validate the transition before touching the schema
+	if err := tenant.Unarchive(); err != nil {
+		return nil, fmt.Errorf("failed to unarchive tenant: %w", err)
+	}
+
+	archive, err := uc.archives.GetLatest(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive: %w", err)
+	}
+
+	// Verify the archive before dropping anything
+	if err := uc.verify(ctx, archive); err != nil {
+		uc.logger.Error("Tenant archive failed verification",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("location", archive.Location),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Remove any leftover schema, then restore from the archive
+	if err := uc.provisioner.DeProvisionTenant(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to clear schema: %w", err)
+	}
+
+	if err := uc.restore(ctx, archive); err != nil {
+		uc.logger.Error("Failed to restore tenant schema",
+			zap.String("tenant_id", tenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to restore schema: %w", err)
+	}
+
+	archive.MarkRestored()
+
+	// Update tenant and archive record
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.archives.Update(ctx, archive); err != nil {
+			return fmt.Errorf("failed to update archive: %w", err)
+		}
+		return saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantUnarchived, tenant, before)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update tenant",
+			zap.String("tenant_id", tenantID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	// Publish event (async)
+	go func() {
+		publishCtx := context.Background()
+		if err := uc.publisher.PublishTenantUnarchived(publishCtx, tenant); err != nil {
+			uc.logger.Error("Failed to publish tenant.unarchived event",
+				zap.String("tenant_id", tenant.TenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	uc.logger.Info("Tenant unarchived",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("location", archive.Location),
+	)
+
+	return tenant, nil
+}
+
+// verify checks the stored archive against its recorded checksum
+func (uc *UnarchiveTenantUseCase) verify(ctx context.Context, archive *domain.TenantArchive) error {
+	rc, err := uc.blobs.Get(ctx, archive.Location)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer rc.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rc); err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != archive.Checksum {
+		return domain.ErrArchiveChecksumMismatch
+	}
+
+	return nil
+}
+
+// restore loads the archive into a freshly provisioned schema
+func (uc *UnarchiveTenantUseCase) restore(ctx context.Context, archive *domain.TenantArchive) error {
+	rc, err := uc.blobs.Get(ctx, archive.Location)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer rc.Close()
+
+	return uc.provisioner.RestoreSchema(ctx, archive.TenantID, rc)
+}
@@ -16,12 +16,15 @@ type UpdateTenantCommand struct {
 	ContactEmail *string
 	ContactName  *string
 	BillingEmail *string
-	Settings     map[string]interface{}
+	// Settings is a JSON merge patch of the tenant's settings
+	Settings map[string]interface{}
 }
 
 // UpdateTenantUseCase handles tenant updates
 type UpdateTenantUseCase struct {
 	repo      domain.TenantRepository
+	tx        Transactor
+	audit     domain.AuditRepository
 	publisher EventPublisher
 	logger    *zap.Logger
 }
@@ -29,11 +32,15 @@ type UpdateTenantUseCase struct {
 // NewUpdateTenantUseCase creates a new UpdateTenantUseCase
 func NewUpdateTenantUseCase(
 	repo domain.TenantRepository,
+	tx Transactor,
+	audit domain.AuditRepository,
 	publisher EventPublisher,
 	logger *zap.Logger,
 ) *UpdateTenantUseCase {
 	return &UpdateTenantUseCase{
 		repo:      repo,
+		tx:        tx,
+		audit:     audit,
 		publisher: publisher,
 		logger:    logger,
 	}
@@ -55,6 +62,8 @@ func (uc *UpdateTenantUseCase) Execute(ctx context.Context, cmd UpdateTenantComm
 		return nil, fmt.Errorf("failed to get tenant: %w", err)
 	}
 
+	before := tenant.Snapshot()
+
 	// Apply updates
 	if cmd.Name != nil {
 		if err := tenant.UpdateName(*cmd.Name); err != nil {
@@ -77,17 +86,13 @@ func (uc *UpdateTenantUseCase) Execute(ctx context.Context, cmd UpdateTenantComm
 	}
 
 	if cmd.Settings != nil {
-		// Merge settings (don't replace entirely)
-		if tenant.Settings == nil {
-			tenant.Settings = make(map[string]interface{})
-		}
-		for k, v := range cmd.Settings {
-			tenant.Settings[k] = v
+		if err := tenant.UpdateSettings(cmd.Settings); err != nil {
+			return nil, fmt.Errorf("failed to update settings: %w", err)
 		}
 	}
 
 	// Update tenant
-	if err := uc.repo.Update(ctx, tenant); err != nil {
+	if err := saveTenant(ctx, uc.tx, uc.repo, uc.audit, domain.AuditTenantUpdated, tenant, before); err != nil {
 		uc.logger.Error("Failed to update tenant",
 			zap.String("tenant_id", cmd.TenantID.String()),
 			zap.Error(err),
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// VerifyCustomDomainCommand represents the input for checking one custom domain
+type VerifyCustomDomainCommand struct {
+	TenantID uuid.UUID
+	DomainID uuid.UUID
+}
+
+// VerifyDueDomainsCommand represents the input for a re-verification run
+type VerifyDueDomainsCommand struct {
+	// RecheckAfter is how long a check result holds before the domain is due
+	RecheckAfter time.Duration
+	Limit        int
+}
+
+// VerifyDueDomainsReport summarizes a re-verification run
+type VerifyDueDomainsReport struct {
+	Checked  int
+	Verified int
+	Failed   int
+	// Errors counts domains that could not be checked, such as on DNS outages
+	Errors int
+}
+
+// VerifyCustomDomainUseCase checks the verification TXT record of custom
+// domains, on request or periodically, and records the outcome
+type VerifyCustomDomainUseCase struct {
+	repo      domain.TenantRepository
+	domains   domain.CustomDomainRepository
+	resolver  domain.TXTResolver
+	tx        Transactor
+	audit     domain.AuditRepository
+	publisher EventPublisher
+	logger    *zap.Logger
+}
+
+// NewVerifyCustomDomainUseCase creates a new VerifyCustomDomainUseCase
+func NewVerifyCustomDomainUseCase(
+	repo domain.TenantRepository,
+	domains domain.CustomDomainRepository,
+	resolver domain.TXTResolver,
+	tx Transactor,
+	audit domain.AuditRepository,
+	publisher EventPublisher,
+	logger *zap.Logger,
+) *VerifyCustomDomainUseCase {
+	return &VerifyCustomDomainUseCase{
+		repo:      repo,
+		domains:   domains,
+		resolver:  resolver,
+		tx:        tx,
+		audit:     audit,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute checks one domain of a tenant right away
+func (uc *VerifyCustomDomainUseCase) Execute(ctx context.Context, cmd VerifyCustomDomainCommand) (*domain.CustomDomain, error) {
+	d, err := uc.domains.GetByID(ctx, cmd.DomainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom domain: %w", err)
+	}
+	// Another tenant's domain is reported as missing
+	if d.TenantID != cmd.TenantID {
+		return nil, domain.ErrDomainNotFound
+	}
+
+	if err := uc.verify(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// VerifyDue checks the pending and verified domains whose last check is older
+// than RecheckAfter. A failure to check one domain is counted in the report
+// and does not stop the run.
+func (uc *VerifyCustomDomainUseCase) VerifyDue(ctx context.Context, cmd VerifyDueDomainsCommand) (*VerifyDueDomainsReport, error) {
+	if cmd.RecheckAfter < 0 {
+		return nil, fmt.Errorf("domain recheck interval cannot be negative, got %s", cmd.RecheckAfter)
+	}
+
+	due, err := uc.domains.ListDue(ctx, time.Now().Add(-cmd.RecheckAfter), cmd.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domains due for verification: %w", err)
+	}
+
+	report := &VerifyDueDomainsReport{}
+	for _, d := range due {
+		if err := uc.verify(ctx, d); err != nil {
+			report.Errors++
+			uc.logger.Warn("Failed to verify custom domain",
+				zap.String("tenant_id", d.TenantID.String()),
+				zap.String("hostname", d.Hostname),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		report.Checked++
+		switch d.Status {
+		case domain.DomainVerified:
+			report.Verified++
+		case domain.DomainFailed:
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// verify checks a domain and saves the outcome. A status change is audited
+// and published.
+func (uc *VerifyCustomDomainUseCase) verify(ctx context.Context, d *domain.CustomDomain) error {
+	before := d.Snapshot()
+	previous := d.Status
+
+	if err := d.Verify(ctx, uc.resolver, time.Now()); err != nil {
+		return err
+	}
+
+	changed := d.Status != previous
+	var event *domain.AuditEvent
+	if changed {
+		action := domain.AuditTenantDomainVerified
+		if d.Status == domain.DomainFailed {
+			action = domain.AuditTenantDomainFailed
+		}
+		tenantID := d.TenantID
+		event = actor.FromContext(ctx).Stamp(domain.NewAuditEvent(action, &tenantID, before, d.Snapshot()))
+	}
+
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.domains.Update(ctx, d, previous); err != nil {
+			return err
+		}
+		if event == nil {
+			return nil
+		}
+		return uc.audit.Record(ctx, event)
+	})
+	if err != nil {
+		// A domain revoked during the DNS lookup stays revoked
+		if errors.Is(err, domain.ErrDomainAlreadyExists) || errors.Is(err, domain.ErrDomainChanged) {
+			return err
+		}
+		return fmt.Errorf("failed to update custom domain: %w", err)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	uc.logger.Info("Custom domain status changed",
+		zap.String("tenant_id", d.TenantID.String()),
+		zap.String("hostname", d.Hostname),
+		zap.String("from", string(previous)),
+		zap.String("to", string(d.Status)),
+	)
+
+	publishDomainChanged(uc.repo, uc.publisher, uc.logger, d)
+
+	return nil
+}
+
+// publishDomainChanged publishes the event of a domain status change (async)
+func publishDomainChanged(repo domain.TenantRepository, publisher EventPublisher, logger *zap.Logger, d *domain.CustomDomain) {
+	go func() {
+		publishCtx := context.Background()
+		tenant, err := repo.GetByTenantID(publishCtx, d.TenantID)
+		if err == nil {
+			err = publisher.PublishTenantDomainChanged(publishCtx, tenant, d)
+		}
+		if err != nil {
+			logger.Error("Failed to publish tenant.domain event",
+				zap.String("tenant_id", d.TenantID.String()),
+				zap.String("hostname", d.Hostname),
+				zap.Error(err),
+			)
+		}
+	}()
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cotai/tenant-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// revokingResolver answers with the domain's TXT record after revoking the
+// domain, as a revoke landing during the DNS lookup would
+type revokingResolver struct {
+	domains *fakeDomainRepo
+	d       *domain.CustomDomain
+}
+
+func (r revokingResolver) LookupTXT(ctx context.Context, _ string) ([]string, error) {
+	revoked := r.domains.get(r.d.ID)
+	previous := revoked.Status
+	if err := revoked.Revoke(); err != nil {
+		return nil, err
+	}
+	if err := r.domains.Update(ctx, &revoked, previous); err != nil {
+		return nil, err
+	}
+	return []string{r.d.VerificationRecordValue()}, nil
+}
+
+func TestVerifyCustomDomain_RevokedDuringLookupStaysRevoked(t *testing.T) {
+	tenant := newActiveTenant(domain.PlanProfessional)
+	d, err := domain.NewCustomDomain(tenant.TenantID, "compras.acme.com.br")
+	require.NoError(t, err)
+
+	tenants := newFakeTenantRepo(tenant)
+	domains := newFakeDomainRepo(d)
+	publisher := &fakePublisher{}
+	uc := NewVerifyCustomDomainUseCase(tenants, domains, revokingResolver{domains: domains, d: d},
+		&fakeTx{stores: []fakeStore{domains}}, &fakeAuditRepo{}, publisher, zap.NewNop())
+
+	_, err = uc.Execute(context.Background(), VerifyCustomDomainCommand{TenantID: tenant.TenantID, DomainID: d.ID})
+	assert.ErrorIs(t, err, domain.ErrDomainChanged)
+
+	stored := domains.get(d.ID)
+	assert.Equal(t, domain.DomainRevoked, stored.Status)
+	assert.NotNil(t, stored.RevokedAt)
+	assert.Nil(t, stored.VerifiedAt)
+	assert.Empty(t, publisher.published())
+}
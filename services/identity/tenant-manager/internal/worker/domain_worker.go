@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// domainLockKey is the advisory lock that keeps verification runs on one replica at a time
+const domainLockKey int64 = 0x74656e616e74646d // "tenantdm"
+
+// DomainConfig holds the custom domain verification worker schedule and limits
+type DomainConfig struct {
+	Interval     time.Duration
+	RecheckAfter time.Duration
+	BatchSize    int
+}
+
+// DomainWorker periodically checks the verification TXT record of pending
+// and verified custom domains
+type DomainWorker struct {
+	verifyUC *usecase.VerifyCustomDomainUseCase
+	locker   Locker
+	config   DomainConfig
+	logger   *zap.Logger
+}
+
+// NewDomainWorker creates a new custom domain verification worker
+func NewDomainWorker(verifyUC *usecase.VerifyCustomDomainUseCase, locker Locker, config DomainConfig, logger *zap.Logger) *DomainWorker {
+	return &DomainWorker{
+		verifyUC: verifyUC,
+		locker:   locker,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run verifies due domains on every interval until ctx is canceled
+func (w *DomainWorker) Run(ctx context.Context) {
+	w.logger.Info("Domain verification worker started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Duration("recheck_after", w.config.RecheckAfter),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Domain verification worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single verification run, unless another replica is already running one
+func (w *DomainWorker) RunOnce(ctx context.Context) {
+	ctx = actor.WithActor(ctx, actor.System)
+
+	acquired, err := w.locker.TryWithLock(ctx, domainLockKey, func(ctx context.Context) error {
+		report, err := w.verifyUC.VerifyDue(ctx, usecase.VerifyDueDomainsCommand{
+			RecheckAfter: w.config.RecheckAfter,
+			Limit:        w.config.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		w.logReport(report)
+		return nil
+	})
+	if err != nil {
+		w.logger.Error("Domain verification run failed", zap.Error(err))
+		return
+	}
+	if !acquired {
+		w.logger.Debug("Domain verification run skipped: another replica holds the lock")
+	}
+}
+
+// logReport logs the outcome of a verification run
+func (w *DomainWorker) logReport(report *usecase.VerifyDueDomainsReport) {
+	if report.Checked == 0 && report.Errors == 0 {
+		w.logger.Debug("Domain verification run found no domains due")
+		return
+	}
+
+	// Lookup errors are logged by the use case as they happen
+	w.logger.Info("Domain verification run completed",
+		zap.Int("checked", report.Checked),
+		zap.Int("verified", report.Verified),
+		zap.Int("failed", report.Failed),
+		zap.Int("errors", report.Errors),
+	)
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// operationLockKey is the advisory lock that elects the one replica running scheduled operations
+const operationLockKey int64 = 0x74656e616e74736f // "tenantso"
+
+// OperationConfig holds the scheduled operations worker schedule and limits
+type OperationConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// OperationWorker periodically runs the scheduled lifecycle operations that
+// are due
+type OperationWorker struct {
+	runUC  *usecase.RunScheduledOperationsUseCase
+	locker Locker
+	config OperationConfig
+	logger *zap.Logger
+}
+
+// NewOperationWorker creates a new scheduled operations worker
+func NewOperationWorker(runUC *usecase.RunScheduledOperationsUseCase, locker Locker, config OperationConfig, logger *zap.Logger) *OperationWorker {
+	return &OperationWorker{
+		runUC:  runUC,
+		locker: locker,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run runs due operations on every interval until ctx is canceled
+func (w *OperationWorker) Run(ctx context.Context) {
+	w.logger.Info("Scheduled operations worker started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Scheduled operations worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single run, unless another replica is already running one
+func (w *OperationWorker) RunOnce(ctx context.Context) {
+	ctx = actor.WithActor(ctx, actor.System)
+
+	acquired, err := w.locker.TryWithLock(ctx, operationLockKey, func(ctx context.Context) error {
+		report, err := w.runUC.Execute(ctx, usecase.RunScheduledOperationsCommand{
+			Limit: w.config.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		w.logReport(report)
+		return nil
+	})
+	if err != nil {
+		w.logger.Error("Scheduled operations run failed", zap.Error(err))
+		return
+	}
+	if !acquired {
+		w.logger.Debug("Scheduled operations run skipped: another replica holds the lock")
+	}
+}
+
+// logReport logs the outcome of a scheduled operations run
+func (w *OperationWorker) logReport(report *usecase.RunScheduledOperationsReport) {
+	if report.Completed == 0 && report.Failed == 0 && report.Errors == 0 {
+		w.logger.Debug("Scheduled operations run found no operations due")
+		return
+	}
+
+	// Failures and errors are logged by the use case as they happen
+	w.logger.Info("Scheduled operations run completed",
+		zap.Int("completed", report.Completed),
+		zap.Int("failed", report.Failed),
+		zap.Int("errors", report.Errors),
+	)
+}
@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// purgeLockKey is the advisory lock that keeps purge runs on one replica at a time
+const purgeLockKey int64 = 0x74656e616e747075 // "tenantpu"
+
+// Locker runs a function while holding a cluster-wide lock
+type Locker interface {
+	TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error)
+}
+
+// PurgeConfig holds the purge worker schedule and limits
+type PurgeConfig struct {
+	Interval  time.Duration
+	Retention time.Duration
+	BatchSize int
+	DryRun    bool
+}
+
+// PurgeWorker periodically purges tenants deleted longer than the retention period
+type PurgeWorker struct {
+	purgeUC *usecase.PurgeTenantsUseCase
+	locker  Locker
+	config  PurgeConfig
+	logger  *zap.Logger
+}
+
+// NewPurgeWorker creates a new purge worker
+func NewPurgeWorker(purgeUC *usecase.PurgeTenantsUseCase, locker Locker, config PurgeConfig, logger *zap.Logger) *PurgeWorker {
+	return &PurgeWorker{
+		purgeUC: purgeUC,
+		locker:  locker,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// Run purges on every interval until ctx is canceled
+func (w *PurgeWorker) Run(ctx context.Context) {
+	w.logger.Info("Purge worker started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Duration("retention", w.config.Retention),
+		zap.Int("batch_size", w.config.BatchSize),
+		zap.Bool("dry_run", w.config.DryRun),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Purge worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single purge run, unless another replica is already running one
+func (w *PurgeWorker) RunOnce(ctx context.Context) {
+	ctx = actor.WithActor(ctx, actor.System)
+
+	acquired, err := w.locker.TryWithLock(ctx, purgeLockKey, func(ctx context.Context) error {
+		report, err := w.purgeUC.Execute(ctx, usecase.PurgeTenantsCommand{
+			Retention: w.config.Retention,
+			Limit:     w.config.BatchSize,
+			DryRun:    w.config.DryRun,
+		})
+		if err != nil {
+			return err
+		}
+
+		w.logReport(report)
+		return nil
+	})
+	if err != nil {
+		w.logger.Error("Purge run failed", zap.Error(err))
+		return
+	}
+	if !acquired {
+		w.logger.Debug("Purge run skipped: another replica holds the lock")
+	}
+}
+
+// logReport logs the outcome of a purge run, listing every candidate of a dry run
+func (w *PurgeWorker) logReport(report *usecase.PurgeReport) {
+	if len(report.Candidates) == 0 {
+		w.logger.Debug("Purge run found no tenants to purge",
+			zap.Time("cutoff", report.Cutoff),
+		)
+		return
+	}
+
+	// Failures are logged by the use case as they happen
+	if report.DryRun {
+		for _, c := range report.Candidates {
+			w.logger.Info("Purge dry run: tenant would be purged",
+				zap.String("tenant_id", c.TenantID.String()),
+				zap.String("slug", c.Slug),
+				zap.String("schema", c.Schema),
+				zap.Time("deleted_at", c.DeletedAt),
+			)
+		}
+	}
+
+	w.logger.Info("Purge run completed",
+		zap.Bool("dry_run", report.DryRun),
+		zap.Time("cutoff", report.Cutoff),
+		zap.Int("candidates", len(report.Candidates)),
+		zap.Int("purged", report.Purged),
+		zap.Int("failed", report.Failed),
+	)
+}
@@ -0,0 +1,105 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/infrastructure/observability"
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// storageLockKey is the advisory lock that keeps metering runs on one replica at a time
+const storageLockKey int64 = 0x74656e616e747374 // "tenantst"
+
+// StorageConfig holds the storage metering worker schedule
+type StorageConfig struct {
+	Interval  time.Duration
+	Retention time.Duration
+}
+
+// StorageWorker periodically measures the schema of every live tenant
+type StorageWorker struct {
+	meterUC *usecase.MeterStorageUseCase
+	locker  Locker
+	metrics *observability.Metrics
+	config  StorageConfig
+	logger  *zap.Logger
+}
+
+// NewStorageWorker creates a new storage metering worker
+func NewStorageWorker(meterUC *usecase.MeterStorageUseCase, locker Locker, metrics *observability.Metrics, config StorageConfig, logger *zap.Logger) *StorageWorker {
+	return &StorageWorker{
+		meterUC: meterUC,
+		locker:  locker,
+		metrics: metrics,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// Run meters storage on every interval until ctx is canceled
+func (w *StorageWorker) Run(ctx context.Context) {
+	w.logger.Info("Storage metering worker started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Duration("retention", w.config.Retention),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Storage metering worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single metering run, unless another replica is already running one
+func (w *StorageWorker) RunOnce(ctx context.Context) {
+	ctx = actor.WithActor(ctx, actor.System)
+
+	acquired, err := w.locker.TryWithLock(ctx, storageLockKey, func(ctx context.Context) error {
+		report, err := w.meterUC.Execute(ctx, usecase.MeterStorageCommand{
+			Retention: w.config.Retention,
+		})
+		if report != nil {
+			w.export(report)
+		}
+		return err
+	})
+	if err != nil {
+		w.logger.Error("Storage metering run failed", zap.Error(err))
+		return
+	}
+	if !acquired {
+		w.logger.Debug("Storage metering run skipped: another replica holds the lock")
+	}
+}
+
+// export replaces the storage gauges with the snapshots of a run, so tenants
+// no longer metered drop out. Only the replica that ran the last metering
+// exports the gauges.
+func (w *StorageWorker) export(report *usecase.MeterStorageReport) {
+	w.metrics.TenantStorageBytes.Reset()
+	w.metrics.TenantStorageQuotaBytes.Reset()
+	for _, s := range report.Snapshots {
+		w.metrics.TenantStorageBytes.WithLabelValues(s.TenantID.String()).Set(float64(s.TotalBytes()))
+		w.metrics.TenantStorageQuotaBytes.WithLabelValues(s.TenantID.String()).Set(float64(s.QuotaBytes))
+	}
+
+	// Failures are logged by the use case as they happen
+	w.logger.Info("Storage metering run completed",
+		zap.Time("measured_at", report.MeasuredAt),
+		zap.Int("measured", len(report.Snapshots)),
+		zap.Int("over_quota", report.OverQuota),
+		zap.Int("failed", report.Failed),
+		zap.Int64("pruned", report.Pruned),
+	)
+}
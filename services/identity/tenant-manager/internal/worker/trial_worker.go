@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cotai/tenant-manager/internal/pkg/actor"
+	"github.com/cotai/tenant-manager/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// trialLockKey is the advisory lock that keeps trial runs on one replica at a time
+const trialLockKey int64 = 0x74656e616e747472 // "tenanttr"
+
+// TrialConfig holds the trial worker schedule, reminder offsets and limits
+type TrialConfig struct {
+	Interval        time.Duration
+	ReminderOffsets []time.Duration
+	BatchSize       int
+}
+
+// TrialWorker periodically sends trial expiry reminders and ends expired
+// trials
+type TrialWorker struct {
+	processUC *usecase.ProcessTrialsUseCase
+	locker    Locker
+	config    TrialConfig
+	logger    *zap.Logger
+}
+
+// NewTrialWorker creates a new trial worker
+func NewTrialWorker(processUC *usecase.ProcessTrialsUseCase, locker Locker, config TrialConfig, logger *zap.Logger) *TrialWorker {
+	return &TrialWorker{
+		processUC: processUC,
+		locker:    locker,
+		config:    config,
+		logger:    logger,
+	}
+}
+
+// Run processes trials on every interval until ctx is canceled
+func (w *TrialWorker) Run(ctx context.Context) {
+	w.logger.Info("Trial worker started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Durations("reminder_offsets", w.config.ReminderOffsets),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Trial worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single trial run, unless another replica is already running one
+func (w *TrialWorker) RunOnce(ctx context.Context) {
+	ctx = actor.WithActor(ctx, actor.System)
+
+	acquired, err := w.locker.TryWithLock(ctx, trialLockKey, func(ctx context.Context) error {
+		report, err := w.processUC.Execute(ctx, usecase.ProcessTrialsCommand{
+			ReminderOffsets: w.config.ReminderOffsets,
+			Limit:           w.config.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		w.logReport(report)
+		return nil
+	})
+	if err != nil {
+		w.logger.Error("Trial run failed", zap.Error(err))
+		return
+	}
+	if !acquired {
+		w.logger.Debug("Trial run skipped: another replica holds the lock")
+	}
+}
+
+// logReport logs the outcome of a trial run
+func (w *TrialWorker) logReport(report *usecase.ProcessTrialsReport) {
+	if report.Reminded == 0 && report.Downgraded == 0 && report.Suspended == 0 && report.Errors == 0 {
+		w.logger.Debug("Trial run found no trials due")
+		return
+	}
+
+	// Per-tenant errors are logged by the use case as they happen
+	w.logger.Info("Trial run completed",
+		zap.Int("reminded", report.Reminded),
+		zap.Int("downgraded", report.Downgraded),
+		zap.Int("suspended", report.Suspended),
+		zap.Int("errors", report.Errors),
+	)
+}
@@ -83,19 +83,24 @@ func (TenantStatus) EnumDescriptor() ([]byte, []int) {
 
 // Tenant represents a tenant entity
 type Tenant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
-	SchemaName    string                 `protobuf:"bytes,5,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	Status        TenantStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=identity.tenant.v1.TenantStatus" json:"status,omitempty"`
-	Plan          string                 `protobuf:"bytes,7,opt,name=plan,proto3" json:"plan,omitempty"`
-	ContactEmail  string                 `protobuf:"bytes,8,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
-	ContactName   string                 `protobuf:"bytes,9,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
-	BillingEmail  string                 `protobuf:"bytes,10,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId     string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug         string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	SchemaName   string                 `protobuf:"bytes,5,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	Status       TenantStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=identity.tenant.v1.TenantStatus" json:"status,omitempty"`
+	Plan         string                 `protobuf:"bytes,7,opt,name=plan,proto3" json:"plan,omitempty"`
+	ContactEmail string                 `protobuf:"bytes,8,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	ContactName  string                 `protobuf:"bytes,9,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	BillingEmail string                 `protobuf:"bytes,10,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// parent_tenant_id is empty for a root tenant
+	ParentTenantId string `protobuf:"bytes,13,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`
+	InheritQuotas  bool   `protobuf:"varint,14,opt,name=inherit_quotas,json=inheritQuotas,proto3" json:"inherit_quotas,omitempty"`
+	// trial_ends_at is set while the tenant is on a trial
+	TrialEndsAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=trial_ends_at,json=trialEndsAt,proto3" json:"trial_ends_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -214,6 +219,27 @@ func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Tenant) GetParentTenantId() string {
+	if x != nil {
+		return x.ParentTenantId
+	}
+	return ""
+}
+
+func (x *Tenant) GetInheritQuotas() bool {
+	if x != nil {
+		return x.InheritQuotas
+	}
+	return false
+}
+
+func (x *Tenant) GetTrialEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrialEndsAt
+	}
+	return nil
+}
+
 // GetTenantRequest is the request for GetTenant
 type GetTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -304,6 +330,159 @@ func (x *GetBySlugRequest) GetSlug() string {
 	return ""
 }
 
+// ResolveTenantByHostRequest is the request for ResolveTenantByHost
+type ResolveTenantByHostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// host may carry a port, as in an HTTP Host header
+	Host          string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTenantByHostRequest) Reset() {
+	*x = ResolveTenantByHostRequest{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTenantByHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTenantByHostRequest) ProtoMessage() {}
+
+func (x *ResolveTenantByHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTenantByHostRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTenantByHostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResolveTenantByHostRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// GetTenantHierarchyRequest is the request for GetTenantHierarchy
+type GetTenantHierarchyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantHierarchyRequest) Reset() {
+	*x = GetTenantHierarchyRequest{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantHierarchyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantHierarchyRequest) ProtoMessage() {}
+
+func (x *GetTenantHierarchyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantHierarchyRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantHierarchyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTenantHierarchyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// TenantHierarchyResponse contains a tenant with its ancestors, root first,
+// and its descendants, ordered by depth, then by name
+type TenantHierarchyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Ancestors     []*Tenant              `protobuf:"bytes,2,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	Descendants   []*Tenant              `protobuf:"bytes,3,rep,name=descendants,proto3" json:"descendants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantHierarchyResponse) Reset() {
+	*x = TenantHierarchyResponse{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantHierarchyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantHierarchyResponse) ProtoMessage() {}
+
+func (x *TenantHierarchyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantHierarchyResponse.ProtoReflect.Descriptor instead.
+func (*TenantHierarchyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TenantHierarchyResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *TenantHierarchyResponse) GetAncestors() []*Tenant {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *TenantHierarchyResponse) GetDescendants() []*Tenant {
+	if x != nil {
+		return x.Descendants
+	}
+	return nil
+}
+
 // ValidateTenantRequest is the request for ValidateTenant
 type ValidateTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -314,7 +493,7 @@ type ValidateTenantRequest struct {
 
 func (x *ValidateTenantRequest) Reset() {
 	*x = ValidateTenantRequest{}
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[3]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +505,7 @@ func (x *ValidateTenantRequest) String() string {
 func (*ValidateTenantRequest) ProtoMessage() {}
 
 func (x *ValidateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[3]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +518,7 @@ func (x *ValidateTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTenantRequest.ProtoReflect.Descriptor instead.
 func (*ValidateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{3}
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ValidateTenantRequest) GetTenantId() string {
@@ -363,7 +542,7 @@ type ValidationResponse struct {
 
 func (x *ValidationResponse) Reset() {
 	*x = ValidationResponse{}
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[4]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -375,7 +554,7 @@ func (x *ValidationResponse) String() string {
 func (*ValidationResponse) ProtoMessage() {}
 
 func (x *ValidationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[4]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +567,7 @@ func (x *ValidationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidationResponse.ProtoReflect.Descriptor instead.
 func (*ValidationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{4}
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidationResponse) GetValid() bool {
@@ -428,15 +607,19 @@ func (x *ValidationResponse) GetMessage() string {
 
 // TenantResponse contains a single tenant
 type TenantResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tenant *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// resolved_from_alias is set by GetTenantBySlug when the requested slug is
+	// a former slug of the tenant; tenant.slug is then the canonical slug to
+	// redirect to
+	ResolvedFromAlias bool `protobuf:"varint,2,opt,name=resolved_from_alias,json=resolvedFromAlias,proto3" json:"resolved_from_alias,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TenantResponse) Reset() {
 	*x = TenantResponse{}
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[5]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +631,7 @@ func (x *TenantResponse) String() string {
 func (*TenantResponse) ProtoMessage() {}
 
 func (x *TenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[5]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +644,7 @@ func (x *TenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantResponse.ProtoReflect.Descriptor instead.
 func (*TenantResponse) Descriptor() ([]byte, []int) {
-	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{5}
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TenantResponse) GetTenant() *Tenant {
@@ -471,21 +654,30 @@ func (x *TenantResponse) GetTenant() *Tenant {
 	return nil
 }
 
+func (x *TenantResponse) GetResolvedFromAlias() bool {
+	if x != nil {
+		return x.ResolvedFromAlias
+	}
+	return false
+}
+
 // ListTenantsRequest is the request for ListTenants
 type ListTenantsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Plan          string                 `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status   string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Plan     string                 `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	Search   string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	// ancestor_id restricts the list to the descendants of a tenant
+	AncestorId    string `protobuf:"bytes,6,opt,name=ancestor_id,json=ancestorId,proto3" json:"ancestor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTenantsRequest) Reset() {
 	*x = ListTenantsRequest{}
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[6]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +689,7 @@ func (x *ListTenantsRequest) String() string {
 func (*ListTenantsRequest) ProtoMessage() {}
 
 func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[6]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +702,7 @@ func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
 func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{6}
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListTenantsRequest) GetPage() int32 {
@@ -548,6 +740,13 @@ func (x *ListTenantsRequest) GetSearch() string {
 	return ""
 }
 
+func (x *ListTenantsRequest) GetAncestorId() string {
+	if x != nil {
+		return x.AncestorId
+	}
+	return ""
+}
+
 // ListTenantsResponse contains a list of tenants with pagination
 type ListTenantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -562,7 +761,7 @@ type ListTenantsResponse struct {
 
 func (x *ListTenantsResponse) Reset() {
 	*x = ListTenantsResponse{}
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[7]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +773,7 @@ func (x *ListTenantsResponse) String() string {
 func (*ListTenantsResponse) ProtoMessage() {}
 
 func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[7]
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +786,7 @@ func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
 func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{7}
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListTenantsResponse) GetTenants() []*Tenant {
@@ -625,130 +824,859 @@ func (x *ListTenantsResponse) GetTotalPages() int32 {
 	return 0
 }
 
-var File_proto_tenant_v1_tenant_proto protoreflect.FileDescriptor
+// ChangePlanRequest is the request for ChangePlan
+type ChangePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Plan          string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_tenant_v1_tenant_proto_rawDesc = "" +
-	"\n" +
-	"\x1cproto/tenant/v1/tenant.proto\x12\x12identity.tenant.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x03\n" +
-	"\x06Tenant\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x04 \x01(\tR\x04slug\x12\x1f\n" +
-	"\vschema_name\x18\x05 \x01(\tR\n" +
-	"schemaName\x128\n" +
-	"\x06status\x18\x06 \x01(\x0e2 .identity.tenant.v1.TenantStatusR\x06status\x12\x12\n" +
-	"\x04plan\x18\a \x01(\tR\x04plan\x12#\n" +
-	"\rcontact_email\x18\b \x01(\tR\fcontactEmail\x12!\n" +
-	"\fcontact_name\x18\t \x01(\tR\vcontactName\x12#\n" +
-	"\rbilling_email\x18\n" +
-	" \x01(\tR\fbillingEmail\x129\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"/\n" +
-	"\x10GetTenantRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"&\n" +
-	"\x10GetBySlugRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"4\n" +
-	"\x15ValidateTenantRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\xbc\x01\n" +
-	"\x12ValidationResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
-	"\vschema_name\x18\x03 \x01(\tR\n" +
-	"schemaName\x128\n" +
-	"\x06status\x18\x04 \x01(\x0e2 .identity.tenant.v1.TenantStatusR\x06status\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage\"D\n" +
-	"\x0eTenantResponse\x122\n" +
-	"\x06tenant\x18\x01 \x01(\v2\x1a.identity.tenant.v1.TenantR\x06tenant\"\x89\x01\n" +
-	"\x12ListTenantsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
-	"\x04plan\x18\x04 \x01(\tR\x04plan\x12\x16\n" +
-	"\x06search\x18\x05 \x01(\tR\x06search\"\xbe\x01\n" +
-	"\x13ListTenantsResponse\x124\n" +
-	"\atenants\x18\x01 \x03(\v2\x1a.identity.tenant.v1.TenantR\atenants\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
-	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages*\xbb\x01\n" +
-	"\fTenantStatus\x12\x1d\n" +
-	"\x19TENANT_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
-	"\x1aTENANT_STATUS_PROVISIONING\x10\x01\x12\x18\n" +
-	"\x14TENANT_STATUS_ACTIVE\x10\x02\x12\x1b\n" +
-	"\x17TENANT_STATUS_SUSPENDED\x10\x03\x12\x1a\n" +
-	"\x16TENANT_STATUS_ARCHIVED\x10\x04\x12\x19\n" +
-	"\x15TENANT_STATUS_DELETED\x10\x052\x88\x03\n" +
-	"\rTenantService\x12U\n" +
-	"\tGetTenant\x12$.identity.tenant.v1.GetTenantRequest\x1a\".identity.tenant.v1.TenantResponse\x12[\n" +
-	"\x0fGetTenantBySlug\x12$.identity.tenant.v1.GetBySlugRequest\x1a\".identity.tenant.v1.TenantResponse\x12c\n" +
-	"\x0eValidateTenant\x12).identity.tenant.v1.ValidateTenantRequest\x1a&.identity.tenant.v1.ValidationResponse\x12^\n" +
-	"\vListTenants\x12&.identity.tenant.v1.ListTenantsRequest\x1a'.identity.tenant.v1.ListTenantsResponseB:Z8github.com/cotai/tenant-manager/proto/tenant/v1;tenantv1b\x06proto3"
+func (x *ChangePlanRequest) Reset() {
+	*x = ChangePlanRequest{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_proto_tenant_v1_tenant_proto_rawDescOnce sync.Once
-	file_proto_tenant_v1_tenant_proto_rawDescData []byte
-)
+func (x *ChangePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_proto_tenant_v1_tenant_proto_rawDescGZIP() []byte {
-	file_proto_tenant_v1_tenant_proto_rawDescOnce.Do(func() {
-		file_proto_tenant_v1_tenant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_tenant_v1_tenant_proto_rawDesc), len(file_proto_tenant_v1_tenant_proto_rawDesc)))
-	})
-	return file_proto_tenant_v1_tenant_proto_rawDescData
+func (*ChangePlanRequest) ProtoMessage() {}
+
+func (x *ChangePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_proto_tenant_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_tenant_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_proto_tenant_v1_tenant_proto_goTypes = []any{
-	(TenantStatus)(0),             // 0: identity.tenant.v1.TenantStatus
-	(*Tenant)(nil),                // 1: identity.tenant.v1.Tenant
-	(*GetTenantRequest)(nil),      // 2: identity.tenant.v1.GetTenantRequest
-	(*GetBySlugRequest)(nil),      // 3: identity.tenant.v1.GetBySlugRequest
-	(*ValidateTenantRequest)(nil), // 4: identity.tenant.v1.ValidateTenantRequest
-	(*ValidationResponse)(nil),    // 5: identity.tenant.v1.ValidationResponse
-	(*TenantResponse)(nil),        // 6: identity.tenant.v1.TenantResponse
-	(*ListTenantsRequest)(nil),    // 7: identity.tenant.v1.ListTenantsRequest
-	(*ListTenantsResponse)(nil),   // 8: identity.tenant.v1.ListTenantsResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+// Deprecated: Use ChangePlanRequest.ProtoReflect.Descriptor instead.
+func (*ChangePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{11}
 }
-var file_proto_tenant_v1_tenant_proto_depIdxs = []int32{
-	0,  // 0: identity.tenant.v1.Tenant.status:type_name -> identity.tenant.v1.TenantStatus
-	9,  // 1: identity.tenant.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 2: identity.tenant.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: identity.tenant.v1.ValidationResponse.status:type_name -> identity.tenant.v1.TenantStatus
-	1,  // 4: identity.tenant.v1.TenantResponse.tenant:type_name -> identity.tenant.v1.Tenant
-	1,  // 5: identity.tenant.v1.ListTenantsResponse.tenants:type_name -> identity.tenant.v1.Tenant
-	2,  // 6: identity.tenant.v1.TenantService.GetTenant:input_type -> identity.tenant.v1.GetTenantRequest
-	3,  // 7: identity.tenant.v1.TenantService.GetTenantBySlug:input_type -> identity.tenant.v1.GetBySlugRequest
-	4,  // 8: identity.tenant.v1.TenantService.ValidateTenant:input_type -> identity.tenant.v1.ValidateTenantRequest
-	7,  // 9: identity.tenant.v1.TenantService.ListTenants:input_type -> identity.tenant.v1.ListTenantsRequest
-	6,  // 10: identity.tenant.v1.TenantService.GetTenant:output_type -> identity.tenant.v1.TenantResponse
-	6,  // 11: identity.tenant.v1.TenantService.GetTenantBySlug:output_type -> identity.tenant.v1.TenantResponse
-	5,  // 12: identity.tenant.v1.TenantService.ValidateTenant:output_type -> identity.tenant.v1.ValidationResponse
-	8,  // 13: identity.tenant.v1.TenantService.ListTenants:output_type -> identity.tenant.v1.ListTenantsResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *ChangePlanRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
 }
 
-func init() { file_proto_tenant_v1_tenant_proto_init() }
-func file_proto_tenant_v1_tenant_proto_init() {
+func (x *ChangePlanRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+// EntitlementRequest is the request for CheckEntitlement, ConsumeEntitlement and ReleaseEntitlement
+type EntitlementRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// amount defaults to 1
+	Amount        int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitlementRequest) Reset() {
+	*x = EntitlementRequest{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementRequest) ProtoMessage() {}
+
+func (x *EntitlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementRequest.ProtoReflect.Descriptor instead.
+func (*EntitlementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EntitlementRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *EntitlementRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntitlementRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// EntitlementResponse contains a tenant's usage of an entitlement in the current window
+type EntitlementResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// allowed is whether the requested amount can be consumed
+	Allowed bool `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// reason explains a denial: LIMIT_EXCEEDED or TENANT_NOT_ACTIVE
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Limit     int64  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used      int64  `protobuf:"varint,6,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining int64  `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// window is none, day, month or rolling
+	Window      string                 `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// resets_at is unset for windows that never reset or roll continuously
+	ResetsAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitlementResponse) Reset() {
+	*x = EntitlementResponse{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementResponse) ProtoMessage() {}
+
+func (x *EntitlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementResponse.ProtoReflect.Descriptor instead.
+func (*EntitlementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EntitlementResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *EntitlementResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntitlementResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *EntitlementResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EntitlementResponse) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *EntitlementResponse) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *EntitlementResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *EntitlementResponse) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *EntitlementResponse) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *EntitlementResponse) GetResetsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return nil
+}
+
+// EvaluateFeaturesRequest is the request for EvaluateFeatures
+type EvaluateFeaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFeaturesRequest) Reset() {
+	*x = EvaluateFeaturesRequest{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateFeaturesRequest) ProtoMessage() {}
+
+func (x *EvaluateFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvaluateFeaturesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// EvaluateFeaturesResponse contains every registered feature flag as resolved for a tenant
+type EvaluateFeaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Features      []*FeatureValue        `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFeaturesResponse) Reset() {
+	*x = EvaluateFeaturesResponse{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateFeaturesResponse) ProtoMessage() {}
+
+func (x *EvaluateFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EvaluateFeaturesResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *EvaluateFeaturesResponse) GetFeatures() []*FeatureValue {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// FeatureValue is a feature flag resolved for a tenant
+type FeatureValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// type is boolean, number or string, and tells which value is set
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// source is override, plan, rollout or default
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*FeatureValue_BoolValue
+	//	*FeatureValue_NumberValue
+	//	*FeatureValue_StringValue
+	Value         isFeatureValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureValue) Reset() {
+	*x = FeatureValue{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureValue) ProtoMessage() {}
+
+func (x *FeatureValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureValue.ProtoReflect.Descriptor instead.
+func (*FeatureValue) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FeatureValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FeatureValue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FeatureValue) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FeatureValue) GetValue() isFeatureValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FeatureValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*FeatureValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *FeatureValue) GetNumberValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*FeatureValue_NumberValue); ok {
+			return x.NumberValue
+		}
+	}
+	return 0
+}
+
+func (x *FeatureValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*FeatureValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+type isFeatureValue_Value interface {
+	isFeatureValue_Value()
+}
+
+type FeatureValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type FeatureValue_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,5,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type FeatureValue_StringValue struct {
+	StringValue string `protobuf:"bytes,6,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+func (*FeatureValue_BoolValue) isFeatureValue_Value() {}
+
+func (*FeatureValue_NumberValue) isFeatureValue_Value() {}
+
+func (*FeatureValue_StringValue) isFeatureValue_Value() {}
+
+// ListUserTenantsRequest is the request for ListUserTenants
+type ListUserTenantsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeycloakUserId string                 `protobuf:"bytes,1,opt,name=keycloak_user_id,json=keycloakUserId,proto3" json:"keycloak_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUserTenantsRequest) Reset() {
+	*x = ListUserTenantsRequest{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTenantsRequest) ProtoMessage() {}
+
+func (x *ListUserTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListUserTenantsRequest) GetKeycloakUserId() string {
+	if x != nil {
+		return x.KeycloakUserId
+	}
+	return ""
+}
+
+// ListUserTenantsResponse contains the memberships of a user, primary first.
+// Memberships of deleted tenants are left out.
+type ListUserTenantsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeycloakUserId string                 `protobuf:"bytes,1,opt,name=keycloak_user_id,json=keycloakUserId,proto3" json:"keycloak_user_id,omitempty"`
+	Tenants        []*UserTenant          `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUserTenantsResponse) Reset() {
+	*x = ListUserTenantsResponse{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTenantsResponse) ProtoMessage() {}
+
+func (x *ListUserTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListUserTenantsResponse) GetKeycloakUserId() string {
+	if x != nil {
+		return x.KeycloakUserId
+	}
+	return ""
+}
+
+func (x *ListUserTenantsResponse) GetTenants() []*UserTenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+// UserTenant is a tenant a user is a member of, with the user's role in it
+type UserTenant struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tenant *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// role is tenant_admin, tenant_manager, tenant_user or tenant_viewer
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	IsPrimary     bool   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTenant) Reset() {
+	*x = UserTenant{}
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTenant) ProtoMessage() {}
+
+func (x *UserTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tenant_v1_tenant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTenant.ProtoReflect.Descriptor instead.
+func (*UserTenant) Descriptor() ([]byte, []int) {
+	return file_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserTenant) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *UserTenant) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserTenant) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+var File_proto_tenant_v1_tenant_proto protoreflect.FileDescriptor
+
+const file_proto_tenant_v1_tenant_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/tenant/v1/tenant.proto\x12\x12identity.tenant.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x04\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12\x1f\n" +
+	"\vschema_name\x18\x05 \x01(\tR\n" +
+	"schemaName\x128\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .identity.tenant.v1.TenantStatusR\x06status\x12\x12\n" +
+	"\x04plan\x18\a \x01(\tR\x04plan\x12#\n" +
+	"\rcontact_email\x18\b \x01(\tR\fcontactEmail\x12!\n" +
+	"\fcontact_name\x18\t \x01(\tR\vcontactName\x12#\n" +
+	"\rbilling_email\x18\n" +
+	" \x01(\tR\fbillingEmail\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
+	"\x10parent_tenant_id\x18\r \x01(\tR\x0eparentTenantId\x12%\n" +
+	"\x0einherit_quotas\x18\x0e \x01(\bR\rinheritQuotas\x12>\n" +
+	"\rtrial_ends_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vtrialEndsAt\"/\n" +
+	"\x10GetTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"&\n" +
+	"\x10GetBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"0\n" +
+	"\x1aResolveTenantByHostRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"8\n" +
+	"\x19GetTenantHierarchyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\xc5\x01\n" +
+	"\x17TenantHierarchyResponse\x122\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x1a.identity.tenant.v1.TenantR\x06tenant\x128\n" +
+	"\tancestors\x18\x02 \x03(\v2\x1a.identity.tenant.v1.TenantR\tancestors\x12<\n" +
+	"\vdescendants\x18\x03 \x03(\v2\x1a.identity.tenant.v1.TenantR\vdescendants\"4\n" +
+	"\x15ValidateTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\xbc\x01\n" +
+	"\x12ValidationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vschema_name\x18\x03 \x01(\tR\n" +
+	"schemaName\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .identity.tenant.v1.TenantStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"t\n" +
+	"\x0eTenantResponse\x122\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x1a.identity.tenant.v1.TenantR\x06tenant\x12.\n" +
+	"\x13resolved_from_alias\x18\x02 \x01(\bR\x11resolvedFromAlias\"\xaa\x01\n" +
+	"\x12ListTenantsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04plan\x18\x04 \x01(\tR\x04plan\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12\x1f\n" +
+	"\vancestor_id\x18\x06 \x01(\tR\n" +
+	"ancestorId\"\xbe\x01\n" +
+	"\x13ListTenantsResponse\x124\n" +
+	"\atenants\x18\x01 \x03(\v2\x1a.identity.tenant.v1.TenantR\atenants\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"D\n" +
+	"\x11ChangePlanRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\"]\n" +
+	"\x12EntitlementRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"\xd0\x02\n" +
+	"\x13EntitlementResponse\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aallowed\x18\x03 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04used\x18\x06 \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x03R\tremaining\x12\x16\n" +
+	"\x06window\x18\b \x01(\tR\x06window\x12=\n" +
+	"\fwindow_start\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x127\n" +
+	"\tresets_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bresetsAt\"6\n" +
+	"\x17EvaluateFeaturesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"u\n" +
+	"\x18EvaluateFeaturesResponse\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12<\n" +
+	"\bfeatures\x18\x02 \x03(\v2 .identity.tenant.v1.FeatureValueR\bfeatures\"\xc0\x01\n" +
+	"\fFeatureValue\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x04 \x01(\bH\x00R\tboolValue\x12#\n" +
+	"\fnumber_value\x18\x05 \x01(\x01H\x00R\vnumberValue\x12#\n" +
+	"\fstring_value\x18\x06 \x01(\tH\x00R\vstringValueB\a\n" +
+	"\x05value\"B\n" +
+	"\x16ListUserTenantsRequest\x12(\n" +
+	"\x10keycloak_user_id\x18\x01 \x01(\tR\x0ekeycloakUserId\"}\n" +
+	"\x17ListUserTenantsResponse\x12(\n" +
+	"\x10keycloak_user_id\x18\x01 \x01(\tR\x0ekeycloakUserId\x128\n" +
+	"\atenants\x18\x02 \x03(\v2\x1e.identity.tenant.v1.UserTenantR\atenants\"s\n" +
+	"\n" +
+	"UserTenant\x122\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x1a.identity.tenant.v1.TenantR\x06tenant\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary*\xbb\x01\n" +
+	"\fTenantStatus\x12\x1d\n" +
+	"\x19TENANT_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTENANT_STATUS_PROVISIONING\x10\x01\x12\x18\n" +
+	"\x14TENANT_STATUS_ACTIVE\x10\x02\x12\x1b\n" +
+	"\x17TENANT_STATUS_SUSPENDED\x10\x03\x12\x1a\n" +
+	"\x16TENANT_STATUS_ARCHIVED\x10\x04\x12\x19\n" +
+	"\x15TENANT_STATUS_DELETED\x10\x052\xcc\t\n" +
+	"\rTenantService\x12U\n" +
+	"\tGetTenant\x12$.identity.tenant.v1.GetTenantRequest\x1a\".identity.tenant.v1.TenantResponse\x12[\n" +
+	"\x0fGetTenantBySlug\x12$.identity.tenant.v1.GetBySlugRequest\x1a\".identity.tenant.v1.TenantResponse\x12i\n" +
+	"\x13ResolveTenantByHost\x12..identity.tenant.v1.ResolveTenantByHostRequest\x1a\".identity.tenant.v1.TenantResponse\x12p\n" +
+	"\x12GetTenantHierarchy\x12-.identity.tenant.v1.GetTenantHierarchyRequest\x1a+.identity.tenant.v1.TenantHierarchyResponse\x12c\n" +
+	"\x0eValidateTenant\x12).identity.tenant.v1.ValidateTenantRequest\x1a&.identity.tenant.v1.ValidationResponse\x12^\n" +
+	"\vListTenants\x12&.identity.tenant.v1.ListTenantsRequest\x1a'.identity.tenant.v1.ListTenantsResponse\x12W\n" +
+	"\n" +
+	"ChangePlan\x12%.identity.tenant.v1.ChangePlanRequest\x1a\".identity.tenant.v1.TenantResponse\x12c\n" +
+	"\x10CheckEntitlement\x12&.identity.tenant.v1.EntitlementRequest\x1a'.identity.tenant.v1.EntitlementResponse\x12e\n" +
+	"\x12ConsumeEntitlement\x12&.identity.tenant.v1.EntitlementRequest\x1a'.identity.tenant.v1.EntitlementResponse\x12e\n" +
+	"\x12ReleaseEntitlement\x12&.identity.tenant.v1.EntitlementRequest\x1a'.identity.tenant.v1.EntitlementResponse\x12m\n" +
+	"\x10EvaluateFeatures\x12+.identity.tenant.v1.EvaluateFeaturesRequest\x1a,.identity.tenant.v1.EvaluateFeaturesResponse\x12j\n" +
+	"\x0fListUserTenants\x12*.identity.tenant.v1.ListUserTenantsRequest\x1a+.identity.tenant.v1.ListUserTenantsResponseB:Z8github.com/cotai/tenant-manager/proto/tenant/v1;tenantv1b\x06proto3"
+
+var (
+	file_proto_tenant_v1_tenant_proto_rawDescOnce sync.Once
+	file_proto_tenant_v1_tenant_proto_rawDescData []byte
+)
+
+func file_proto_tenant_v1_tenant_proto_rawDescGZIP() []byte {
+	file_proto_tenant_v1_tenant_proto_rawDescOnce.Do(func() {
+		file_proto_tenant_v1_tenant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_tenant_v1_tenant_proto_rawDesc), len(file_proto_tenant_v1_tenant_proto_rawDesc)))
+	})
+	return file_proto_tenant_v1_tenant_proto_rawDescData
+}
+
+var file_proto_tenant_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_tenant_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_tenant_v1_tenant_proto_goTypes = []any{
+	(TenantStatus)(0),                  // 0: identity.tenant.v1.TenantStatus
+	(*Tenant)(nil),                     // 1: identity.tenant.v1.Tenant
+	(*GetTenantRequest)(nil),           // 2: identity.tenant.v1.GetTenantRequest
+	(*GetBySlugRequest)(nil),           // 3: identity.tenant.v1.GetBySlugRequest
+	(*ResolveTenantByHostRequest)(nil), // 4: identity.tenant.v1.ResolveTenantByHostRequest
+	(*GetTenantHierarchyRequest)(nil),  // 5: identity.tenant.v1.GetTenantHierarchyRequest
+	(*TenantHierarchyResponse)(nil),    // 6: identity.tenant.v1.TenantHierarchyResponse
+	(*ValidateTenantRequest)(nil),      // 7: identity.tenant.v1.ValidateTenantRequest
+	(*ValidationResponse)(nil),         // 8: identity.tenant.v1.ValidationResponse
+	(*TenantResponse)(nil),             // 9: identity.tenant.v1.TenantResponse
+	(*ListTenantsRequest)(nil),         // 10: identity.tenant.v1.ListTenantsRequest
+	(*ListTenantsResponse)(nil),        // 11: identity.tenant.v1.ListTenantsResponse
+	(*ChangePlanRequest)(nil),          // 12: identity.tenant.v1.ChangePlanRequest
+	(*EntitlementRequest)(nil),         // 13: identity.tenant.v1.EntitlementRequest
+	(*EntitlementResponse)(nil),        // 14: identity.tenant.v1.EntitlementResponse
+	(*EvaluateFeaturesRequest)(nil),    // 15: identity.tenant.v1.EvaluateFeaturesRequest
+	(*EvaluateFeaturesResponse)(nil),   // 16: identity.tenant.v1.EvaluateFeaturesResponse
+	(*FeatureValue)(nil),               // 17: identity.tenant.v1.FeatureValue
+	(*ListUserTenantsRequest)(nil),     // 18: identity.tenant.v1.ListUserTenantsRequest
+	(*ListUserTenantsResponse)(nil),    // 19: identity.tenant.v1.ListUserTenantsResponse
+	(*UserTenant)(nil),                 // 20: identity.tenant.v1.UserTenant
+	(*timestamppb.Timestamp)(nil),      // 21: google.protobuf.Timestamp
+}
+var file_proto_tenant_v1_tenant_proto_depIdxs = []int32{
+	0,  // 0: identity.tenant.v1.Tenant.status:type_name -> identity.tenant.v1.TenantStatus
+	21, // 1: identity.tenant.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	21, // 2: identity.tenant.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 3: identity.tenant.v1.Tenant.trial_ends_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: identity.tenant.v1.TenantHierarchyResponse.tenant:type_name -> identity.tenant.v1.Tenant
+	1,  // 5: identity.tenant.v1.TenantHierarchyResponse.ancestors:type_name -> identity.tenant.v1.Tenant
+	1,  // 6: identity.tenant.v1.TenantHierarchyResponse.descendants:type_name -> identity.tenant.v1.Tenant
+	0,  // 7: identity.tenant.v1.ValidationResponse.status:type_name -> identity.tenant.v1.TenantStatus
+	1,  // 8: identity.tenant.v1.TenantResponse.tenant:type_name -> identity.tenant.v1.Tenant
+	1,  // 9: identity.tenant.v1.ListTenantsResponse.tenants:type_name -> identity.tenant.v1.Tenant
+	21, // 10: identity.tenant.v1.EntitlementResponse.window_start:type_name -> google.protobuf.Timestamp
+	21, // 11: identity.tenant.v1.EntitlementResponse.resets_at:type_name -> google.protobuf.Timestamp
+	17, // 12: identity.tenant.v1.EvaluateFeaturesResponse.features:type_name -> identity.tenant.v1.FeatureValue
+	20, // 13: identity.tenant.v1.ListUserTenantsResponse.tenants:type_name -> identity.tenant.v1.UserTenant
+	1,  // 14: identity.tenant.v1.UserTenant.tenant:type_name -> identity.tenant.v1.Tenant
+	2,  // 15: identity.tenant.v1.TenantService.GetTenant:input_type -> identity.tenant.v1.GetTenantRequest
+	3,  // 16: identity.tenant.v1.TenantService.GetTenantBySlug:input_type -> identity.tenant.v1.GetBySlugRequest
+	4,  // 17: identity.tenant.v1.TenantService.ResolveTenantByHost:input_type -> identity.tenant.v1.ResolveTenantByHostRequest
+	5,  // 18: identity.tenant.v1.TenantService.GetTenantHierarchy:input_type -> identity.tenant.v1.GetTenantHierarchyRequest
+	7,  // 19: identity.tenant.v1.TenantService.ValidateTenant:input_type -> identity.tenant.v1.ValidateTenantRequest
+	10, // 20: identity.tenant.v1.TenantService.ListTenants:input_type -> identity.tenant.v1.ListTenantsRequest
+	12, // 21: identity.tenant.v1.TenantService.ChangePlan:input_type -> identity.tenant.v1.ChangePlanRequest
+	13, // 22: identity.tenant.v1.TenantService.CheckEntitlement:input_type -> identity.tenant.v1.EntitlementRequest
+	13, // 23: identity.tenant.v1.TenantService.ConsumeEntitlement:input_type -> identity.tenant.v1.EntitlementRequest
+	13, // 24: identity.tenant.v1.TenantService.ReleaseEntitlement:input_type -> identity.tenant.v1.EntitlementRequest
+	15, // 25: identity.tenant.v1.TenantService.EvaluateFeatures:input_type -> identity.tenant.v1.EvaluateFeaturesRequest
+	18, // 26: identity.tenant.v1.TenantService.ListUserTenants:input_type -> identity.tenant.v1.ListUserTenantsRequest
+	9,  // 27: identity.tenant.v1.TenantService.GetTenant:output_type -> identity.tenant.v1.TenantResponse
+	9,  // 28: identity.tenant.v1.TenantService.GetTenantBySlug:output_type -> identity.tenant.v1.TenantResponse
+	9,  // 29: identity.tenant.v1.TenantService.ResolveTenantByHost:output_type -> identity.tenant.v1.TenantResponse
+	6,  // 30: identity.tenant.v1.TenantService.GetTenantHierarchy:output_type -> identity.tenant.v1.TenantHierarchyResponse
+	8,  // 31: identity.tenant.v1.TenantService.ValidateTenant:output_type -> identity.tenant.v1.ValidationResponse
+	11, // 32: identity.tenant.v1.TenantService.ListTenants:output_type -> identity.tenant.v1.ListTenantsResponse
+	9,  // 33: identity.tenant.v1.TenantService.ChangePlan:output_type -> identity.tenant.v1.TenantResponse
+	14, // 34: identity.tenant.v1.TenantService.CheckEntitlement:output_type -> identity.tenant.v1.EntitlementResponse
+	14, // 35: identity.tenant.v1.TenantService.ConsumeEntitlement:output_type -> identity.tenant.v1.EntitlementResponse
+	14, // 36: identity.tenant.v1.TenantService.ReleaseEntitlement:output_type -> identity.tenant.v1.EntitlementResponse
+	16, // 37: identity.tenant.v1.TenantService.EvaluateFeatures:output_type -> identity.tenant.v1.EvaluateFeaturesResponse
+	19, // 38: identity.tenant.v1.TenantService.ListUserTenants:output_type -> identity.tenant.v1.ListUserTenantsResponse
+	27, // [27:39] is the sub-list for method output_type
+	15, // [15:27] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto_tenant_v1_tenant_proto_init() }
+func file_proto_tenant_v1_tenant_proto_init() {
 	if File_proto_tenant_v1_tenant_proto != nil {
 		return
 	}
+	file_proto_tenant_v1_tenant_proto_msgTypes[16].OneofWrappers = []any{
+		(*FeatureValue_BoolValue)(nil),
+		(*FeatureValue_NumberValue)(nil),
+		(*FeatureValue_StringValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tenant_v1_tenant_proto_rawDesc), len(file_proto_tenant_v1_tenant_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
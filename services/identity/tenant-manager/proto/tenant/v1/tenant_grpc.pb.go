@@ -19,10 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TenantService_GetTenant_FullMethodName       = "/identity.tenant.v1.TenantService/GetTenant"
-	TenantService_GetTenantBySlug_FullMethodName = "/identity.tenant.v1.TenantService/GetTenantBySlug"
-	TenantService_ValidateTenant_FullMethodName  = "/identity.tenant.v1.TenantService/ValidateTenant"
-	TenantService_ListTenants_FullMethodName     = "/identity.tenant.v1.TenantService/ListTenants"
+	TenantService_GetTenant_FullMethodName           = "/identity.tenant.v1.TenantService/GetTenant"
+	TenantService_GetTenantBySlug_FullMethodName     = "/identity.tenant.v1.TenantService/GetTenantBySlug"
+	TenantService_ResolveTenantByHost_FullMethodName = "/identity.tenant.v1.TenantService/ResolveTenantByHost"
+	TenantService_GetTenantHierarchy_FullMethodName  = "/identity.tenant.v1.TenantService/GetTenantHierarchy"
+	TenantService_ValidateTenant_FullMethodName      = "/identity.tenant.v1.TenantService/ValidateTenant"
+	TenantService_ListTenants_FullMethodName         = "/identity.tenant.v1.TenantService/ListTenants"
+	TenantService_ChangePlan_FullMethodName          = "/identity.tenant.v1.TenantService/ChangePlan"
+	TenantService_CheckEntitlement_FullMethodName    = "/identity.tenant.v1.TenantService/CheckEntitlement"
+	TenantService_ConsumeEntitlement_FullMethodName  = "/identity.tenant.v1.TenantService/ConsumeEntitlement"
+	TenantService_ReleaseEntitlement_FullMethodName  = "/identity.tenant.v1.TenantService/ReleaseEntitlement"
+	TenantService_EvaluateFeatures_FullMethodName    = "/identity.tenant.v1.TenantService/EvaluateFeatures"
+	TenantService_ListUserTenants_FullMethodName     = "/identity.tenant.v1.TenantService/ListUserTenants"
 )
 
 // TenantServiceClient is the client API for TenantService service.
@@ -33,12 +41,28 @@ const (
 type TenantServiceClient interface {
 	// GetTenant retrieves a tenant by ID
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
-	// GetTenantBySlug retrieves a tenant by slug
+	// GetTenantBySlug retrieves a tenant by slug; former slugs resolve until released
 	GetTenantBySlug(ctx context.Context, in *GetBySlugRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	// ResolveTenantByHost retrieves the tenant a request host belongs to, through its verified custom domains
+	ResolveTenantByHost(ctx context.Context, in *ResolveTenantByHostRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	// GetTenantHierarchy retrieves a tenant with its ancestors and descendants
+	GetTenantHierarchy(ctx context.Context, in *GetTenantHierarchyRequest, opts ...grpc.CallOption) (*TenantHierarchyResponse, error)
 	// ValidateTenant checks if a tenant exists and is active
 	ValidateTenant(ctx context.Context, in *ValidateTenantRequest, opts ...grpc.CallOption) (*ValidationResponse, error)
 	// ListTenants retrieves a paginated list of tenants
 	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	// ChangePlan moves a tenant to another subscription plan
+	ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	// CheckEntitlement reports whether a tenant may consume an entitlement, without consuming it
+	CheckEntitlement(ctx context.Context, in *EntitlementRequest, opts ...grpc.CallOption) (*EntitlementResponse, error)
+	// ConsumeEntitlement counts usage of an entitlement, failing with RESOURCE_EXHAUSTED over the limit
+	ConsumeEntitlement(ctx context.Context, in *EntitlementRequest, opts ...grpc.CallOption) (*EntitlementResponse, error)
+	// ReleaseEntitlement gives back usage of an entitlement
+	ReleaseEntitlement(ctx context.Context, in *EntitlementRequest, opts ...grpc.CallOption) (*EntitlementResponse, error)
+	// EvaluateFeatures resolves every registered feature flag for a tenant
+	EvaluateFeatures(ctx context.Context, in *EvaluateFeaturesRequest, opts ...grpc.CallOption) (*EvaluateFeaturesResponse, error)
+	// ListUserTenants retrieves the tenants a Keycloak user is an active member of, primary first
+	ListUserTenants(ctx context.Context, in *ListUserTenantsRequest, opts ...grpc.CallOption) (*ListUserTenantsResponse, error)
 }
 
 type tenantServiceClient struct {
@@ -69,6 +93,26 @@ func (c *tenantServiceClient) GetTenantBySlug(ctx context.Context, in *GetBySlug
 	return out, nil
 }
 
+func (c *tenantServiceClient) ResolveTenantByHost(ctx context.Context, in *ResolveTenantByHostRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ResolveTenantByHost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenantHierarchy(ctx context.Context, in *GetTenantHierarchyRequest, opts ...grpc.CallOption) (*TenantHierarchyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantHierarchyResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantHierarchy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantServiceClient) ValidateTenant(ctx context.Context, in *ValidateTenantRequest, opts ...grpc.CallOption) (*ValidationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidationResponse)
@@ -89,6 +133,66 @@ func (c *tenantServiceClient) ListTenants(ctx context.Context, in *ListTenantsRe
 	return out, nil
 }
 
+func (c *tenantServiceClient) ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ChangePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) CheckEntitlement(ctx context.Context, in *EntitlementRequest, opts ...grpc.CallOption) (*EntitlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntitlementResponse)
+	err := c.cc.Invoke(ctx, TenantService_CheckEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ConsumeEntitlement(ctx context.Context, in *EntitlementRequest, opts ...grpc.CallOption) (*EntitlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntitlementResponse)
+	err := c.cc.Invoke(ctx, TenantService_ConsumeEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ReleaseEntitlement(ctx context.Context, in *EntitlementRequest, opts ...grpc.CallOption) (*EntitlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntitlementResponse)
+	err := c.cc.Invoke(ctx, TenantService_ReleaseEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) EvaluateFeatures(ctx context.Context, in *EvaluateFeaturesRequest, opts ...grpc.CallOption) (*EvaluateFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateFeaturesResponse)
+	err := c.cc.Invoke(ctx, TenantService_EvaluateFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ListUserTenants(ctx context.Context, in *ListUserTenantsRequest, opts ...grpc.CallOption) (*ListUserTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListUserTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TenantServiceServer is the server API for TenantService service.
 // All implementations must embed UnimplementedTenantServiceServer
 // for forward compatibility.
@@ -97,12 +201,28 @@ func (c *tenantServiceClient) ListTenants(ctx context.Context, in *ListTenantsRe
 type TenantServiceServer interface {
 	// GetTenant retrieves a tenant by ID
 	GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error)
-	// GetTenantBySlug retrieves a tenant by slug
+	// GetTenantBySlug retrieves a tenant by slug; former slugs resolve until released
 	GetTenantBySlug(context.Context, *GetBySlugRequest) (*TenantResponse, error)
+	// ResolveTenantByHost retrieves the tenant a request host belongs to, through its verified custom domains
+	ResolveTenantByHost(context.Context, *ResolveTenantByHostRequest) (*TenantResponse, error)
+	// GetTenantHierarchy retrieves a tenant with its ancestors and descendants
+	GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*TenantHierarchyResponse, error)
 	// ValidateTenant checks if a tenant exists and is active
 	ValidateTenant(context.Context, *ValidateTenantRequest) (*ValidationResponse, error)
 	// ListTenants retrieves a paginated list of tenants
 	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+	// ChangePlan moves a tenant to another subscription plan
+	ChangePlan(context.Context, *ChangePlanRequest) (*TenantResponse, error)
+	// CheckEntitlement reports whether a tenant may consume an entitlement, without consuming it
+	CheckEntitlement(context.Context, *EntitlementRequest) (*EntitlementResponse, error)
+	// ConsumeEntitlement counts usage of an entitlement, failing with RESOURCE_EXHAUSTED over the limit
+	ConsumeEntitlement(context.Context, *EntitlementRequest) (*EntitlementResponse, error)
+	// ReleaseEntitlement gives back usage of an entitlement
+	ReleaseEntitlement(context.Context, *EntitlementRequest) (*EntitlementResponse, error)
+	// EvaluateFeatures resolves every registered feature flag for a tenant
+	EvaluateFeatures(context.Context, *EvaluateFeaturesRequest) (*EvaluateFeaturesResponse, error)
+	// ListUserTenants retrieves the tenants a Keycloak user is an active member of, primary first
+	ListUserTenants(context.Context, *ListUserTenantsRequest) (*ListUserTenantsResponse, error)
 	mustEmbedUnimplementedTenantServiceServer()
 }
 
@@ -119,12 +239,36 @@ func (UnimplementedTenantServiceServer) GetTenant(context.Context, *GetTenantReq
 func (UnimplementedTenantServiceServer) GetTenantBySlug(context.Context, *GetBySlugRequest) (*TenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTenantBySlug not implemented")
 }
+func (UnimplementedTenantServiceServer) ResolveTenantByHost(context.Context, *ResolveTenantByHostRequest) (*TenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveTenantByHost not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*TenantHierarchyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantHierarchy not implemented")
+}
 func (UnimplementedTenantServiceServer) ValidateTenant(context.Context, *ValidateTenantRequest) (*ValidationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateTenant not implemented")
 }
 func (UnimplementedTenantServiceServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTenants not implemented")
 }
+func (UnimplementedTenantServiceServer) ChangePlan(context.Context, *ChangePlanRequest) (*TenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangePlan not implemented")
+}
+func (UnimplementedTenantServiceServer) CheckEntitlement(context.Context, *EntitlementRequest) (*EntitlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckEntitlement not implemented")
+}
+func (UnimplementedTenantServiceServer) ConsumeEntitlement(context.Context, *EntitlementRequest) (*EntitlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeEntitlement not implemented")
+}
+func (UnimplementedTenantServiceServer) ReleaseEntitlement(context.Context, *EntitlementRequest) (*EntitlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseEntitlement not implemented")
+}
+func (UnimplementedTenantServiceServer) EvaluateFeatures(context.Context, *EvaluateFeaturesRequest) (*EvaluateFeaturesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateFeatures not implemented")
+}
+func (UnimplementedTenantServiceServer) ListUserTenants(context.Context, *ListUserTenantsRequest) (*ListUserTenantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserTenants not implemented")
+}
 func (UnimplementedTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {}
 func (UnimplementedTenantServiceServer) testEmbeddedByValue()                       {}
 
@@ -182,6 +326,42 @@ func _TenantService_GetTenantBySlug_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_ResolveTenantByHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTenantByHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ResolveTenantByHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ResolveTenantByHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ResolveTenantByHost(ctx, req.(*ResolveTenantByHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenantHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantHierarchy(ctx, req.(*GetTenantHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TenantService_ValidateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateTenantRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +398,114 @@ func _TenantService_ListTenants_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_ChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ChangePlan(ctx, req.(*ChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_CheckEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).CheckEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_CheckEntitlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).CheckEntitlement(ctx, req.(*EntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ConsumeEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ConsumeEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ConsumeEntitlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ConsumeEntitlement(ctx, req.(*EntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ReleaseEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ReleaseEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ReleaseEntitlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ReleaseEntitlement(ctx, req.(*EntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_EvaluateFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).EvaluateFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_EvaluateFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).EvaluateFeatures(ctx, req.(*EvaluateFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ListUserTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ListUserTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ListUserTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ListUserTenants(ctx, req.(*ListUserTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TenantService_ServiceDesc is the grpc.ServiceDesc for TenantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +521,14 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenantBySlug",
 			Handler:    _TenantService_GetTenantBySlug_Handler,
 		},
+		{
+			MethodName: "ResolveTenantByHost",
+			Handler:    _TenantService_ResolveTenantByHost_Handler,
+		},
+		{
+			MethodName: "GetTenantHierarchy",
+			Handler:    _TenantService_GetTenantHierarchy_Handler,
+		},
 		{
 			MethodName: "ValidateTenant",
 			Handler:    _TenantService_ValidateTenant_Handler,
@@ -241,6 +537,30 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTenants",
 			Handler:    _TenantService_ListTenants_Handler,
 		},
+		{
+			MethodName: "ChangePlan",
+			Handler:    _TenantService_ChangePlan_Handler,
+		},
+		{
+			MethodName: "CheckEntitlement",
+			Handler:    _TenantService_CheckEntitlement_Handler,
+		},
+		{
+			MethodName: "ConsumeEntitlement",
+			Handler:    _TenantService_ConsumeEntitlement_Handler,
+		},
+		{
+			MethodName: "ReleaseEntitlement",
+			Handler:    _TenantService_ReleaseEntitlement_Handler,
+		},
+		{
+			MethodName: "EvaluateFeatures",
+			Handler:    _TenantService_EvaluateFeatures_Handler,
+		},
+		{
+			MethodName: "ListUserTenants",
+			Handler:    _TenantService_ListUserTenants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/tenant/v1/tenant.proto",